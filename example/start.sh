@@ -32,7 +32,10 @@ function run_server() {
 
 run_server "   film" $GOBIN/swapi-server -port 30481 \
     -service "buf.knit.demo.swapi.film.v1.FilmService" \
-    -service "buf.knit.demo.swapi.relations.v1.FilmResolverService" &
+    -service "buf.knit.demo.swapi.relations.v1.FilmResolverService" \
+    -service "buf.knit.demo.swapi.search.v1.SearchService" \
+    -service "buf.knit.demo.swapi.graph.v1.GraphService" \
+    -service "buf.knit.demo.swapi.stats.v1.StatsService" &
 pids="$!"
 run_server " person" $GOBIN/swapi-server -port 30482 \
     -service "buf.knit.demo.swapi.person.v1.PersonService" \
@@ -48,7 +51,9 @@ run_server "vehicle" $GOBIN/swapi-server -port 30484 \
     -service "buf.knit.demo.swapi.starship.v1.StarshipService" \
     -service "buf.knit.demo.swapi.vehicle.v1.VehicleService" \
     -service "buf.knit.demo.swapi.relations.v1.StarshipResolverService" \
-    -service "buf.knit.demo.swapi.relations.v1.VehicleResolverService" &
+    -service "buf.knit.demo.swapi.relations.v1.VehicleResolverService" \
+    -service "buf.knit.demo.swapi.manufacturer.v1.ManufacturerService" \
+    -service "buf.knit.demo.swapi.relations.v1.ManufacturerResolverService" &
 pids="$pids $!"
 
 run_server "gateway" $GOBIN/knitgateway -conf knitgateway.swapi-micro.yaml &
@@ -3,21 +3,19 @@ module github.com/bufbuild/knit-demo
 go 1.23.0
 
 require (
-	buf.build/gen/go/bufbuild/knit-demo/connectrpc/go v1.15.0-20231005145018-a92ee6b04e01.1
-	buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go v1.33.0-20231005145018-a92ee6b04e01.1
 	buf.build/gen/go/bufbuild/knit/connectrpc/go v1.15.0-20240111194952-c419effe3c1f.1
+	buf.build/gen/go/bufbuild/knit/protocolbuffers/go v1.33.0-20240111194952-c419effe3c1f.1
 	connectrpc.com/connect v1.15.0
 	connectrpc.com/grpcreflect v1.2.0
 	github.com/bufbuild/knit-go v0.1.0
 	github.com/peterhellberg/swapi v0.0.0-20230222134402-c0bd79f5129c
 	github.com/rs/cors v1.11.0
 	golang.org/x/net v0.38.0
+	google.golang.org/genproto v0.0.0-20240318140521-94a12d6c2237
 	google.golang.org/protobuf v1.33.0
 )
 
 require (
-	buf.build/gen/go/bufbuild/knit/protocolbuffers/go v1.33.0-20240111194952-c419effe3c1f.1 // indirect
 	golang.org/x/sync v0.12.0 // indirect
 	golang.org/x/text v0.23.0 // indirect
-	google.golang.org/genproto v0.0.0-20240318140521-94a12d6c2237 // indirect
 )
@@ -23,18 +23,25 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"sort"
 	"strings"
+	"syscall"
+	"time"
 
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/film/v1/filmv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/person/v1/personv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/planet/v1/planetv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/relations/v1/relationsv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/species/v1/speciesv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/starship/v1/starshipv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/vehicle/v1/vehiclev1connect"
 	"buf.build/gen/go/bufbuild/knit/connectrpc/go/buf/knit/gateway/v1alpha1/gatewayv1alpha1connect"
 	"connectrpc.com/grpcreflect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1/filmv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/graph/v1/graphv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1/manufacturerv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1/personv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1/planetv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1/relationsv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/search/v1/searchv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1/speciesv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1/starshipv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/stats/v1/statsv1connect"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1/vehiclev1connect"
 	"github.com/bufbuild/knit-demo/go/internal"
 	"github.com/bufbuild/knit-demo/go/internal/swapi"
 	"github.com/bufbuild/knit-go"
@@ -50,10 +57,44 @@ func main() {
 	var serviceNames multiStringFlag
 	flags.Var(&serviceNames, "service", "The set of services to implement. If not specified, all services will be implemented.")
 	embedGateway := flags.Bool("embed-gateway", false, "If true, the server will embed a Knit gateway and also expose the Knit protocol.")
+	dataDir := flags.String("data-dir", "", "A directory of JSON files (films.json, people.json, etc) with the data to serve. If not specified, the snapshot of swapi.dev data compiled into the server is used.")
+	watchInterval := flags.Duration("watch-interval", 0, "If non-zero, the directory indicated by --data-dir is polled at this interval and the data is reloaded when its files change. Regardless of this flag, the data is reloaded when the server receives a SIGHUP signal.")
+	logFanOut := flags.Bool("log-fan-out", false, "If true, the server logs how the related entities of every call to a relation resolver are batched, for debugging.")
+	changeLogRetention := flags.Int("change-log-retention", 1000, "The number of recent changes to the data that are retained so that Watch RPCs can resume after them.")
+	pageTokenKeyFile := flags.String("page-token-key-file", "", "A file containing the secret key used to authenticate page tokens. If not specified, a random key is generated on startup, so page tokens are not valid after a restart or across multiple servers.")
 
 	_ = flags.Parse(os.Args[1:])
 
-	handler := swapi.NewHandler()
+	var handlerOpts []swapi.HandlerOption
+	var dataDirSource *swapi.JSONDirDataSource
+	if *dataDir != "" {
+		dataDirSource = swapi.NewJSONDirDataSource(*dataDir)
+		handlerOpts = append(handlerOpts, swapi.WithDataSource(dataDirSource))
+	} else if *watchInterval != 0 {
+		log.Fatalln("cannot use --watch-interval without --data-dir")
+	}
+	if *pageTokenKeyFile != "" {
+		key, err := os.ReadFile(*pageTokenKeyFile)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		handlerOpts = append(handlerOpts, swapi.WithPageTokenKey(key))
+	}
+	handlerOpts = append(handlerOpts, swapi.WithChangeLogRetention(*changeLogRetention))
+	if *logFanOut {
+		handlerOpts = append(handlerOpts, swapi.WithFanOutRecorder(func(stats swapi.FanOutStats) {
+			log.Printf("fan-out for %s: %d bases, %d ids, %d unique ids (dedupe ratio %.2f)",
+				stats.Procedure, stats.Bases, stats.IDs, stats.UniqueIDs, stats.DedupeRatio())
+		}))
+	}
+	handler, err := swapi.NewHandler(handlerOpts...)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	go reloadOnSignal(handler)
+	if dataDirSource != nil && *watchInterval > 0 {
+		go reloadOnChange(handler, dataDirSource, *watchInterval)
+	}
 
 	mux := http.NewServeMux()
 
@@ -120,6 +161,31 @@ func main() {
 				mux.Handle(relationsv1connect.NewVehicleResolverServiceHandler(handler))
 			},
 		},
+		manufacturerv1connect.ManufacturerServiceName: {
+			register: func() {
+				mux.Handle(manufacturerv1connect.NewManufacturerServiceHandler(handler))
+			},
+		},
+		relationsv1connect.ManufacturerResolverServiceName: {
+			register: func() {
+				mux.Handle(relationsv1connect.NewManufacturerResolverServiceHandler(handler))
+			},
+		},
+		graphv1connect.GraphServiceName: {
+			register: func() {
+				mux.Handle(graphv1connect.NewGraphServiceHandler(handler))
+			},
+		},
+		statsv1connect.StatsServiceName: {
+			register: func() {
+				mux.Handle(statsv1connect.NewStatsServiceHandler(handler))
+			},
+		},
+		searchv1connect.SearchServiceName: {
+			register: func() {
+				mux.Handle(searchv1connect.NewSearchServiceHandler(handler))
+			},
+		},
 	}
 	// if none specified, we'll expose all of them
 	if len(serviceNames) == 0 {
@@ -175,6 +241,54 @@ func main() {
 	}
 }
 
+// reloadOnSignal reloads the handler's data whenever the process receives
+// a SIGHUP signal.
+func reloadOnSignal(handler *swapi.Handler) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	for range signals {
+		log.Println("received SIGHUP; reloading data")
+		reload(handler)
+	}
+}
+
+// reloadOnChange polls the given data source and reloads the handler's data
+// whenever the data source's files are modified.
+func reloadOnChange(handler *swapi.Handler, source *swapi.JSONDirDataSource, interval time.Duration) {
+	lastModTime, err := source.ModTime()
+	if err != nil {
+		log.Printf("failed to check data directory for changes: %v\n", err)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		modTime, err := source.ModTime()
+		if err != nil {
+			log.Printf("failed to check data directory for changes: %v\n", err)
+			continue
+		}
+		if modTime.Equal(lastModTime) {
+			continue
+		}
+		log.Println("data directory changed; reloading data")
+		// If the reload fails, such as because a file is only partly
+		// written, it is retried on the next tick.
+		if reload(handler) {
+			lastModTime = modTime
+		}
+	}
+}
+
+// reload reloads the handler's data and reports whether it succeeded.
+func reload(handler *swapi.Handler) bool {
+	if err := handler.Reload(context.Background()); err != nil {
+		log.Printf("reload failed, continuing to serve previous data: %v\n", err)
+		return false
+	}
+	log.Println("reload complete")
+	return true
+}
+
 type multiStringFlag []string
 
 func (m *multiStringFlag) String() string {
@@ -0,0 +1,285 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/common/v1/common.proto
+
+package commonv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChangeType describes an event in a stream returned by a Watch RPC.
+type ChangeType int32
+
+const (
+	ChangeType_CHANGE_TYPE_UNSPECIFIED ChangeType = 0
+	// The entity already existed when the watch started. These events are
+	// only sent when the request asks for an initial snapshot, and they are
+	// all sent before any other events.
+	ChangeType_CHANGE_TYPE_SNAPSHOT ChangeType = 1
+	// The entity was created.
+	ChangeType_CHANGE_TYPE_CREATED ChangeType = 2
+	// The entity was updated. This includes changes made to keep inverse
+	// relations in sync, such as when another entity that refers to it is
+	// created.
+	ChangeType_CHANGE_TYPE_UPDATED ChangeType = 3
+	// The entity was deleted. The event contains the entity as it was
+	// before it was deleted.
+	ChangeType_CHANGE_TYPE_DELETED ChangeType = 4
+	// No entity changed, but the stream has advanced past changes to other
+	// types of entities. The event only has a resume_token, which clients
+	// should save so that they do not resume from a change that is no longer
+	// retained.
+	ChangeType_CHANGE_TYPE_CHECKPOINT ChangeType = 5
+)
+
+// Enum value maps for ChangeType.
+var (
+	ChangeType_name = map[int32]string{
+		0: "CHANGE_TYPE_UNSPECIFIED",
+		1: "CHANGE_TYPE_SNAPSHOT",
+		2: "CHANGE_TYPE_CREATED",
+		3: "CHANGE_TYPE_UPDATED",
+		4: "CHANGE_TYPE_DELETED",
+		5: "CHANGE_TYPE_CHECKPOINT",
+	}
+	ChangeType_value = map[string]int32{
+		"CHANGE_TYPE_UNSPECIFIED": 0,
+		"CHANGE_TYPE_SNAPSHOT":    1,
+		"CHANGE_TYPE_CREATED":     2,
+		"CHANGE_TYPE_UPDATED":     3,
+		"CHANGE_TYPE_DELETED":     4,
+		"CHANGE_TYPE_CHECKPOINT":  5,
+	}
+)
+
+func (x ChangeType) Enum() *ChangeType {
+	p := new(ChangeType)
+	*p = x
+	return p
+}
+
+func (x ChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_buf_knit_demo_swapi_common_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeType) Type() protoreflect.EnumType {
+	return &file_buf_knit_demo_swapi_common_v1_common_proto_enumTypes[0]
+}
+
+func (x ChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeType.Descriptor instead.
+func (ChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_common_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+// EntityError describes why one of the entities that was requested by id
+// could not be returned.
+type EntityError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the entity.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The Connect error code that describes the problem, such as "not_found".
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// A description of the problem.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *EntityError) Reset() {
+	*x = EntityError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_common_v1_common_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityError) ProtoMessage() {}
+
+func (x *EntityError) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_common_v1_common_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityError.ProtoReflect.Descriptor instead.
+func (*EntityError) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_common_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EntityError) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntityError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EntityError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_common_v1_common_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_common_v1_common_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x22, 0x4b, 0x0a, 0x0b, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xaa, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x10, 0x05, 0x42, 0x98, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f,
+	0x6b, 0x6e, 0x69, 0x74, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b, 0x44, 0x53, 0x43, 0xaa,
+	0x02, 0x1d, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x1d, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x29, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x42, 0x75,
+	0x66, 0x3a, 0x3a, 0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d, 0x6f, 0x3a, 0x3a, 0x53,
+	0x77, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_common_v1_common_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_common_v1_common_proto_rawDescData = file_buf_knit_demo_swapi_common_v1_common_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_common_v1_common_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_common_v1_common_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_common_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_common_v1_common_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_common_v1_common_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_common_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_buf_knit_demo_swapi_common_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_buf_knit_demo_swapi_common_v1_common_proto_goTypes = []interface{}{
+	(ChangeType)(0),     // 0: buf.knit.demo.swapi.common.v1.ChangeType
+	(*EntityError)(nil), // 1: buf.knit.demo.swapi.common.v1.EntityError
+}
+var file_buf_knit_demo_swapi_common_v1_common_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_common_v1_common_proto_init() }
+func file_buf_knit_demo_swapi_common_v1_common_proto_init() {
+	if File_buf_knit_demo_swapi_common_v1_common_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_common_v1_common_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_common_v1_common_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_common_v1_common_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_common_v1_common_proto_depIdxs,
+		EnumInfos:         file_buf_knit_demo_swapi_common_v1_common_proto_enumTypes,
+		MessageInfos:      file_buf_knit_demo_swapi_common_v1_common_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_common_v1_common_proto = out.File
+	file_buf_knit_demo_swapi_common_v1_common_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_common_v1_common_proto_goTypes = nil
+	file_buf_knit_demo_swapi_common_v1_common_proto_depIdxs = nil
+}
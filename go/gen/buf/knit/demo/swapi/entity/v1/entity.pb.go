@@ -0,0 +1,411 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/entity/v1/entity.proto
+
+package entityv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	v11 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	v12 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	v13 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	v14 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	v15 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EntityType identifies one of the types of entities in the Star Wars API.
+type EntityType int32
+
+const (
+	EntityType_ENTITY_TYPE_UNSPECIFIED EntityType = 0
+	EntityType_ENTITY_TYPE_FILM        EntityType = 1
+	EntityType_ENTITY_TYPE_PERSON      EntityType = 2
+	EntityType_ENTITY_TYPE_PLANET      EntityType = 3
+	EntityType_ENTITY_TYPE_SPECIES     EntityType = 4
+	EntityType_ENTITY_TYPE_STARSHIP    EntityType = 5
+	EntityType_ENTITY_TYPE_VEHICLE     EntityType = 6
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_FILM",
+		2: "ENTITY_TYPE_PERSON",
+		3: "ENTITY_TYPE_PLANET",
+		4: "ENTITY_TYPE_SPECIES",
+		5: "ENTITY_TYPE_STARSHIP",
+		6: "ENTITY_TYPE_VEHICLE",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED": 0,
+		"ENTITY_TYPE_FILM":        1,
+		"ENTITY_TYPE_PERSON":      2,
+		"ENTITY_TYPE_PLANET":      3,
+		"ENTITY_TYPE_SPECIES":     4,
+		"ENTITY_TYPE_STARSHIP":    5,
+		"ENTITY_TYPE_VEHICLE":     6,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_buf_knit_demo_swapi_entity_v1_entity_proto_enumTypes[0].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_buf_knit_demo_swapi_entity_v1_entity_proto_enumTypes[0]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescGZIP(), []int{0}
+}
+
+// Entity is any one of the entities in the Star Wars API. Since each case
+// is the same message that is returned by the entity's own service, Knit
+// can resolve its relations.
+type Entity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Entity:
+	//	*Entity_Film
+	//	*Entity_Person
+	//	*Entity_Planet
+	//	*Entity_Species
+	//	*Entity_Starship
+	//	*Entity_Vehicle
+	Entity isEntity_Entity `protobuf_oneof:"entity"`
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_entity_v1_entity_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_entity_v1_entity_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *Entity) GetEntity() isEntity_Entity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (x *Entity) GetFilm() *v1.Film {
+	if x, ok := x.GetEntity().(*Entity_Film); ok {
+		return x.Film
+	}
+	return nil
+}
+
+func (x *Entity) GetPerson() *v11.Person {
+	if x, ok := x.GetEntity().(*Entity_Person); ok {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *Entity) GetPlanet() *v12.Planet {
+	if x, ok := x.GetEntity().(*Entity_Planet); ok {
+		return x.Planet
+	}
+	return nil
+}
+
+func (x *Entity) GetSpecies() *v13.Species {
+	if x, ok := x.GetEntity().(*Entity_Species); ok {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *Entity) GetStarship() *v14.Starship {
+	if x, ok := x.GetEntity().(*Entity_Starship); ok {
+		return x.Starship
+	}
+	return nil
+}
+
+func (x *Entity) GetVehicle() *v15.Vehicle {
+	if x, ok := x.GetEntity().(*Entity_Vehicle); ok {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type isEntity_Entity interface {
+	isEntity_Entity()
+}
+
+type Entity_Film struct {
+	Film *v1.Film `protobuf:"bytes,1,opt,name=film,proto3,oneof"`
+}
+
+type Entity_Person struct {
+	Person *v11.Person `protobuf:"bytes,2,opt,name=person,proto3,oneof"`
+}
+
+type Entity_Planet struct {
+	Planet *v12.Planet `protobuf:"bytes,3,opt,name=planet,proto3,oneof"`
+}
+
+type Entity_Species struct {
+	Species *v13.Species `protobuf:"bytes,4,opt,name=species,proto3,oneof"`
+}
+
+type Entity_Starship struct {
+	Starship *v14.Starship `protobuf:"bytes,5,opt,name=starship,proto3,oneof"`
+}
+
+type Entity_Vehicle struct {
+	Vehicle *v15.Vehicle `protobuf:"bytes,6,opt,name=vehicle,proto3,oneof"`
+}
+
+func (*Entity_Film) isEntity_Entity() {}
+
+func (*Entity_Person) isEntity_Entity() {}
+
+func (*Entity_Planet) isEntity_Entity() {}
+
+func (*Entity_Species) isEntity_Entity() {}
+
+func (*Entity_Starship) isEntity_Entity() {}
+
+func (*Entity_Vehicle) isEntity_Entity() {}
+
+var File_buf_knit_demo_swapi_entity_v1_entity_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x26, 0x62, 0x75, 0x66,
+	0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2f, 0x66, 0x69, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65,
+	0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x62, 0x75, 0x66,
+	0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x65, 0x63,
+	0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x62, 0x75, 0x66, 0x2f, 0x6b,
+	0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x73,
+	0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x62, 0x75, 0x66, 0x2f, 0x6b,
+	0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x03, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x37, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6c, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x12, 0x3f, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x48, 0x00, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x12, 0x43, 0x0a,
+	0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x48,
+	0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x43, 0x0a, 0x07, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2a, 0xbb, 0x01, 0x0a, 0x0a, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x4d, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x53,
+	0x4f, 0x4e, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x45, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x53, 0x48, 0x49, 0x50, 0x10, 0x05, 0x12,
+	0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56,
+	0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x10, 0x06, 0x42, 0x98, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d,
+	0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x76,
+	0x31, 0x3b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b, 0x44,
+	0x53, 0x45, 0xaa, 0x02, 0x1d, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44, 0x65,
+	0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x1d, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65,
+	0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x29, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65,
+	0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x22, 0x42, 0x75, 0x66, 0x3a, 0x3a, 0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d, 0x6f,
+	0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescData = file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_entity_v1_entity_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_buf_knit_demo_swapi_entity_v1_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_buf_knit_demo_swapi_entity_v1_entity_proto_goTypes = []interface{}{
+	(EntityType)(0),      // 0: buf.knit.demo.swapi.entity.v1.EntityType
+	(*Entity)(nil),       // 1: buf.knit.demo.swapi.entity.v1.Entity
+	(*v1.Film)(nil),      // 2: buf.knit.demo.swapi.film.v1.Film
+	(*v11.Person)(nil),   // 3: buf.knit.demo.swapi.person.v1.Person
+	(*v12.Planet)(nil),   // 4: buf.knit.demo.swapi.planet.v1.Planet
+	(*v13.Species)(nil),  // 5: buf.knit.demo.swapi.species.v1.Species
+	(*v14.Starship)(nil), // 6: buf.knit.demo.swapi.starship.v1.Starship
+	(*v15.Vehicle)(nil),  // 7: buf.knit.demo.swapi.vehicle.v1.Vehicle
+}
+var file_buf_knit_demo_swapi_entity_v1_entity_proto_depIdxs = []int32{
+	2, // 0: buf.knit.demo.swapi.entity.v1.Entity.film:type_name -> buf.knit.demo.swapi.film.v1.Film
+	3, // 1: buf.knit.demo.swapi.entity.v1.Entity.person:type_name -> buf.knit.demo.swapi.person.v1.Person
+	4, // 2: buf.knit.demo.swapi.entity.v1.Entity.planet:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	5, // 3: buf.knit.demo.swapi.entity.v1.Entity.species:type_name -> buf.knit.demo.swapi.species.v1.Species
+	6, // 4: buf.knit.demo.swapi.entity.v1.Entity.starship:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	7, // 5: buf.knit.demo.swapi.entity.v1.Entity.vehicle:type_name -> buf.knit.demo.swapi.vehicle.v1.Vehicle
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_entity_v1_entity_proto_init() }
+func file_buf_knit_demo_swapi_entity_v1_entity_proto_init() {
+	if File_buf_knit_demo_swapi_entity_v1_entity_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_entity_v1_entity_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Entity_Film)(nil),
+		(*Entity_Person)(nil),
+		(*Entity_Planet)(nil),
+		(*Entity_Species)(nil),
+		(*Entity_Starship)(nil),
+		(*Entity_Vehicle)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_entity_v1_entity_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_entity_v1_entity_proto_depIdxs,
+		EnumInfos:         file_buf_knit_demo_swapi_entity_v1_entity_proto_enumTypes,
+		MessageInfos:      file_buf_knit_demo_swapi_entity_v1_entity_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_entity_v1_entity_proto = out.File
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_goTypes = nil
+	file_buf_knit_demo_swapi_entity_v1_entity_proto_depIdxs = nil
+}
@@ -0,0 +1,1517 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/film/v1/film.proto
+
+package filmv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	date "google.golang.org/genproto/googleapis/type/date"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Film struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	EpisodeNumber int32                  `protobuf:"varint,3,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	OpeningCrawl  string                 `protobuf:"bytes,4,opt,name=opening_crawl,json=openingCrawl,proto3" json:"opening_crawl,omitempty"`
+	Director      string                 `protobuf:"bytes,5,opt,name=director,proto3" json:"director,omitempty"`
+	Producer      string                 `protobuf:"bytes,6,opt,name=producer,proto3" json:"producer,omitempty"`
+	ReleaseDate   *date.Date             `protobuf:"bytes,7,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	CharacterIds  []string               `protobuf:"bytes,8,rep,name=character_ids,json=characterIds,proto3" json:"character_ids,omitempty"`
+	PlanetIds     []string               `protobuf:"bytes,9,rep,name=planet_ids,json=planetIds,proto3" json:"planet_ids,omitempty"`
+	SpeciesIds    []string               `protobuf:"bytes,10,rep,name=species_ids,json=speciesIds,proto3" json:"species_ids,omitempty"`
+	StarshipIds   []string               `protobuf:"bytes,11,rep,name=starship_ids,json=starshipIds,proto3" json:"starship_ids,omitempty"`
+	VehicleIds    []string               `protobuf:"bytes,12,rep,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	Created       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Edited        *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=edited,proto3" json:"edited,omitempty"`
+}
+
+func (x *Film) Reset() {
+	*x = Film{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Film) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Film) ProtoMessage() {}
+
+func (x *Film) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Film.ProtoReflect.Descriptor instead.
+func (*Film) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Film) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Film) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Film) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *Film) GetOpeningCrawl() string {
+	if x != nil {
+		return x.OpeningCrawl
+	}
+	return ""
+}
+
+func (x *Film) GetDirector() string {
+	if x != nil {
+		return x.Director
+	}
+	return ""
+}
+
+func (x *Film) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *Film) GetReleaseDate() *date.Date {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *Film) GetCharacterIds() []string {
+	if x != nil {
+		return x.CharacterIds
+	}
+	return nil
+}
+
+func (x *Film) GetPlanetIds() []string {
+	if x != nil {
+		return x.PlanetIds
+	}
+	return nil
+}
+
+func (x *Film) GetSpeciesIds() []string {
+	if x != nil {
+		return x.SpeciesIds
+	}
+	return nil
+}
+
+func (x *Film) GetStarshipIds() []string {
+	if x != nil {
+		return x.StarshipIds
+	}
+	return nil
+}
+
+func (x *Film) GetVehicleIds() []string {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *Film) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Film) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+type GetFilmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If false, the request fails with a NOT_FOUND error when any of the ids
+	// does not refer to an existing entity. If true, the response contains
+	// the entities that were found, and errors describes the ids that were
+	// not.
+	AllowMissing bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of films fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetFilmsRequest) Reset() {
+	*x = GetFilmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilmsRequest) ProtoMessage() {}
+
+func (x *GetFilmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilmsRequest.ProtoReflect.Descriptor instead.
+func (*GetFilmsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFilmsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetFilmsRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+func (x *GetFilmsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetFilmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entities that were found, in the order of the request's ids.
+	Films []*Film `protobuf:"bytes,1,rep,name=films,proto3" json:"films,omitempty"`
+	// The ids that could not be returned. Only populated when allow_missing
+	// is true in the request.
+	Errors []*v1.EntityError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *GetFilmsResponse) Reset() {
+	*x = GetFilmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilmsResponse) ProtoMessage() {}
+
+func (x *GetFilmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilmsResponse.ProtoReflect.Descriptor instead.
+func (*GetFilmsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFilmsResponse) GetFilms() []*Film {
+	if x != nil {
+		return x.Films
+	}
+	return nil
+}
+
+func (x *GetFilmsResponse) GetErrors() []*v1.EntityError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListFilmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An optional filter expression, in the style of AIP-160, that limits the
+	// results to matching entities. For example: `name:"sky" AND film_ids:1`.
+	// If the filter is invalid, the request fails with an INVALID_ARGUMENT
+	// error.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// An optional comma-separated list of fields to sort the results by, in
+	// the style of AIP-132. Each field may be followed by "asc" or "desc", for
+	// example: "population desc, name". Entities that compare equal remain in
+	// their default order. The page_token must have been returned by a request
+	// with the same order_by, or else the request fails with an
+	// INVALID_ARGUMENT error.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of films fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListFilmsRequest) Reset() {
+	*x = ListFilmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilmsRequest) ProtoMessage() {}
+
+func (x *ListFilmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilmsRequest.ProtoReflect.Descriptor instead.
+func (*ListFilmsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFilmsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFilmsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListFilmsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListFilmsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListFilmsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListFilmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Films         []*Film `protobuf:"bytes,1,rep,name=films,proto3" json:"films,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListFilmsResponse) Reset() {
+	*x = ListFilmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilmsResponse) ProtoMessage() {}
+
+func (x *ListFilmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilmsResponse.ProtoReflect.Descriptor instead.
+func (*ListFilmsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFilmsResponse) GetFilms() []*Film {
+	if x != nil {
+		return x.Films
+	}
+	return nil
+}
+
+func (x *ListFilmsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamFilmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional filter expression, with the same syntax as the filter of
+	// ListFilmsRequest.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The maximum number of films in each response. If zero, defaults to
+	// 100. Values greater than 1000 are treated as 1000.
+	ChunkSize int32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *StreamFilmsRequest) Reset() {
+	*x = StreamFilmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFilmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFilmsRequest) ProtoMessage() {}
+
+func (x *StreamFilmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFilmsRequest.ProtoReflect.Descriptor instead.
+func (*StreamFilmsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamFilmsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *StreamFilmsRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamFilmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of films. All chunks of a stream come from the same
+	// version of the data, in the same order as ListFilms.
+	Films []*Film `protobuf:"bytes,1,rep,name=films,proto3" json:"films,omitempty"`
+}
+
+func (x *StreamFilmsResponse) Reset() {
+	*x = StreamFilmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFilmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFilmsResponse) ProtoMessage() {}
+
+func (x *StreamFilmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFilmsResponse.ProtoReflect.Descriptor instead.
+func (*StreamFilmsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamFilmsResponse) GetFilms() []*Film {
+	if x != nil {
+		return x.Films
+	}
+	return nil
+}
+
+type CreateFilmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The film to create. If its id is empty, the server assigns one. The
+	// created and edited timestamps are always assigned by the server.
+	// All of the ids it refers to must refer to existing entities. The
+	// inverse relations of those entities are updated to refer back to the
+	// new film.
+	Film *Film `protobuf:"bytes,1,opt,name=film,proto3" json:"film,omitempty"`
+}
+
+func (x *CreateFilmRequest) Reset() {
+	*x = CreateFilmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFilmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilmRequest) ProtoMessage() {}
+
+func (x *CreateFilmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilmRequest.ProtoReflect.Descriptor instead.
+func (*CreateFilmRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateFilmRequest) GetFilm() *Film {
+	if x != nil {
+		return x.Film
+	}
+	return nil
+}
+
+type CreateFilmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The film that was created.
+	Film *Film `protobuf:"bytes,1,opt,name=film,proto3" json:"film,omitempty"`
+}
+
+func (x *CreateFilmResponse) Reset() {
+	*x = CreateFilmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFilmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilmResponse) ProtoMessage() {}
+
+func (x *CreateFilmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilmResponse.ProtoReflect.Descriptor instead.
+func (*CreateFilmResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateFilmResponse) GetFilm() *Film {
+	if x != nil {
+		return x.Film
+	}
+	return nil
+}
+
+type UpdateFilmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The film to update. Its id identifies the film to update. If its edited
+	// timestamp is present, it must match the current edited timestamp of the
+	// film, or else the update fails with an ABORTED error. Clients should
+	// set it to the value they last read to avoid overwriting concurrent
+	// changes. As with CreateFilm, all ids it refers to must refer to existing
+	// entities, and the inverse relations of those entities are updated.
+	Film *Film `protobuf:"bytes,1,opt,name=film,proto3" json:"film,omitempty"`
+	// The fields to update. If absent, the fields that are populated in the
+	// request are updated, as described by AIP-134, so fields cannot be cleared
+	// without a mask. The id, created, and edited fields cannot be updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateFilmRequest) Reset() {
+	*x = UpdateFilmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFilmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFilmRequest) ProtoMessage() {}
+
+func (x *UpdateFilmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFilmRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFilmRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateFilmRequest) GetFilm() *Film {
+	if x != nil {
+		return x.Film
+	}
+	return nil
+}
+
+func (x *UpdateFilmRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateFilmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The film after the update was applied.
+	Film *Film `protobuf:"bytes,1,opt,name=film,proto3" json:"film,omitempty"`
+}
+
+func (x *UpdateFilmResponse) Reset() {
+	*x = UpdateFilmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFilmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFilmResponse) ProtoMessage() {}
+
+func (x *UpdateFilmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFilmResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFilmResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateFilmResponse) GetFilm() *Film {
+	if x != nil {
+		return x.Film
+	}
+	return nil
+}
+
+type DeleteFilmRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the film to delete.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If present, the film is only deleted if this matches its current
+	// edited timestamp. Otherwise, the delete fails with an ABORTED error.
+	Edited *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=edited,proto3" json:"edited,omitempty"`
+	// If false, the delete fails with a FAILED_PRECONDITION error when any
+	// other entity refers to the film. If true, all references to the film
+	// are removed from other entities.
+	Cascade bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DeleteFilmRequest) Reset() {
+	*x = DeleteFilmRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFilmRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilmRequest) ProtoMessage() {}
+
+func (x *DeleteFilmRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilmRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilmRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteFilmRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteFilmRequest) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *DeleteFilmRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DeleteFilmResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFilmResponse) Reset() {
+	*x = DeleteFilmResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFilmResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilmResponse) ProtoMessage() {}
+
+func (x *DeleteFilmResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilmResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFilmResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{12}
+}
+
+type WatchFilmsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If present, the stream resumes after the event that returned this
+	// token. Otherwise, it starts with the next change. Only a limited
+	// number of recent changes are retained, so if the token refers to a
+	// change that is no longer retained, or was returned by a different
+	// server process, the request fails with an OUT_OF_RANGE error. Clients
+	// should then watch again with initial_snapshot set.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// If true, the stream starts with a SNAPSHOT event for every existing
+	// film. Cannot be combined with resume_token.
+	InitialSnapshot bool `protobuf:"varint,2,opt,name=initial_snapshot,json=initialSnapshot,proto3" json:"initial_snapshot,omitempty"`
+}
+
+func (x *WatchFilmsRequest) Reset() {
+	*x = WatchFilmsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFilmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFilmsRequest) ProtoMessage() {}
+
+func (x *WatchFilmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFilmsRequest.ProtoReflect.Descriptor instead.
+func (*WatchFilmsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchFilmsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchFilmsRequest) GetInitialSnapshot() bool {
+	if x != nil {
+		return x.InitialSnapshot
+	}
+	return false
+}
+
+type WatchFilmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of change.
+	ChangeType v1.ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=buf.knit.demo.swapi.common.v1.ChangeType" json:"change_type,omitempty"`
+	// The film after the change, or before it for deletes. Absent for
+	// checkpoints.
+	Film *Film `protobuf:"bytes,2,opt,name=film,proto3" json:"film,omitempty"`
+	// A token to resume the stream after this event.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchFilmsResponse) Reset() {
+	*x = WatchFilmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchFilmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFilmsResponse) ProtoMessage() {}
+
+func (x *WatchFilmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFilmsResponse.ProtoReflect.Descriptor instead.
+func (*WatchFilmsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchFilmsResponse) GetChangeType() v1.ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return v1.ChangeType(0)
+}
+
+func (x *WatchFilmsResponse) GetFilm() *Film {
+	if x != nil {
+		return x.Film
+	}
+	return nil
+}
+
+func (x *WatchFilmsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_film_v1_film_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_film_v1_film_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69,
+	0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69,
+	0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f,
+	0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x03, 0x0a,
+	0x04, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x72,
+	0x61, 0x77, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x43, 0x72, 0x61, 0x77, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x49, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x34,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x8f, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6c, 0x6d, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xba,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x74, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x37, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x6d, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x4b, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4e,
+	0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x22, 0x4a,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6c, 0x6d, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x22, 0x4b, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c,
+	0x6d, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x6d, 0x22, 0x71,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x04,
+	0x66, 0x69, 0x6c, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xad, 0x06, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x6d, 0x73, 0x12, 0x2c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6f, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c,
+	0x6d, 0x73, 0x12, 0x2d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x77, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x46, 0x69, 0x6c, 0x6d, 0x73, 0x12, 0x2f, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12,
+	0x6d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x2e, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x2e, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x2e, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x02, 0x12, 0x74, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x12,
+	0x2e, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x42, 0x88, 0x02, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x09, 0x46, 0x69, 0x6c,
+	0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x6b, 0x6e,
+	0x69, 0x74, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62,
+	0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x69, 0x6c, 0x6d, 0x76,
+	0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b, 0x44, 0x53, 0x46, 0xaa, 0x02, 0x1b, 0x42, 0x75, 0x66, 0x2e,
+	0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x46, 0x69, 0x6c, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1b, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e,
+	0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x46, 0x69,
+	0x6c, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x27, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74,
+	0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x46, 0x69, 0x6c, 0x6d,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x20, 0x42, 0x75, 0x66, 0x3a, 0x3a, 0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d,
+	0x6f, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x46, 0x69, 0x6c, 0x6d, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_film_v1_film_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_film_v1_film_proto_rawDescData = file_buf_knit_demo_swapi_film_v1_film_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_film_v1_film_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_film_v1_film_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_film_v1_film_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_film_v1_film_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_film_v1_film_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_buf_knit_demo_swapi_film_v1_film_proto_goTypes = []interface{}{
+	(*Film)(nil),                  // 0: buf.knit.demo.swapi.film.v1.Film
+	(*GetFilmsRequest)(nil),       // 1: buf.knit.demo.swapi.film.v1.GetFilmsRequest
+	(*GetFilmsResponse)(nil),      // 2: buf.knit.demo.swapi.film.v1.GetFilmsResponse
+	(*ListFilmsRequest)(nil),      // 3: buf.knit.demo.swapi.film.v1.ListFilmsRequest
+	(*ListFilmsResponse)(nil),     // 4: buf.knit.demo.swapi.film.v1.ListFilmsResponse
+	(*StreamFilmsRequest)(nil),    // 5: buf.knit.demo.swapi.film.v1.StreamFilmsRequest
+	(*StreamFilmsResponse)(nil),   // 6: buf.knit.demo.swapi.film.v1.StreamFilmsResponse
+	(*CreateFilmRequest)(nil),     // 7: buf.knit.demo.swapi.film.v1.CreateFilmRequest
+	(*CreateFilmResponse)(nil),    // 8: buf.knit.demo.swapi.film.v1.CreateFilmResponse
+	(*UpdateFilmRequest)(nil),     // 9: buf.knit.demo.swapi.film.v1.UpdateFilmRequest
+	(*UpdateFilmResponse)(nil),    // 10: buf.knit.demo.swapi.film.v1.UpdateFilmResponse
+	(*DeleteFilmRequest)(nil),     // 11: buf.knit.demo.swapi.film.v1.DeleteFilmRequest
+	(*DeleteFilmResponse)(nil),    // 12: buf.knit.demo.swapi.film.v1.DeleteFilmResponse
+	(*WatchFilmsRequest)(nil),     // 13: buf.knit.demo.swapi.film.v1.WatchFilmsRequest
+	(*WatchFilmsResponse)(nil),    // 14: buf.knit.demo.swapi.film.v1.WatchFilmsResponse
+	(*date.Date)(nil),             // 15: google.type.Date
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 17: google.protobuf.FieldMask
+	(*v1.EntityError)(nil),        // 18: buf.knit.demo.swapi.common.v1.EntityError
+	(v1.ChangeType)(0),            // 19: buf.knit.demo.swapi.common.v1.ChangeType
+}
+var file_buf_knit_demo_swapi_film_v1_film_proto_depIdxs = []int32{
+	15, // 0: buf.knit.demo.swapi.film.v1.Film.release_date:type_name -> google.type.Date
+	16, // 1: buf.knit.demo.swapi.film.v1.Film.created:type_name -> google.protobuf.Timestamp
+	16, // 2: buf.knit.demo.swapi.film.v1.Film.edited:type_name -> google.protobuf.Timestamp
+	17, // 3: buf.knit.demo.swapi.film.v1.GetFilmsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 4: buf.knit.demo.swapi.film.v1.GetFilmsResponse.films:type_name -> buf.knit.demo.swapi.film.v1.Film
+	18, // 5: buf.knit.demo.swapi.film.v1.GetFilmsResponse.errors:type_name -> buf.knit.demo.swapi.common.v1.EntityError
+	17, // 6: buf.knit.demo.swapi.film.v1.ListFilmsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 7: buf.knit.demo.swapi.film.v1.ListFilmsResponse.films:type_name -> buf.knit.demo.swapi.film.v1.Film
+	0,  // 8: buf.knit.demo.swapi.film.v1.StreamFilmsResponse.films:type_name -> buf.knit.demo.swapi.film.v1.Film
+	0,  // 9: buf.knit.demo.swapi.film.v1.CreateFilmRequest.film:type_name -> buf.knit.demo.swapi.film.v1.Film
+	0,  // 10: buf.knit.demo.swapi.film.v1.CreateFilmResponse.film:type_name -> buf.knit.demo.swapi.film.v1.Film
+	0,  // 11: buf.knit.demo.swapi.film.v1.UpdateFilmRequest.film:type_name -> buf.knit.demo.swapi.film.v1.Film
+	17, // 12: buf.knit.demo.swapi.film.v1.UpdateFilmRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 13: buf.knit.demo.swapi.film.v1.UpdateFilmResponse.film:type_name -> buf.knit.demo.swapi.film.v1.Film
+	16, // 14: buf.knit.demo.swapi.film.v1.DeleteFilmRequest.edited:type_name -> google.protobuf.Timestamp
+	19, // 15: buf.knit.demo.swapi.film.v1.WatchFilmsResponse.change_type:type_name -> buf.knit.demo.swapi.common.v1.ChangeType
+	0,  // 16: buf.knit.demo.swapi.film.v1.WatchFilmsResponse.film:type_name -> buf.knit.demo.swapi.film.v1.Film
+	1,  // 17: buf.knit.demo.swapi.film.v1.FilmService.GetFilms:input_type -> buf.knit.demo.swapi.film.v1.GetFilmsRequest
+	3,  // 18: buf.knit.demo.swapi.film.v1.FilmService.ListFilms:input_type -> buf.knit.demo.swapi.film.v1.ListFilmsRequest
+	5,  // 19: buf.knit.demo.swapi.film.v1.FilmService.StreamFilms:input_type -> buf.knit.demo.swapi.film.v1.StreamFilmsRequest
+	7,  // 20: buf.knit.demo.swapi.film.v1.FilmService.CreateFilm:input_type -> buf.knit.demo.swapi.film.v1.CreateFilmRequest
+	9,  // 21: buf.knit.demo.swapi.film.v1.FilmService.UpdateFilm:input_type -> buf.knit.demo.swapi.film.v1.UpdateFilmRequest
+	11, // 22: buf.knit.demo.swapi.film.v1.FilmService.DeleteFilm:input_type -> buf.knit.demo.swapi.film.v1.DeleteFilmRequest
+	13, // 23: buf.knit.demo.swapi.film.v1.FilmService.WatchFilms:input_type -> buf.knit.demo.swapi.film.v1.WatchFilmsRequest
+	2,  // 24: buf.knit.demo.swapi.film.v1.FilmService.GetFilms:output_type -> buf.knit.demo.swapi.film.v1.GetFilmsResponse
+	4,  // 25: buf.knit.demo.swapi.film.v1.FilmService.ListFilms:output_type -> buf.knit.demo.swapi.film.v1.ListFilmsResponse
+	6,  // 26: buf.knit.demo.swapi.film.v1.FilmService.StreamFilms:output_type -> buf.knit.demo.swapi.film.v1.StreamFilmsResponse
+	8,  // 27: buf.knit.demo.swapi.film.v1.FilmService.CreateFilm:output_type -> buf.knit.demo.swapi.film.v1.CreateFilmResponse
+	10, // 28: buf.knit.demo.swapi.film.v1.FilmService.UpdateFilm:output_type -> buf.knit.demo.swapi.film.v1.UpdateFilmResponse
+	12, // 29: buf.knit.demo.swapi.film.v1.FilmService.DeleteFilm:output_type -> buf.knit.demo.swapi.film.v1.DeleteFilmResponse
+	14, // 30: buf.knit.demo.swapi.film.v1.FilmService.WatchFilms:output_type -> buf.knit.demo.swapi.film.v1.WatchFilmsResponse
+	24, // [24:31] is the sub-list for method output_type
+	17, // [17:24] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_film_v1_film_proto_init() }
+func file_buf_knit_demo_swapi_film_v1_film_proto_init() {
+	if File_buf_knit_demo_swapi_film_v1_film_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Film); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFilmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFilmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFilmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFilmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFilmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFilmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFilmRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFilmResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFilmsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchFilmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_film_v1_film_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_film_v1_film_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_film_v1_film_proto_depIdxs,
+		MessageInfos:      file_buf_knit_demo_swapi_film_v1_film_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_film_v1_film_proto = out.File
+	file_buf_knit_demo_swapi_film_v1_film_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_film_v1_film_proto_goTypes = nil
+	file_buf_knit_demo_swapi_film_v1_film_proto_depIdxs = nil
+}
@@ -0,0 +1,324 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/film/v1/film.proto
+
+package filmv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FilmServiceName is the fully-qualified name of the FilmService service.
+	FilmServiceName = "buf.knit.demo.swapi.film.v1.FilmService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FilmServiceGetFilmsProcedure is the fully-qualified name of the FilmService's GetFilms RPC.
+	FilmServiceGetFilmsProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/GetFilms"
+	// FilmServiceListFilmsProcedure is the fully-qualified name of the FilmService's ListFilms RPC.
+	FilmServiceListFilmsProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/ListFilms"
+	// FilmServiceStreamFilmsProcedure is the fully-qualified name of the FilmService's StreamFilms RPC.
+	FilmServiceStreamFilmsProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/StreamFilms"
+	// FilmServiceCreateFilmProcedure is the fully-qualified name of the FilmService's CreateFilm RPC.
+	FilmServiceCreateFilmProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/CreateFilm"
+	// FilmServiceUpdateFilmProcedure is the fully-qualified name of the FilmService's UpdateFilm RPC.
+	FilmServiceUpdateFilmProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/UpdateFilm"
+	// FilmServiceDeleteFilmProcedure is the fully-qualified name of the FilmService's DeleteFilm RPC.
+	FilmServiceDeleteFilmProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/DeleteFilm"
+	// FilmServiceWatchFilmsProcedure is the fully-qualified name of the FilmService's WatchFilms RPC.
+	FilmServiceWatchFilmsProcedure = "/buf.knit.demo.swapi.film.v1.FilmService/WatchFilms"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	filmServiceServiceDescriptor           = v1.File_buf_knit_demo_swapi_film_v1_film_proto.Services().ByName("FilmService")
+	filmServiceGetFilmsMethodDescriptor    = filmServiceServiceDescriptor.Methods().ByName("GetFilms")
+	filmServiceListFilmsMethodDescriptor   = filmServiceServiceDescriptor.Methods().ByName("ListFilms")
+	filmServiceStreamFilmsMethodDescriptor = filmServiceServiceDescriptor.Methods().ByName("StreamFilms")
+	filmServiceCreateFilmMethodDescriptor  = filmServiceServiceDescriptor.Methods().ByName("CreateFilm")
+	filmServiceUpdateFilmMethodDescriptor  = filmServiceServiceDescriptor.Methods().ByName("UpdateFilm")
+	filmServiceDeleteFilmMethodDescriptor  = filmServiceServiceDescriptor.Methods().ByName("DeleteFilm")
+	filmServiceWatchFilmsMethodDescriptor  = filmServiceServiceDescriptor.Methods().ByName("WatchFilms")
+)
+
+// FilmServiceClient is a client for the buf.knit.demo.swapi.film.v1.FilmService service.
+type FilmServiceClient interface {
+	GetFilms(context.Context, *connect.Request[v1.GetFilmsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	ListFilms(context.Context, *connect.Request[v1.ListFilmsRequest]) (*connect.Response[v1.ListFilmsResponse], error)
+	// StreamFilms streams all films, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListFilms.
+	StreamFilms(context.Context, *connect.Request[v1.StreamFilmsRequest]) (*connect.ServerStreamForClient[v1.StreamFilmsResponse], error)
+	// CreateFilm creates a new film.
+	CreateFilm(context.Context, *connect.Request[v1.CreateFilmRequest]) (*connect.Response[v1.CreateFilmResponse], error)
+	// UpdateFilm updates an existing film.
+	UpdateFilm(context.Context, *connect.Request[v1.UpdateFilmRequest]) (*connect.Response[v1.UpdateFilmResponse], error)
+	// DeleteFilm deletes a film.
+	DeleteFilm(context.Context, *connect.Request[v1.DeleteFilmRequest]) (*connect.Response[v1.DeleteFilmResponse], error)
+	// WatchFilms streams the changes to films, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchFilms(context.Context, *connect.Request[v1.WatchFilmsRequest]) (*connect.ServerStreamForClient[v1.WatchFilmsResponse], error)
+}
+
+// NewFilmServiceClient constructs a client for the buf.knit.demo.swapi.film.v1.FilmService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFilmServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FilmServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &filmServiceClient{
+		getFilms: connect.NewClient[v1.GetFilmsRequest, v1.GetFilmsResponse](
+			httpClient,
+			baseURL+FilmServiceGetFilmsProcedure,
+			connect.WithSchema(filmServiceGetFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listFilms: connect.NewClient[v1.ListFilmsRequest, v1.ListFilmsResponse](
+			httpClient,
+			baseURL+FilmServiceListFilmsProcedure,
+			connect.WithSchema(filmServiceListFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamFilms: connect.NewClient[v1.StreamFilmsRequest, v1.StreamFilmsResponse](
+			httpClient,
+			baseURL+FilmServiceStreamFilmsProcedure,
+			connect.WithSchema(filmServiceStreamFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createFilm: connect.NewClient[v1.CreateFilmRequest, v1.CreateFilmResponse](
+			httpClient,
+			baseURL+FilmServiceCreateFilmProcedure,
+			connect.WithSchema(filmServiceCreateFilmMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateFilm: connect.NewClient[v1.UpdateFilmRequest, v1.UpdateFilmResponse](
+			httpClient,
+			baseURL+FilmServiceUpdateFilmProcedure,
+			connect.WithSchema(filmServiceUpdateFilmMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFilm: connect.NewClient[v1.DeleteFilmRequest, v1.DeleteFilmResponse](
+			httpClient,
+			baseURL+FilmServiceDeleteFilmProcedure,
+			connect.WithSchema(filmServiceDeleteFilmMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		watchFilms: connect.NewClient[v1.WatchFilmsRequest, v1.WatchFilmsResponse](
+			httpClient,
+			baseURL+FilmServiceWatchFilmsProcedure,
+			connect.WithSchema(filmServiceWatchFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// filmServiceClient implements FilmServiceClient.
+type filmServiceClient struct {
+	getFilms    *connect.Client[v1.GetFilmsRequest, v1.GetFilmsResponse]
+	listFilms   *connect.Client[v1.ListFilmsRequest, v1.ListFilmsResponse]
+	streamFilms *connect.Client[v1.StreamFilmsRequest, v1.StreamFilmsResponse]
+	createFilm  *connect.Client[v1.CreateFilmRequest, v1.CreateFilmResponse]
+	updateFilm  *connect.Client[v1.UpdateFilmRequest, v1.UpdateFilmResponse]
+	deleteFilm  *connect.Client[v1.DeleteFilmRequest, v1.DeleteFilmResponse]
+	watchFilms  *connect.Client[v1.WatchFilmsRequest, v1.WatchFilmsResponse]
+}
+
+// GetFilms calls buf.knit.demo.swapi.film.v1.FilmService.GetFilms.
+func (c *filmServiceClient) GetFilms(ctx context.Context, req *connect.Request[v1.GetFilmsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return c.getFilms.CallUnary(ctx, req)
+}
+
+// ListFilms calls buf.knit.demo.swapi.film.v1.FilmService.ListFilms.
+func (c *filmServiceClient) ListFilms(ctx context.Context, req *connect.Request[v1.ListFilmsRequest]) (*connect.Response[v1.ListFilmsResponse], error) {
+	return c.listFilms.CallUnary(ctx, req)
+}
+
+// StreamFilms calls buf.knit.demo.swapi.film.v1.FilmService.StreamFilms.
+func (c *filmServiceClient) StreamFilms(ctx context.Context, req *connect.Request[v1.StreamFilmsRequest]) (*connect.ServerStreamForClient[v1.StreamFilmsResponse], error) {
+	return c.streamFilms.CallServerStream(ctx, req)
+}
+
+// CreateFilm calls buf.knit.demo.swapi.film.v1.FilmService.CreateFilm.
+func (c *filmServiceClient) CreateFilm(ctx context.Context, req *connect.Request[v1.CreateFilmRequest]) (*connect.Response[v1.CreateFilmResponse], error) {
+	return c.createFilm.CallUnary(ctx, req)
+}
+
+// UpdateFilm calls buf.knit.demo.swapi.film.v1.FilmService.UpdateFilm.
+func (c *filmServiceClient) UpdateFilm(ctx context.Context, req *connect.Request[v1.UpdateFilmRequest]) (*connect.Response[v1.UpdateFilmResponse], error) {
+	return c.updateFilm.CallUnary(ctx, req)
+}
+
+// DeleteFilm calls buf.knit.demo.swapi.film.v1.FilmService.DeleteFilm.
+func (c *filmServiceClient) DeleteFilm(ctx context.Context, req *connect.Request[v1.DeleteFilmRequest]) (*connect.Response[v1.DeleteFilmResponse], error) {
+	return c.deleteFilm.CallUnary(ctx, req)
+}
+
+// WatchFilms calls buf.knit.demo.swapi.film.v1.FilmService.WatchFilms.
+func (c *filmServiceClient) WatchFilms(ctx context.Context, req *connect.Request[v1.WatchFilmsRequest]) (*connect.ServerStreamForClient[v1.WatchFilmsResponse], error) {
+	return c.watchFilms.CallServerStream(ctx, req)
+}
+
+// FilmServiceHandler is an implementation of the buf.knit.demo.swapi.film.v1.FilmService service.
+type FilmServiceHandler interface {
+	GetFilms(context.Context, *connect.Request[v1.GetFilmsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	ListFilms(context.Context, *connect.Request[v1.ListFilmsRequest]) (*connect.Response[v1.ListFilmsResponse], error)
+	// StreamFilms streams all films, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListFilms.
+	StreamFilms(context.Context, *connect.Request[v1.StreamFilmsRequest], *connect.ServerStream[v1.StreamFilmsResponse]) error
+	// CreateFilm creates a new film.
+	CreateFilm(context.Context, *connect.Request[v1.CreateFilmRequest]) (*connect.Response[v1.CreateFilmResponse], error)
+	// UpdateFilm updates an existing film.
+	UpdateFilm(context.Context, *connect.Request[v1.UpdateFilmRequest]) (*connect.Response[v1.UpdateFilmResponse], error)
+	// DeleteFilm deletes a film.
+	DeleteFilm(context.Context, *connect.Request[v1.DeleteFilmRequest]) (*connect.Response[v1.DeleteFilmResponse], error)
+	// WatchFilms streams the changes to films, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchFilms(context.Context, *connect.Request[v1.WatchFilmsRequest], *connect.ServerStream[v1.WatchFilmsResponse]) error
+}
+
+// NewFilmServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFilmServiceHandler(svc FilmServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	filmServiceGetFilmsHandler := connect.NewUnaryHandler(
+		FilmServiceGetFilmsProcedure,
+		svc.GetFilms,
+		connect.WithSchema(filmServiceGetFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmServiceListFilmsHandler := connect.NewUnaryHandler(
+		FilmServiceListFilmsProcedure,
+		svc.ListFilms,
+		connect.WithSchema(filmServiceListFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmServiceStreamFilmsHandler := connect.NewServerStreamHandler(
+		FilmServiceStreamFilmsProcedure,
+		svc.StreamFilms,
+		connect.WithSchema(filmServiceStreamFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmServiceCreateFilmHandler := connect.NewUnaryHandler(
+		FilmServiceCreateFilmProcedure,
+		svc.CreateFilm,
+		connect.WithSchema(filmServiceCreateFilmMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmServiceUpdateFilmHandler := connect.NewUnaryHandler(
+		FilmServiceUpdateFilmProcedure,
+		svc.UpdateFilm,
+		connect.WithSchema(filmServiceUpdateFilmMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmServiceDeleteFilmHandler := connect.NewUnaryHandler(
+		FilmServiceDeleteFilmProcedure,
+		svc.DeleteFilm,
+		connect.WithSchema(filmServiceDeleteFilmMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmServiceWatchFilmsHandler := connect.NewServerStreamHandler(
+		FilmServiceWatchFilmsProcedure,
+		svc.WatchFilms,
+		connect.WithSchema(filmServiceWatchFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.film.v1.FilmService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FilmServiceGetFilmsProcedure:
+			filmServiceGetFilmsHandler.ServeHTTP(w, r)
+		case FilmServiceListFilmsProcedure:
+			filmServiceListFilmsHandler.ServeHTTP(w, r)
+		case FilmServiceStreamFilmsProcedure:
+			filmServiceStreamFilmsHandler.ServeHTTP(w, r)
+		case FilmServiceCreateFilmProcedure:
+			filmServiceCreateFilmHandler.ServeHTTP(w, r)
+		case FilmServiceUpdateFilmProcedure:
+			filmServiceUpdateFilmHandler.ServeHTTP(w, r)
+		case FilmServiceDeleteFilmProcedure:
+			filmServiceDeleteFilmHandler.ServeHTTP(w, r)
+		case FilmServiceWatchFilmsProcedure:
+			filmServiceWatchFilmsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFilmServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFilmServiceHandler struct{}
+
+func (UnimplementedFilmServiceHandler) GetFilms(context.Context, *connect.Request[v1.GetFilmsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.GetFilms is not implemented"))
+}
+
+func (UnimplementedFilmServiceHandler) ListFilms(context.Context, *connect.Request[v1.ListFilmsRequest]) (*connect.Response[v1.ListFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.ListFilms is not implemented"))
+}
+
+func (UnimplementedFilmServiceHandler) StreamFilms(context.Context, *connect.Request[v1.StreamFilmsRequest], *connect.ServerStream[v1.StreamFilmsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.StreamFilms is not implemented"))
+}
+
+func (UnimplementedFilmServiceHandler) CreateFilm(context.Context, *connect.Request[v1.CreateFilmRequest]) (*connect.Response[v1.CreateFilmResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.CreateFilm is not implemented"))
+}
+
+func (UnimplementedFilmServiceHandler) UpdateFilm(context.Context, *connect.Request[v1.UpdateFilmRequest]) (*connect.Response[v1.UpdateFilmResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.UpdateFilm is not implemented"))
+}
+
+func (UnimplementedFilmServiceHandler) DeleteFilm(context.Context, *connect.Request[v1.DeleteFilmRequest]) (*connect.Response[v1.DeleteFilmResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.DeleteFilm is not implemented"))
+}
+
+func (UnimplementedFilmServiceHandler) WatchFilms(context.Context, *connect.Request[v1.WatchFilmsRequest], *connect.ServerStream[v1.WatchFilmsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.film.v1.FilmService.WatchFilms is not implemented"))
+}
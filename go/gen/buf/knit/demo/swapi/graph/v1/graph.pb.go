@@ -0,0 +1,579 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/graph/v1/graph.proto
+
+package graphv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/entity/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EdgeKind is a kind of relation between two types of entities. Each kind
+// of edge can be traversed in either direction. For example, a
+// EDGE_KIND_FILM_CHARACTERS edge connects a film to each of its characters,
+// and also each character to the films they appear in.
+type EdgeKind int32
+
+const (
+	EdgeKind_EDGE_KIND_UNSPECIFIED       EdgeKind = 0
+	EdgeKind_EDGE_KIND_FILM_CHARACTERS   EdgeKind = 1
+	EdgeKind_EDGE_KIND_FILM_PLANETS      EdgeKind = 2
+	EdgeKind_EDGE_KIND_FILM_SPECIES      EdgeKind = 3
+	EdgeKind_EDGE_KIND_FILM_STARSHIPS    EdgeKind = 4
+	EdgeKind_EDGE_KIND_FILM_VEHICLES     EdgeKind = 5
+	EdgeKind_EDGE_KIND_PERSON_HOMEWORLD  EdgeKind = 6
+	EdgeKind_EDGE_KIND_PERSON_SPECIES    EdgeKind = 7
+	EdgeKind_EDGE_KIND_PERSON_STARSHIPS  EdgeKind = 8
+	EdgeKind_EDGE_KIND_PERSON_VEHICLES   EdgeKind = 9
+	EdgeKind_EDGE_KIND_SPECIES_HOMEWORLD EdgeKind = 10
+)
+
+// Enum value maps for EdgeKind.
+var (
+	EdgeKind_name = map[int32]string{
+		0:  "EDGE_KIND_UNSPECIFIED",
+		1:  "EDGE_KIND_FILM_CHARACTERS",
+		2:  "EDGE_KIND_FILM_PLANETS",
+		3:  "EDGE_KIND_FILM_SPECIES",
+		4:  "EDGE_KIND_FILM_STARSHIPS",
+		5:  "EDGE_KIND_FILM_VEHICLES",
+		6:  "EDGE_KIND_PERSON_HOMEWORLD",
+		7:  "EDGE_KIND_PERSON_SPECIES",
+		8:  "EDGE_KIND_PERSON_STARSHIPS",
+		9:  "EDGE_KIND_PERSON_VEHICLES",
+		10: "EDGE_KIND_SPECIES_HOMEWORLD",
+	}
+	EdgeKind_value = map[string]int32{
+		"EDGE_KIND_UNSPECIFIED":       0,
+		"EDGE_KIND_FILM_CHARACTERS":   1,
+		"EDGE_KIND_FILM_PLANETS":      2,
+		"EDGE_KIND_FILM_SPECIES":      3,
+		"EDGE_KIND_FILM_STARSHIPS":    4,
+		"EDGE_KIND_FILM_VEHICLES":     5,
+		"EDGE_KIND_PERSON_HOMEWORLD":  6,
+		"EDGE_KIND_PERSON_SPECIES":    7,
+		"EDGE_KIND_PERSON_STARSHIPS":  8,
+		"EDGE_KIND_PERSON_VEHICLES":   9,
+		"EDGE_KIND_SPECIES_HOMEWORLD": 10,
+	}
+)
+
+func (x EdgeKind) Enum() *EdgeKind {
+	p := new(EdgeKind)
+	*p = x
+	return p
+}
+
+func (x EdgeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EdgeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_enumTypes[0].Descriptor()
+}
+
+func (EdgeKind) Type() protoreflect.EnumType {
+	return &file_buf_knit_demo_swapi_graph_v1_graph_proto_enumTypes[0]
+}
+
+func (x EdgeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EdgeKind.Descriptor instead.
+func (EdgeKind) EnumDescriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescGZIP(), []int{0}
+}
+
+// EntityRef identifies an entity.
+type EntityRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type v1.EntityType `protobuf:"varint,1,opt,name=type,proto3,enum=buf.knit.demo.swapi.entity.v1.EntityType" json:"type,omitempty"`
+	Id   string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EntityRef) Reset() {
+	*x = EntityRef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntityRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntityRef) ProtoMessage() {}
+
+func (x *EntityRef) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntityRef.ProtoReflect.Descriptor instead.
+func (*EntityRef) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EntityRef) GetType() v1.EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return v1.EntityType(0)
+}
+
+func (x *EntityRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FindPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entity at the start of the path. If it does not exist, the request
+	// fails with a NOT_FOUND error.
+	From *EntityRef `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The entity at the end of the path. If it does not exist, the request
+	// fails with a NOT_FOUND error.
+	To *EntityRef `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// The maximum number of edges in the path. If zero, the default of 6 is
+	// used. It cannot be greater than 20.
+	MaxDepth uint32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// The kinds of edges that the path may use. If empty, all kinds of edges
+	// may be used.
+	AllowedEdgeKinds []EdgeKind `protobuf:"varint,4,rep,packed,name=allowed_edge_kinds,json=allowedEdgeKinds,proto3,enum=buf.knit.demo.swapi.graph.v1.EdgeKind" json:"allowed_edge_kinds,omitempty"`
+}
+
+func (x *FindPathRequest) Reset() {
+	*x = FindPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathRequest) ProtoMessage() {}
+
+func (x *FindPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathRequest.ProtoReflect.Descriptor instead.
+func (*FindPathRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FindPathRequest) GetFrom() *EntityRef {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *FindPathRequest) GetTo() *EntityRef {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *FindPathRequest) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetAllowedEdgeKinds() []EdgeKind {
+	if x != nil {
+		return x.AllowedEdgeKinds
+	}
+	return nil
+}
+
+type FindPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entities on the path, starting with the from entity and ending with
+	// the to entity.
+	Entities []*v1.Entity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	// The edges between the entities, where edges[i] connects entities[i] and
+	// entities[i+1].
+	Edges []*Edge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *FindPathResponse) Reset() {
+	*x = FindPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPathResponse) ProtoMessage() {}
+
+func (x *FindPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindPathResponse.ProtoReflect.Descriptor instead.
+func (*FindPathResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FindPathResponse) GetEntities() []*v1.Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *FindPathResponse) GetEdges() []*Edge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+// Edge is a relation between two entities on a path.
+type Edge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind EdgeKind `protobuf:"varint,1,opt,name=kind,proto3,enum=buf.knit.demo.swapi.graph.v1.EdgeKind" json:"kind,omitempty"`
+	// The name of the field of the first entity that refers to the second
+	// entity, such as "character_ids" or "film_ids". This is empty if only the
+	// second entity refers to the first, as for a planet and a species whose
+	// homeworld it is.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+}
+
+func (x *Edge) Reset() {
+	*x = Edge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Edge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Edge) ProtoMessage() {}
+
+func (x *Edge) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Edge.ProtoReflect.Descriptor instead.
+func (*Edge) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Edge) GetKind() EdgeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EdgeKind_EDGE_KIND_UNSPECIFIED
+}
+
+func (x *Edge) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_graph_v1_graph_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x62, 0x75, 0x66, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e,
+	0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x66, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x37, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x54, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x65, 0x64, 0x67, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x10, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x45, 0x64, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x8f, 0x01,
+	0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22,
+	0x58, 0x0a, 0x04, 0x45, 0x64, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2a, 0xd5, 0x02, 0x0a, 0x08, 0x45, 0x64,
+	0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46,
+	0x49, 0x4c, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x53, 0x10, 0x01,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49,
+	0x4c, 0x4d, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x45, 0x54, 0x53, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x4d, 0x5f, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x45, 0x53, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x44, 0x47, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x53,
+	0x48, 0x49, 0x50, 0x53, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b,
+	0x49, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x4c, 0x4d, 0x5f, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45,
+	0x53, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4c,
+	0x44, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x49, 0x45, 0x53, 0x10,
+	0x07, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50,
+	0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x53, 0x48, 0x49, 0x50, 0x53, 0x10,
+	0x08, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50,
+	0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x45, 0x48, 0x49, 0x43, 0x4c, 0x45, 0x53, 0x10, 0x09,
+	0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x48, 0x4f, 0x4d, 0x45, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x10,
+	0x0a, 0x32, 0x7e, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x6e, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x42, 0x90, 0x02, 0x0a, 0x20, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x67, 0x72,
+	0x61, 0x70, 0x68, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x47, 0x72, 0x61, 0x70, 0x68, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2d, 0x64,
+	0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x66, 0x2f, 0x6b,
+	0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x61, 0x70, 0x68, 0x76, 0x31, 0xa2,
+	0x02, 0x05, 0x42, 0x4b, 0x44, 0x53, 0x47, 0xaa, 0x02, 0x1c, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e,
+	0x69, 0x74, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1c, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69,
+	0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x28, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74,
+	0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x21, 0x42, 0x75, 0x66, 0x3a, 0x3a, 0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65,
+	0x6d, 0x6f, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescData = file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_graph_v1_graph_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_buf_knit_demo_swapi_graph_v1_graph_proto_goTypes = []interface{}{
+	(EdgeKind)(0),            // 0: buf.knit.demo.swapi.graph.v1.EdgeKind
+	(*EntityRef)(nil),        // 1: buf.knit.demo.swapi.graph.v1.EntityRef
+	(*FindPathRequest)(nil),  // 2: buf.knit.demo.swapi.graph.v1.FindPathRequest
+	(*FindPathResponse)(nil), // 3: buf.knit.demo.swapi.graph.v1.FindPathResponse
+	(*Edge)(nil),             // 4: buf.knit.demo.swapi.graph.v1.Edge
+	(v1.EntityType)(0),       // 5: buf.knit.demo.swapi.entity.v1.EntityType
+	(*v1.Entity)(nil),        // 6: buf.knit.demo.swapi.entity.v1.Entity
+}
+var file_buf_knit_demo_swapi_graph_v1_graph_proto_depIdxs = []int32{
+	5, // 0: buf.knit.demo.swapi.graph.v1.EntityRef.type:type_name -> buf.knit.demo.swapi.entity.v1.EntityType
+	1, // 1: buf.knit.demo.swapi.graph.v1.FindPathRequest.from:type_name -> buf.knit.demo.swapi.graph.v1.EntityRef
+	1, // 2: buf.knit.demo.swapi.graph.v1.FindPathRequest.to:type_name -> buf.knit.demo.swapi.graph.v1.EntityRef
+	0, // 3: buf.knit.demo.swapi.graph.v1.FindPathRequest.allowed_edge_kinds:type_name -> buf.knit.demo.swapi.graph.v1.EdgeKind
+	6, // 4: buf.knit.demo.swapi.graph.v1.FindPathResponse.entities:type_name -> buf.knit.demo.swapi.entity.v1.Entity
+	4, // 5: buf.knit.demo.swapi.graph.v1.FindPathResponse.edges:type_name -> buf.knit.demo.swapi.graph.v1.Edge
+	0, // 6: buf.knit.demo.swapi.graph.v1.Edge.kind:type_name -> buf.knit.demo.swapi.graph.v1.EdgeKind
+	2, // 7: buf.knit.demo.swapi.graph.v1.GraphService.FindPath:input_type -> buf.knit.demo.swapi.graph.v1.FindPathRequest
+	3, // 8: buf.knit.demo.swapi.graph.v1.GraphService.FindPath:output_type -> buf.knit.demo.swapi.graph.v1.FindPathResponse
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_graph_v1_graph_proto_init() }
+func file_buf_knit_demo_swapi_graph_v1_graph_proto_init() {
+	if File_buf_knit_demo_swapi_graph_v1_graph_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntityRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Edge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_graph_v1_graph_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_graph_v1_graph_proto_depIdxs,
+		EnumInfos:         file_buf_knit_demo_swapi_graph_v1_graph_proto_enumTypes,
+		MessageInfos:      file_buf_knit_demo_swapi_graph_v1_graph_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_graph_v1_graph_proto = out.File
+	file_buf_knit_demo_swapi_graph_v1_graph_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_graph_v1_graph_proto_goTypes = nil
+	file_buf_knit_demo_swapi_graph_v1_graph_proto_depIdxs = nil
+}
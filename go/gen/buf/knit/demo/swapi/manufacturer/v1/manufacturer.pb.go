@@ -0,0 +1,815 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/manufacturer/v1/manufacturer.proto
+
+package manufacturerv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Manufacturer is a maker of starships and vehicles. Manufacturers are
+// derived from the manufacturers of starships and vehicles, so they cannot
+// be created, updated, or deleted directly.
+type Manufacturer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the manufacturer, which is derived from its name, such as
+	// "kuat-drive-yards".
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StarshipIds []string `protobuf:"bytes,3,rep,name=starship_ids,json=starshipIds,proto3" json:"starship_ids,omitempty"`
+	VehicleIds  []string `protobuf:"bytes,4,rep,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	// The earliest created timestamp of the manufacturer's starships and
+	// vehicles.
+	Created *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	// The latest edited timestamp of the manufacturer's starships and
+	// vehicles.
+	Edited *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=edited,proto3" json:"edited,omitempty"`
+}
+
+func (x *Manufacturer) Reset() {
+	*x = Manufacturer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Manufacturer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manufacturer) ProtoMessage() {}
+
+func (x *Manufacturer) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
+func (*Manufacturer) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Manufacturer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Manufacturer) GetStarshipIds() []string {
+	if x != nil {
+		return x.StarshipIds
+	}
+	return nil
+}
+
+func (x *Manufacturer) GetVehicleIds() []string {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *Manufacturer) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Manufacturer) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+type GetManufacturersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If false, the request fails with a NOT_FOUND error when any of the ids
+	// does not refer to an existing entity. If true, the response contains
+	// the entities that were found, and errors describes the ids that were
+	// not.
+	AllowMissing bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of manufacturers fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetManufacturersRequest) Reset() {
+	*x = GetManufacturersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManufacturersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturersRequest) ProtoMessage() {}
+
+func (x *GetManufacturersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturersRequest.ProtoReflect.Descriptor instead.
+func (*GetManufacturersRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetManufacturersRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetManufacturersRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+func (x *GetManufacturersRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetManufacturersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entities that were found, in the order of the request's ids.
+	Manufacturers []*Manufacturer `protobuf:"bytes,1,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	// The ids that could not be returned. Only populated when allow_missing
+	// is true in the request.
+	Errors []*v1.EntityError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *GetManufacturersResponse) Reset() {
+	*x = GetManufacturersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturersResponse) ProtoMessage() {}
+
+func (x *GetManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*GetManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetManufacturersResponse) GetManufacturers() []*Manufacturer {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+func (x *GetManufacturersResponse) GetErrors() []*v1.EntityError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListManufacturersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An optional filter expression, in the style of AIP-160, that limits the
+	// results to matching entities. For example: `name:"kuat"`.
+	// If the filter is invalid, the request fails with an INVALID_ARGUMENT
+	// error.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// An optional comma-separated list of fields to sort the results by, in
+	// the style of AIP-132. Each field may be followed by "asc" or "desc", for
+	// example: "name desc". Entities that compare equal remain in their
+	// default order. The page_token must have been returned by a request with
+	// the same order_by, or else the request fails with an INVALID_ARGUMENT
+	// error.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of manufacturers fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListManufacturersRequest) Reset() {
+	*x = ListManufacturersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListManufacturersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersRequest) ProtoMessage() {}
+
+func (x *ListManufacturersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersRequest.ProtoReflect.Descriptor instead.
+func (*ListManufacturersRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListManufacturersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListManufacturersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListManufacturersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListManufacturersRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListManufacturersRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListManufacturersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manufacturers []*Manufacturer `protobuf:"bytes,1,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListManufacturersResponse) Reset() {
+	*x = ListManufacturersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListManufacturersResponse) ProtoMessage() {}
+
+func (x *ListManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*ListManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListManufacturersResponse) GetManufacturers() []*Manufacturer {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+func (x *ListManufacturersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamManufacturersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional filter expression, with the same syntax as the filter of
+	// ListManufacturersRequest.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The maximum number of manufacturers in each response. If zero, defaults to
+	// 100. Values greater than 1000 are treated as 1000.
+	ChunkSize int32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *StreamManufacturersRequest) Reset() {
+	*x = StreamManufacturersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamManufacturersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamManufacturersRequest) ProtoMessage() {}
+
+func (x *StreamManufacturersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamManufacturersRequest.ProtoReflect.Descriptor instead.
+func (*StreamManufacturersRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamManufacturersRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *StreamManufacturersRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamManufacturersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of manufacturers. All chunks of a stream come from the same
+	// version of the data, in the same order as ListManufacturers.
+	Manufacturers []*Manufacturer `protobuf:"bytes,1,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+}
+
+func (x *StreamManufacturersResponse) Reset() {
+	*x = StreamManufacturersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamManufacturersResponse) ProtoMessage() {}
+
+func (x *StreamManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*StreamManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamManufacturersResponse) GetManufacturers() []*Manufacturer {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+var File_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDesc = []byte{
+	0x0a, 0x36, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x23, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x62,
+	0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x01, 0x0a,
+	0x0c, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x22,
+	0x89, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xb7, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x75,
+	0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x73, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x9c, 0x01, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x75,
+	0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53, 0x0a, 0x1a, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x76,
+	0x0a, 0x1b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x0d, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x32, 0xe8, 0x03, 0x0a, 0x13, 0x4d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x94,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x73, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e,
+	0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x97, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12,
+	0x9f, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x12, 0x3f, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d,
+	0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30,
+	0x01, 0x42, 0xc8, 0x02, 0x0a, 0x27, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61,
+	0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x4d,
+	0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2d, 0x64, 0x65, 0x6d,
+	0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69,
+	0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x6e,
+	0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x6e,
+	0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b,
+	0x44, 0x53, 0x4d, 0xaa, 0x02, 0x23, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44,
+	0x65, 0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x23, 0x42, 0x75, 0x66, 0x5c,
+	0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c,
+	0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x2f, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x28, 0x42, 0x75, 0x66, 0x3a, 0x3a, 0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44,
+	0x65, 0x6d, 0x6f, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x61, 0x6e, 0x75,
+	0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescData = file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_goTypes = []interface{}{
+	(*Manufacturer)(nil),                // 0: buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+	(*GetManufacturersRequest)(nil),     // 1: buf.knit.demo.swapi.manufacturer.v1.GetManufacturersRequest
+	(*GetManufacturersResponse)(nil),    // 2: buf.knit.demo.swapi.manufacturer.v1.GetManufacturersResponse
+	(*ListManufacturersRequest)(nil),    // 3: buf.knit.demo.swapi.manufacturer.v1.ListManufacturersRequest
+	(*ListManufacturersResponse)(nil),   // 4: buf.knit.demo.swapi.manufacturer.v1.ListManufacturersResponse
+	(*StreamManufacturersRequest)(nil),  // 5: buf.knit.demo.swapi.manufacturer.v1.StreamManufacturersRequest
+	(*StreamManufacturersResponse)(nil), // 6: buf.knit.demo.swapi.manufacturer.v1.StreamManufacturersResponse
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 8: google.protobuf.FieldMask
+	(*v1.EntityError)(nil),              // 9: buf.knit.demo.swapi.common.v1.EntityError
+}
+var file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_depIdxs = []int32{
+	7,  // 0: buf.knit.demo.swapi.manufacturer.v1.Manufacturer.created:type_name -> google.protobuf.Timestamp
+	7,  // 1: buf.knit.demo.swapi.manufacturer.v1.Manufacturer.edited:type_name -> google.protobuf.Timestamp
+	8,  // 2: buf.knit.demo.swapi.manufacturer.v1.GetManufacturersRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 3: buf.knit.demo.swapi.manufacturer.v1.GetManufacturersResponse.manufacturers:type_name -> buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+	9,  // 4: buf.knit.demo.swapi.manufacturer.v1.GetManufacturersResponse.errors:type_name -> buf.knit.demo.swapi.common.v1.EntityError
+	8,  // 5: buf.knit.demo.swapi.manufacturer.v1.ListManufacturersRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: buf.knit.demo.swapi.manufacturer.v1.ListManufacturersResponse.manufacturers:type_name -> buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+	0,  // 7: buf.knit.demo.swapi.manufacturer.v1.StreamManufacturersResponse.manufacturers:type_name -> buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+	1,  // 8: buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.GetManufacturers:input_type -> buf.knit.demo.swapi.manufacturer.v1.GetManufacturersRequest
+	3,  // 9: buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.ListManufacturers:input_type -> buf.knit.demo.swapi.manufacturer.v1.ListManufacturersRequest
+	5,  // 10: buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.StreamManufacturers:input_type -> buf.knit.demo.swapi.manufacturer.v1.StreamManufacturersRequest
+	2,  // 11: buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.GetManufacturers:output_type -> buf.knit.demo.swapi.manufacturer.v1.GetManufacturersResponse
+	4,  // 12: buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.ListManufacturers:output_type -> buf.knit.demo.swapi.manufacturer.v1.ListManufacturersResponse
+	6,  // 13: buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.StreamManufacturers:output_type -> buf.knit.demo.swapi.manufacturer.v1.StreamManufacturersResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_init() }
+func file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_init() {
+	if File_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Manufacturer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetManufacturersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetManufacturersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManufacturersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListManufacturersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamManufacturersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamManufacturersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_depIdxs,
+		MessageInfos:      file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto = out.File
+	file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_goTypes = nil
+	file_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto_depIdxs = nil
+}
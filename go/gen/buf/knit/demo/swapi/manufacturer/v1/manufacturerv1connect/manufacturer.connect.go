@@ -0,0 +1,202 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/manufacturer/v1/manufacturer.proto
+
+package manufacturerv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ManufacturerServiceName is the fully-qualified name of the ManufacturerService service.
+	ManufacturerServiceName = "buf.knit.demo.swapi.manufacturer.v1.ManufacturerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ManufacturerServiceGetManufacturersProcedure is the fully-qualified name of the
+	// ManufacturerService's GetManufacturers RPC.
+	ManufacturerServiceGetManufacturersProcedure = "/buf.knit.demo.swapi.manufacturer.v1.ManufacturerService/GetManufacturers"
+	// ManufacturerServiceListManufacturersProcedure is the fully-qualified name of the
+	// ManufacturerService's ListManufacturers RPC.
+	ManufacturerServiceListManufacturersProcedure = "/buf.knit.demo.swapi.manufacturer.v1.ManufacturerService/ListManufacturers"
+	// ManufacturerServiceStreamManufacturersProcedure is the fully-qualified name of the
+	// ManufacturerService's StreamManufacturers RPC.
+	ManufacturerServiceStreamManufacturersProcedure = "/buf.knit.demo.swapi.manufacturer.v1.ManufacturerService/StreamManufacturers"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	manufacturerServiceServiceDescriptor                   = v1.File_buf_knit_demo_swapi_manufacturer_v1_manufacturer_proto.Services().ByName("ManufacturerService")
+	manufacturerServiceGetManufacturersMethodDescriptor    = manufacturerServiceServiceDescriptor.Methods().ByName("GetManufacturers")
+	manufacturerServiceListManufacturersMethodDescriptor   = manufacturerServiceServiceDescriptor.Methods().ByName("ListManufacturers")
+	manufacturerServiceStreamManufacturersMethodDescriptor = manufacturerServiceServiceDescriptor.Methods().ByName("StreamManufacturers")
+)
+
+// ManufacturerServiceClient is a client for the
+// buf.knit.demo.swapi.manufacturer.v1.ManufacturerService service.
+type ManufacturerServiceClient interface {
+	GetManufacturers(context.Context, *connect.Request[v1.GetManufacturersRequest]) (*connect.Response[v1.GetManufacturersResponse], error)
+	ListManufacturers(context.Context, *connect.Request[v1.ListManufacturersRequest]) (*connect.Response[v1.ListManufacturersResponse], error)
+	// StreamManufacturers streams all manufacturers, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListManufacturers.
+	StreamManufacturers(context.Context, *connect.Request[v1.StreamManufacturersRequest]) (*connect.ServerStreamForClient[v1.StreamManufacturersResponse], error)
+}
+
+// NewManufacturerServiceClient constructs a client for the
+// buf.knit.demo.swapi.manufacturer.v1.ManufacturerService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewManufacturerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ManufacturerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &manufacturerServiceClient{
+		getManufacturers: connect.NewClient[v1.GetManufacturersRequest, v1.GetManufacturersResponse](
+			httpClient,
+			baseURL+ManufacturerServiceGetManufacturersProcedure,
+			connect.WithSchema(manufacturerServiceGetManufacturersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listManufacturers: connect.NewClient[v1.ListManufacturersRequest, v1.ListManufacturersResponse](
+			httpClient,
+			baseURL+ManufacturerServiceListManufacturersProcedure,
+			connect.WithSchema(manufacturerServiceListManufacturersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamManufacturers: connect.NewClient[v1.StreamManufacturersRequest, v1.StreamManufacturersResponse](
+			httpClient,
+			baseURL+ManufacturerServiceStreamManufacturersProcedure,
+			connect.WithSchema(manufacturerServiceStreamManufacturersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// manufacturerServiceClient implements ManufacturerServiceClient.
+type manufacturerServiceClient struct {
+	getManufacturers    *connect.Client[v1.GetManufacturersRequest, v1.GetManufacturersResponse]
+	listManufacturers   *connect.Client[v1.ListManufacturersRequest, v1.ListManufacturersResponse]
+	streamManufacturers *connect.Client[v1.StreamManufacturersRequest, v1.StreamManufacturersResponse]
+}
+
+// GetManufacturers calls buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.GetManufacturers.
+func (c *manufacturerServiceClient) GetManufacturers(ctx context.Context, req *connect.Request[v1.GetManufacturersRequest]) (*connect.Response[v1.GetManufacturersResponse], error) {
+	return c.getManufacturers.CallUnary(ctx, req)
+}
+
+// ListManufacturers calls
+// buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.ListManufacturers.
+func (c *manufacturerServiceClient) ListManufacturers(ctx context.Context, req *connect.Request[v1.ListManufacturersRequest]) (*connect.Response[v1.ListManufacturersResponse], error) {
+	return c.listManufacturers.CallUnary(ctx, req)
+}
+
+// StreamManufacturers calls
+// buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.StreamManufacturers.
+func (c *manufacturerServiceClient) StreamManufacturers(ctx context.Context, req *connect.Request[v1.StreamManufacturersRequest]) (*connect.ServerStreamForClient[v1.StreamManufacturersResponse], error) {
+	return c.streamManufacturers.CallServerStream(ctx, req)
+}
+
+// ManufacturerServiceHandler is an implementation of the
+// buf.knit.demo.swapi.manufacturer.v1.ManufacturerService service.
+type ManufacturerServiceHandler interface {
+	GetManufacturers(context.Context, *connect.Request[v1.GetManufacturersRequest]) (*connect.Response[v1.GetManufacturersResponse], error)
+	ListManufacturers(context.Context, *connect.Request[v1.ListManufacturersRequest]) (*connect.Response[v1.ListManufacturersResponse], error)
+	// StreamManufacturers streams all manufacturers, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListManufacturers.
+	StreamManufacturers(context.Context, *connect.Request[v1.StreamManufacturersRequest], *connect.ServerStream[v1.StreamManufacturersResponse]) error
+}
+
+// NewManufacturerServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewManufacturerServiceHandler(svc ManufacturerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	manufacturerServiceGetManufacturersHandler := connect.NewUnaryHandler(
+		ManufacturerServiceGetManufacturersProcedure,
+		svc.GetManufacturers,
+		connect.WithSchema(manufacturerServiceGetManufacturersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	manufacturerServiceListManufacturersHandler := connect.NewUnaryHandler(
+		ManufacturerServiceListManufacturersProcedure,
+		svc.ListManufacturers,
+		connect.WithSchema(manufacturerServiceListManufacturersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	manufacturerServiceStreamManufacturersHandler := connect.NewServerStreamHandler(
+		ManufacturerServiceStreamManufacturersProcedure,
+		svc.StreamManufacturers,
+		connect.WithSchema(manufacturerServiceStreamManufacturersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.manufacturer.v1.ManufacturerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ManufacturerServiceGetManufacturersProcedure:
+			manufacturerServiceGetManufacturersHandler.ServeHTTP(w, r)
+		case ManufacturerServiceListManufacturersProcedure:
+			manufacturerServiceListManufacturersHandler.ServeHTTP(w, r)
+		case ManufacturerServiceStreamManufacturersProcedure:
+			manufacturerServiceStreamManufacturersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedManufacturerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedManufacturerServiceHandler struct{}
+
+func (UnimplementedManufacturerServiceHandler) GetManufacturers(context.Context, *connect.Request[v1.GetManufacturersRequest]) (*connect.Response[v1.GetManufacturersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.GetManufacturers is not implemented"))
+}
+
+func (UnimplementedManufacturerServiceHandler) ListManufacturers(context.Context, *connect.Request[v1.ListManufacturersRequest]) (*connect.Response[v1.ListManufacturersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.ListManufacturers is not implemented"))
+}
+
+func (UnimplementedManufacturerServiceHandler) StreamManufacturers(context.Context, *connect.Request[v1.StreamManufacturersRequest], *connect.ServerStream[v1.StreamManufacturersResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.manufacturer.v1.ManufacturerService.StreamManufacturers is not implemented"))
+}
@@ -0,0 +1,1725 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/person/v1/person.proto
+
+package personv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Era is an era of the Star Wars calendar.
+type Era int32
+
+const (
+	Era_ERA_UNSPECIFIED Era = 0
+	// Before the Battle of Yavin.
+	Era_ERA_BBY Era = 1
+	// After the Battle of Yavin.
+	Era_ERA_ABY Era = 2
+)
+
+// Enum value maps for Era.
+var (
+	Era_name = map[int32]string{
+		0: "ERA_UNSPECIFIED",
+		1: "ERA_BBY",
+		2: "ERA_ABY",
+	}
+	Era_value = map[string]int32{
+		"ERA_UNSPECIFIED": 0,
+		"ERA_BBY":         1,
+		"ERA_ABY":         2,
+	}
+)
+
+func (x Era) Enum() *Era {
+	p := new(Era)
+	*p = x
+	return p
+}
+
+func (x Era) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Era) Descriptor() protoreflect.EnumDescriptor {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_enumTypes[0].Descriptor()
+}
+
+func (Era) Type() protoreflect.EnumType {
+	return &file_buf_knit_demo_swapi_person_v1_person_proto_enumTypes[0]
+}
+
+func (x Era) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Era.Descriptor instead.
+func (Era) EnumDescriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{0}
+}
+
+type Person struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The person's mass, as it appears in swapi.dev data, such as "1,358" or
+	// "unknown". See mass_kg for the numeric value.
+	Mass      string `protobuf:"bytes,3,opt,name=mass,proto3" json:"mass,omitempty"`
+	HairColor string `protobuf:"bytes,4,opt,name=hair_color,json=hairColor,proto3" json:"hair_color,omitempty"`
+	SkinColor string `protobuf:"bytes,5,opt,name=skin_color,json=skinColor,proto3" json:"skin_color,omitempty"`
+	EyeColor  string `protobuf:"bytes,6,opt,name=eye_color,json=eyeColor,proto3" json:"eye_color,omitempty"`
+	// The person's birth year, as it appears in swapi.dev data, such as
+	// "19BBY" or "unknown". See birth_year_value for the structured value.
+	BirthYear   string                 `protobuf:"bytes,7,opt,name=birth_year,json=birthYear,proto3" json:"birth_year,omitempty"`
+	Gender      string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	HomeworldId string                 `protobuf:"bytes,9,opt,name=homeworld_id,json=homeworldId,proto3" json:"homeworld_id,omitempty"`
+	SpeciesIds  []string               `protobuf:"bytes,10,rep,name=species_ids,json=speciesIds,proto3" json:"species_ids,omitempty"`
+	FilmIds     []string               `protobuf:"bytes,11,rep,name=film_ids,json=filmIds,proto3" json:"film_ids,omitempty"`
+	StarshipIds []string               `protobuf:"bytes,12,rep,name=starship_ids,json=starshipIds,proto3" json:"starship_ids,omitempty"`
+	VehicleIds  []string               `protobuf:"bytes,13,rep,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	Created     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created,proto3" json:"created,omitempty"`
+	Edited      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=edited,proto3" json:"edited,omitempty"`
+	// The person's height, in centimeters. Absent if unknown.
+	HeightCm *float64 `protobuf:"fixed64,16,opt,name=height_cm,json=heightCm,proto3,oneof" json:"height_cm,omitempty"`
+	// The person's mass, in kilograms. This is computed by the server from
+	// mass and is ignored in requests. Absent if unknown.
+	MassKg *float64 `protobuf:"fixed64,17,opt,name=mass_kg,json=massKg,proto3,oneof" json:"mass_kg,omitempty"`
+	// The person's birth year. This is computed by the server from birth_year
+	// and is ignored in requests. Absent if unknown.
+	BirthYearValue *BirthYear `protobuf:"bytes,18,opt,name=birth_year_value,json=birthYearValue,proto3" json:"birth_year_value,omitempty"`
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Person) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Person) GetMass() string {
+	if x != nil {
+		return x.Mass
+	}
+	return ""
+}
+
+func (x *Person) GetHairColor() string {
+	if x != nil {
+		return x.HairColor
+	}
+	return ""
+}
+
+func (x *Person) GetSkinColor() string {
+	if x != nil {
+		return x.SkinColor
+	}
+	return ""
+}
+
+func (x *Person) GetEyeColor() string {
+	if x != nil {
+		return x.EyeColor
+	}
+	return ""
+}
+
+func (x *Person) GetBirthYear() string {
+	if x != nil {
+		return x.BirthYear
+	}
+	return ""
+}
+
+func (x *Person) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Person) GetHomeworldId() string {
+	if x != nil {
+		return x.HomeworldId
+	}
+	return ""
+}
+
+func (x *Person) GetSpeciesIds() []string {
+	if x != nil {
+		return x.SpeciesIds
+	}
+	return nil
+}
+
+func (x *Person) GetFilmIds() []string {
+	if x != nil {
+		return x.FilmIds
+	}
+	return nil
+}
+
+func (x *Person) GetStarshipIds() []string {
+	if x != nil {
+		return x.StarshipIds
+	}
+	return nil
+}
+
+func (x *Person) GetVehicleIds() []string {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *Person) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Person) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *Person) GetHeightCm() float64 {
+	if x != nil && x.HeightCm != nil {
+		return *x.HeightCm
+	}
+	return 0
+}
+
+func (x *Person) GetMassKg() float64 {
+	if x != nil && x.MassKg != nil {
+		return *x.MassKg
+	}
+	return 0
+}
+
+func (x *Person) GetBirthYearValue() *BirthYear {
+	if x != nil {
+		return x.BirthYearValue
+	}
+	return nil
+}
+
+// BirthYear is a year in the Star Wars calendar, which counts years before
+// and after the Battle of Yavin.
+type BirthYear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The era of the year.
+	Era Era `protobuf:"varint,1,opt,name=era,proto3,enum=buf.knit.demo.swapi.person.v1.Era" json:"era,omitempty"`
+	// The number of years before or after the Battle of Yavin, depending on
+	// the era. This may be fractional, as in "41.9BBY".
+	Year float64 `protobuf:"fixed64,2,opt,name=year,proto3" json:"year,omitempty"`
+	// The year on a single timeline, which is negative before the Battle of
+	// Yavin and positive after it. Use this field to compare or sort years.
+	AbsoluteYear float64 `protobuf:"fixed64,3,opt,name=absolute_year,json=absoluteYear,proto3" json:"absolute_year,omitempty"`
+}
+
+func (x *BirthYear) Reset() {
+	*x = BirthYear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BirthYear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BirthYear) ProtoMessage() {}
+
+func (x *BirthYear) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BirthYear.ProtoReflect.Descriptor instead.
+func (*BirthYear) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BirthYear) GetEra() Era {
+	if x != nil {
+		return x.Era
+	}
+	return Era_ERA_UNSPECIFIED
+}
+
+func (x *BirthYear) GetYear() float64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *BirthYear) GetAbsoluteYear() float64 {
+	if x != nil {
+		return x.AbsoluteYear
+	}
+	return 0
+}
+
+type GetPeopleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If false, the request fails with a NOT_FOUND error when any of the ids
+	// does not refer to an existing entity. If true, the response contains
+	// the entities that were found, and errors describes the ids that were
+	// not.
+	AllowMissing bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of people fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetPeopleRequest) Reset() {
+	*x = GetPeopleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeopleRequest) ProtoMessage() {}
+
+func (x *GetPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeopleRequest.ProtoReflect.Descriptor instead.
+func (*GetPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPeopleRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetPeopleRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+func (x *GetPeopleRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetPeopleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entities that were found, in the order of the request's ids.
+	People []*Person `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"`
+	// The ids that could not be returned. Only populated when allow_missing
+	// is true in the request.
+	Errors []*v1.EntityError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *GetPeopleResponse) Reset() {
+	*x = GetPeopleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeopleResponse) ProtoMessage() {}
+
+func (x *GetPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeopleResponse.ProtoReflect.Descriptor instead.
+func (*GetPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPeopleResponse) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+func (x *GetPeopleResponse) GetErrors() []*v1.EntityError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListPeopleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An optional filter expression, in the style of AIP-160, that limits the
+	// results to matching entities. For example: `name:"sky" AND film_ids:1`.
+	// If the filter is invalid, the request fails with an INVALID_ARGUMENT
+	// error.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// An optional comma-separated list of fields to sort the results by, in
+	// the style of AIP-132. Each field may be followed by "asc" or "desc", for
+	// example: "population desc, name". Entities that compare equal remain in
+	// their default order. The page_token must have been returned by a request
+	// with the same order_by, or else the request fails with an
+	// INVALID_ARGUMENT error.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of people fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListPeopleRequest) Reset() {
+	*x = ListPeopleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleRequest) ProtoMessage() {}
+
+func (x *ListPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeopleRequest.ProtoReflect.Descriptor instead.
+func (*ListPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPeopleRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPeopleRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPeopleRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListPeopleRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPeopleRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListPeopleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	People        []*Person `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPeopleResponse) Reset() {
+	*x = ListPeopleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeopleResponse) ProtoMessage() {}
+
+func (x *ListPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeopleResponse.ProtoReflect.Descriptor instead.
+func (*ListPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPeopleResponse) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+func (x *ListPeopleResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamPeopleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional filter expression, with the same syntax as the filter of
+	// ListPeopleRequest.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The maximum number of people in each response. If zero, defaults to
+	// 100. Values greater than 1000 are treated as 1000.
+	ChunkSize int32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *StreamPeopleRequest) Reset() {
+	*x = StreamPeopleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPeopleRequest) ProtoMessage() {}
+
+func (x *StreamPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPeopleRequest.ProtoReflect.Descriptor instead.
+func (*StreamPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamPeopleRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *StreamPeopleRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamPeopleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of people. All chunks of a stream come from the same
+	// version of the data, in the same order as ListPeople.
+	People []*Person `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"`
+}
+
+func (x *StreamPeopleResponse) Reset() {
+	*x = StreamPeopleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPeopleResponse) ProtoMessage() {}
+
+func (x *StreamPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPeopleResponse.ProtoReflect.Descriptor instead.
+func (*StreamPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamPeopleResponse) GetPeople() []*Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+type CreatePersonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The person to create. If its id is empty, the server assigns one. The
+	// created and edited timestamps are always assigned by the server.
+	// All of the ids it refers to must refer to existing entities. The
+	// inverse relations of those entities are updated to refer back to the
+	// new person.
+	Person *Person `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+}
+
+func (x *CreatePersonRequest) Reset() {
+	*x = CreatePersonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonRequest) ProtoMessage() {}
+
+func (x *CreatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonRequest.ProtoReflect.Descriptor instead.
+func (*CreatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreatePersonRequest) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type CreatePersonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The person that was created.
+	Person *Person `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+}
+
+func (x *CreatePersonResponse) Reset() {
+	*x = CreatePersonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePersonResponse) ProtoMessage() {}
+
+func (x *CreatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePersonResponse.ProtoReflect.Descriptor instead.
+func (*CreatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreatePersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type UpdatePersonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The person to update. Its id identifies the person to update. If its edited
+	// timestamp is present, it must match the current edited timestamp of the
+	// person, or else the update fails with an ABORTED error. Clients should
+	// set it to the value they last read to avoid overwriting concurrent
+	// changes. As with CreatePerson, all ids it refers to must refer to existing
+	// entities, and the inverse relations of those entities are updated.
+	Person *Person `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	// The fields to update. If absent, the fields that are populated in the
+	// request are updated, as described by AIP-134, so fields cannot be cleared
+	// without a mask. The id, created, and edited fields cannot be updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdatePersonRequest) Reset() {
+	*x = UpdatePersonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonRequest) ProtoMessage() {}
+
+func (x *UpdatePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePersonRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdatePersonRequest) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *UpdatePersonRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdatePersonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The person after the update was applied.
+	Person *Person `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+}
+
+func (x *UpdatePersonResponse) Reset() {
+	*x = UpdatePersonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePersonResponse) ProtoMessage() {}
+
+func (x *UpdatePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePersonResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePersonResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdatePersonResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type DeletePersonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the person to delete.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If present, the person is only deleted if this matches its current
+	// edited timestamp. Otherwise, the delete fails with an ABORTED error.
+	Edited *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=edited,proto3" json:"edited,omitempty"`
+	// If false, the delete fails with a FAILED_PRECONDITION error when any
+	// other entity refers to the person. If true, all references to the person
+	// are removed from other entities.
+	Cascade bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DeletePersonRequest) Reset() {
+	*x = DeletePersonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonRequest) ProtoMessage() {}
+
+func (x *DeletePersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonRequest.ProtoReflect.Descriptor instead.
+func (*DeletePersonRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeletePersonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletePersonRequest) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *DeletePersonRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DeletePersonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePersonResponse) Reset() {
+	*x = DeletePersonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePersonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePersonResponse) ProtoMessage() {}
+
+func (x *DeletePersonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePersonResponse.ProtoReflect.Descriptor instead.
+func (*DeletePersonResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{13}
+}
+
+type WatchPeopleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If present, the stream resumes after the event that returned this
+	// token. Otherwise, it starts with the next change. Only a limited
+	// number of recent changes are retained, so if the token refers to a
+	// change that is no longer retained, or was returned by a different
+	// server process, the request fails with an OUT_OF_RANGE error. Clients
+	// should then watch again with initial_snapshot set.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// If true, the stream starts with a SNAPSHOT event for every existing
+	// person. Cannot be combined with resume_token.
+	InitialSnapshot bool `protobuf:"varint,2,opt,name=initial_snapshot,json=initialSnapshot,proto3" json:"initial_snapshot,omitempty"`
+}
+
+func (x *WatchPeopleRequest) Reset() {
+	*x = WatchPeopleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPeopleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPeopleRequest) ProtoMessage() {}
+
+func (x *WatchPeopleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPeopleRequest.ProtoReflect.Descriptor instead.
+func (*WatchPeopleRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchPeopleRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchPeopleRequest) GetInitialSnapshot() bool {
+	if x != nil {
+		return x.InitialSnapshot
+	}
+	return false
+}
+
+type WatchPeopleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of change.
+	ChangeType v1.ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=buf.knit.demo.swapi.common.v1.ChangeType" json:"change_type,omitempty"`
+	// The person after the change, or before it for deletes. Absent for
+	// checkpoints.
+	Person *Person `protobuf:"bytes,2,opt,name=person,proto3" json:"person,omitempty"`
+	// A token to resume the stream after this event.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchPeopleResponse) Reset() {
+	*x = WatchPeopleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPeopleResponse) ProtoMessage() {}
+
+func (x *WatchPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPeopleResponse.ProtoReflect.Descriptor instead.
+func (*WatchPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchPeopleResponse) GetChangeType() v1.ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return v1.ChangeType(0)
+}
+
+func (x *WatchPeopleResponse) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *WatchPeopleResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_person_v1_person_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_person_v1_person_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x62, 0x75, 0x66,
+	0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x05, 0x0a, 0x06, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x68, 0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x68, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x6b, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x6b, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x79, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x79, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69,
+	0x72, 0x74, 0x68, 0x59, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x49, 0x64,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x09,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x08, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1c,
+	0x0a, 0x07, 0x6d, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x01, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x73, 0x4b, 0x67, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a, 0x10,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x72, 0x74, 0x68, 0x59, 0x65, 0x61, 0x72,
+	0x52, 0x0e, 0x62, 0x69, 0x72, 0x74, 0x68, 0x59, 0x65, 0x61, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x6d, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x73, 0x73, 0x5f, 0x6b, 0x67, 0x22, 0x7a, 0x0a, 0x09, 0x42, 0x69,
+	0x72, 0x74, 0x68, 0x59, 0x65, 0x61, 0x72, 0x12, 0x34, 0x0a, 0x03, 0x65, 0x72, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x61, 0x52, 0x03, 0x65, 0x72, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x65, 0x59, 0x65, 0x61, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x96, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65,
+	0x12, 0x42, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6f,
+	0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x22, 0x7b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x4c, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x55, 0x0a,
+	0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65,
+	0x6f, 0x70, 0x6c, 0x65, 0x22, 0x54, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x14, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x22, 0x91, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x55, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64,
+	0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xc3, 0x01,
+	0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x2a, 0x34, 0x0a, 0x03, 0x45, 0x72, 0x61, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52,
+	0x41, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x45, 0x52, 0x41, 0x5f, 0x42, 0x42, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x45, 0x52, 0x41, 0x5f, 0x41, 0x42, 0x59, 0x10, 0x02, 0x32, 0xe9, 0x06, 0x0a, 0x0d, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x73, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x2f, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6f, 0x70,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x75, 0x66, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6f,
+	0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x12, 0x76, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x30,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x7e, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x77, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x77, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x02, 0x12, 0x7b, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x65, 0x6f,
+	0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x90, 0x02, 0x01, 0x30, 0x01, 0x42, 0x98, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f,
+	0x6b, 0x6e, 0x69, 0x74, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b, 0x44, 0x53, 0x50, 0xaa,
+	0x02, 0x1d, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x1d, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x29, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c,
+	0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x42, 0x75,
+	0x66, 0x3a, 0x3a, 0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d, 0x6f, 0x3a, 0x3a, 0x53,
+	0x77, 0x61, 0x70, 0x69, 0x3a, 0x3a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_person_v1_person_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_person_v1_person_proto_rawDescData = file_buf_knit_demo_swapi_person_v1_person_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_person_v1_person_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_person_v1_person_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_person_v1_person_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_person_v1_person_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_person_v1_person_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_person_v1_person_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_buf_knit_demo_swapi_person_v1_person_proto_goTypes = []interface{}{
+	(Era)(0),                      // 0: buf.knit.demo.swapi.person.v1.Era
+	(*Person)(nil),                // 1: buf.knit.demo.swapi.person.v1.Person
+	(*BirthYear)(nil),             // 2: buf.knit.demo.swapi.person.v1.BirthYear
+	(*GetPeopleRequest)(nil),      // 3: buf.knit.demo.swapi.person.v1.GetPeopleRequest
+	(*GetPeopleResponse)(nil),     // 4: buf.knit.demo.swapi.person.v1.GetPeopleResponse
+	(*ListPeopleRequest)(nil),     // 5: buf.knit.demo.swapi.person.v1.ListPeopleRequest
+	(*ListPeopleResponse)(nil),    // 6: buf.knit.demo.swapi.person.v1.ListPeopleResponse
+	(*StreamPeopleRequest)(nil),   // 7: buf.knit.demo.swapi.person.v1.StreamPeopleRequest
+	(*StreamPeopleResponse)(nil),  // 8: buf.knit.demo.swapi.person.v1.StreamPeopleResponse
+	(*CreatePersonRequest)(nil),   // 9: buf.knit.demo.swapi.person.v1.CreatePersonRequest
+	(*CreatePersonResponse)(nil),  // 10: buf.knit.demo.swapi.person.v1.CreatePersonResponse
+	(*UpdatePersonRequest)(nil),   // 11: buf.knit.demo.swapi.person.v1.UpdatePersonRequest
+	(*UpdatePersonResponse)(nil),  // 12: buf.knit.demo.swapi.person.v1.UpdatePersonResponse
+	(*DeletePersonRequest)(nil),   // 13: buf.knit.demo.swapi.person.v1.DeletePersonRequest
+	(*DeletePersonResponse)(nil),  // 14: buf.knit.demo.swapi.person.v1.DeletePersonResponse
+	(*WatchPeopleRequest)(nil),    // 15: buf.knit.demo.swapi.person.v1.WatchPeopleRequest
+	(*WatchPeopleResponse)(nil),   // 16: buf.knit.demo.swapi.person.v1.WatchPeopleResponse
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 18: google.protobuf.FieldMask
+	(*v1.EntityError)(nil),        // 19: buf.knit.demo.swapi.common.v1.EntityError
+	(v1.ChangeType)(0),            // 20: buf.knit.demo.swapi.common.v1.ChangeType
+}
+var file_buf_knit_demo_swapi_person_v1_person_proto_depIdxs = []int32{
+	17, // 0: buf.knit.demo.swapi.person.v1.Person.created:type_name -> google.protobuf.Timestamp
+	17, // 1: buf.knit.demo.swapi.person.v1.Person.edited:type_name -> google.protobuf.Timestamp
+	2,  // 2: buf.knit.demo.swapi.person.v1.Person.birth_year_value:type_name -> buf.knit.demo.swapi.person.v1.BirthYear
+	0,  // 3: buf.knit.demo.swapi.person.v1.BirthYear.era:type_name -> buf.knit.demo.swapi.person.v1.Era
+	18, // 4: buf.knit.demo.swapi.person.v1.GetPeopleRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 5: buf.knit.demo.swapi.person.v1.GetPeopleResponse.people:type_name -> buf.knit.demo.swapi.person.v1.Person
+	19, // 6: buf.knit.demo.swapi.person.v1.GetPeopleResponse.errors:type_name -> buf.knit.demo.swapi.common.v1.EntityError
+	18, // 7: buf.knit.demo.swapi.person.v1.ListPeopleRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: buf.knit.demo.swapi.person.v1.ListPeopleResponse.people:type_name -> buf.knit.demo.swapi.person.v1.Person
+	1,  // 9: buf.knit.demo.swapi.person.v1.StreamPeopleResponse.people:type_name -> buf.knit.demo.swapi.person.v1.Person
+	1,  // 10: buf.knit.demo.swapi.person.v1.CreatePersonRequest.person:type_name -> buf.knit.demo.swapi.person.v1.Person
+	1,  // 11: buf.knit.demo.swapi.person.v1.CreatePersonResponse.person:type_name -> buf.knit.demo.swapi.person.v1.Person
+	1,  // 12: buf.knit.demo.swapi.person.v1.UpdatePersonRequest.person:type_name -> buf.knit.demo.swapi.person.v1.Person
+	18, // 13: buf.knit.demo.swapi.person.v1.UpdatePersonRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 14: buf.knit.demo.swapi.person.v1.UpdatePersonResponse.person:type_name -> buf.knit.demo.swapi.person.v1.Person
+	17, // 15: buf.knit.demo.swapi.person.v1.DeletePersonRequest.edited:type_name -> google.protobuf.Timestamp
+	20, // 16: buf.knit.demo.swapi.person.v1.WatchPeopleResponse.change_type:type_name -> buf.knit.demo.swapi.common.v1.ChangeType
+	1,  // 17: buf.knit.demo.swapi.person.v1.WatchPeopleResponse.person:type_name -> buf.knit.demo.swapi.person.v1.Person
+	3,  // 18: buf.knit.demo.swapi.person.v1.PersonService.GetPeople:input_type -> buf.knit.demo.swapi.person.v1.GetPeopleRequest
+	5,  // 19: buf.knit.demo.swapi.person.v1.PersonService.ListPeople:input_type -> buf.knit.demo.swapi.person.v1.ListPeopleRequest
+	7,  // 20: buf.knit.demo.swapi.person.v1.PersonService.StreamPeople:input_type -> buf.knit.demo.swapi.person.v1.StreamPeopleRequest
+	9,  // 21: buf.knit.demo.swapi.person.v1.PersonService.CreatePerson:input_type -> buf.knit.demo.swapi.person.v1.CreatePersonRequest
+	11, // 22: buf.knit.demo.swapi.person.v1.PersonService.UpdatePerson:input_type -> buf.knit.demo.swapi.person.v1.UpdatePersonRequest
+	13, // 23: buf.knit.demo.swapi.person.v1.PersonService.DeletePerson:input_type -> buf.knit.demo.swapi.person.v1.DeletePersonRequest
+	15, // 24: buf.knit.demo.swapi.person.v1.PersonService.WatchPeople:input_type -> buf.knit.demo.swapi.person.v1.WatchPeopleRequest
+	4,  // 25: buf.knit.demo.swapi.person.v1.PersonService.GetPeople:output_type -> buf.knit.demo.swapi.person.v1.GetPeopleResponse
+	6,  // 26: buf.knit.demo.swapi.person.v1.PersonService.ListPeople:output_type -> buf.knit.demo.swapi.person.v1.ListPeopleResponse
+	8,  // 27: buf.knit.demo.swapi.person.v1.PersonService.StreamPeople:output_type -> buf.knit.demo.swapi.person.v1.StreamPeopleResponse
+	10, // 28: buf.knit.demo.swapi.person.v1.PersonService.CreatePerson:output_type -> buf.knit.demo.swapi.person.v1.CreatePersonResponse
+	12, // 29: buf.knit.demo.swapi.person.v1.PersonService.UpdatePerson:output_type -> buf.knit.demo.swapi.person.v1.UpdatePersonResponse
+	14, // 30: buf.knit.demo.swapi.person.v1.PersonService.DeletePerson:output_type -> buf.knit.demo.swapi.person.v1.DeletePersonResponse
+	16, // 31: buf.knit.demo.swapi.person.v1.PersonService.WatchPeople:output_type -> buf.knit.demo.swapi.person.v1.WatchPeopleResponse
+	25, // [25:32] is the sub-list for method output_type
+	18, // [18:25] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_person_v1_person_proto_init() }
+func file_buf_knit_demo_swapi_person_v1_person_proto_init() {
+	if File_buf_knit_demo_swapi_person_v1_person_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Person); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BirthYear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeopleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeopleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeopleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeopleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPeopleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPeopleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePersonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePersonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePersonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPeopleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPeopleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_person_v1_person_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_person_v1_person_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_person_v1_person_proto_depIdxs,
+		EnumInfos:         file_buf_knit_demo_swapi_person_v1_person_proto_enumTypes,
+		MessageInfos:      file_buf_knit_demo_swapi_person_v1_person_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_person_v1_person_proto = out.File
+	file_buf_knit_demo_swapi_person_v1_person_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_person_v1_person_proto_goTypes = nil
+	file_buf_knit_demo_swapi_person_v1_person_proto_depIdxs = nil
+}
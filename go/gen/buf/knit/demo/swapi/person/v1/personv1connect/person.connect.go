@@ -0,0 +1,331 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/person/v1/person.proto
+
+package personv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PersonServiceName is the fully-qualified name of the PersonService service.
+	PersonServiceName = "buf.knit.demo.swapi.person.v1.PersonService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PersonServiceGetPeopleProcedure is the fully-qualified name of the PersonService's GetPeople RPC.
+	PersonServiceGetPeopleProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/GetPeople"
+	// PersonServiceListPeopleProcedure is the fully-qualified name of the PersonService's ListPeople
+	// RPC.
+	PersonServiceListPeopleProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/ListPeople"
+	// PersonServiceStreamPeopleProcedure is the fully-qualified name of the PersonService's
+	// StreamPeople RPC.
+	PersonServiceStreamPeopleProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/StreamPeople"
+	// PersonServiceCreatePersonProcedure is the fully-qualified name of the PersonService's
+	// CreatePerson RPC.
+	PersonServiceCreatePersonProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/CreatePerson"
+	// PersonServiceUpdatePersonProcedure is the fully-qualified name of the PersonService's
+	// UpdatePerson RPC.
+	PersonServiceUpdatePersonProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/UpdatePerson"
+	// PersonServiceDeletePersonProcedure is the fully-qualified name of the PersonService's
+	// DeletePerson RPC.
+	PersonServiceDeletePersonProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/DeletePerson"
+	// PersonServiceWatchPeopleProcedure is the fully-qualified name of the PersonService's WatchPeople
+	// RPC.
+	PersonServiceWatchPeopleProcedure = "/buf.knit.demo.swapi.person.v1.PersonService/WatchPeople"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	personServiceServiceDescriptor            = v1.File_buf_knit_demo_swapi_person_v1_person_proto.Services().ByName("PersonService")
+	personServiceGetPeopleMethodDescriptor    = personServiceServiceDescriptor.Methods().ByName("GetPeople")
+	personServiceListPeopleMethodDescriptor   = personServiceServiceDescriptor.Methods().ByName("ListPeople")
+	personServiceStreamPeopleMethodDescriptor = personServiceServiceDescriptor.Methods().ByName("StreamPeople")
+	personServiceCreatePersonMethodDescriptor = personServiceServiceDescriptor.Methods().ByName("CreatePerson")
+	personServiceUpdatePersonMethodDescriptor = personServiceServiceDescriptor.Methods().ByName("UpdatePerson")
+	personServiceDeletePersonMethodDescriptor = personServiceServiceDescriptor.Methods().ByName("DeletePerson")
+	personServiceWatchPeopleMethodDescriptor  = personServiceServiceDescriptor.Methods().ByName("WatchPeople")
+)
+
+// PersonServiceClient is a client for the buf.knit.demo.swapi.person.v1.PersonService service.
+type PersonServiceClient interface {
+	GetPeople(context.Context, *connect.Request[v1.GetPeopleRequest]) (*connect.Response[v1.GetPeopleResponse], error)
+	ListPeople(context.Context, *connect.Request[v1.ListPeopleRequest]) (*connect.Response[v1.ListPeopleResponse], error)
+	// StreamPeople streams all people, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListPeople.
+	StreamPeople(context.Context, *connect.Request[v1.StreamPeopleRequest]) (*connect.ServerStreamForClient[v1.StreamPeopleResponse], error)
+	// CreatePerson creates a new person.
+	CreatePerson(context.Context, *connect.Request[v1.CreatePersonRequest]) (*connect.Response[v1.CreatePersonResponse], error)
+	// UpdatePerson updates an existing person.
+	UpdatePerson(context.Context, *connect.Request[v1.UpdatePersonRequest]) (*connect.Response[v1.UpdatePersonResponse], error)
+	// DeletePerson deletes a person.
+	DeletePerson(context.Context, *connect.Request[v1.DeletePersonRequest]) (*connect.Response[v1.DeletePersonResponse], error)
+	// WatchPeople streams the changes to people, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchPeople(context.Context, *connect.Request[v1.WatchPeopleRequest]) (*connect.ServerStreamForClient[v1.WatchPeopleResponse], error)
+}
+
+// NewPersonServiceClient constructs a client for the buf.knit.demo.swapi.person.v1.PersonService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPersonServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PersonServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &personServiceClient{
+		getPeople: connect.NewClient[v1.GetPeopleRequest, v1.GetPeopleResponse](
+			httpClient,
+			baseURL+PersonServiceGetPeopleProcedure,
+			connect.WithSchema(personServiceGetPeopleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listPeople: connect.NewClient[v1.ListPeopleRequest, v1.ListPeopleResponse](
+			httpClient,
+			baseURL+PersonServiceListPeopleProcedure,
+			connect.WithSchema(personServiceListPeopleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamPeople: connect.NewClient[v1.StreamPeopleRequest, v1.StreamPeopleResponse](
+			httpClient,
+			baseURL+PersonServiceStreamPeopleProcedure,
+			connect.WithSchema(personServiceStreamPeopleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createPerson: connect.NewClient[v1.CreatePersonRequest, v1.CreatePersonResponse](
+			httpClient,
+			baseURL+PersonServiceCreatePersonProcedure,
+			connect.WithSchema(personServiceCreatePersonMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updatePerson: connect.NewClient[v1.UpdatePersonRequest, v1.UpdatePersonResponse](
+			httpClient,
+			baseURL+PersonServiceUpdatePersonProcedure,
+			connect.WithSchema(personServiceUpdatePersonMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deletePerson: connect.NewClient[v1.DeletePersonRequest, v1.DeletePersonResponse](
+			httpClient,
+			baseURL+PersonServiceDeletePersonProcedure,
+			connect.WithSchema(personServiceDeletePersonMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		watchPeople: connect.NewClient[v1.WatchPeopleRequest, v1.WatchPeopleResponse](
+			httpClient,
+			baseURL+PersonServiceWatchPeopleProcedure,
+			connect.WithSchema(personServiceWatchPeopleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// personServiceClient implements PersonServiceClient.
+type personServiceClient struct {
+	getPeople    *connect.Client[v1.GetPeopleRequest, v1.GetPeopleResponse]
+	listPeople   *connect.Client[v1.ListPeopleRequest, v1.ListPeopleResponse]
+	streamPeople *connect.Client[v1.StreamPeopleRequest, v1.StreamPeopleResponse]
+	createPerson *connect.Client[v1.CreatePersonRequest, v1.CreatePersonResponse]
+	updatePerson *connect.Client[v1.UpdatePersonRequest, v1.UpdatePersonResponse]
+	deletePerson *connect.Client[v1.DeletePersonRequest, v1.DeletePersonResponse]
+	watchPeople  *connect.Client[v1.WatchPeopleRequest, v1.WatchPeopleResponse]
+}
+
+// GetPeople calls buf.knit.demo.swapi.person.v1.PersonService.GetPeople.
+func (c *personServiceClient) GetPeople(ctx context.Context, req *connect.Request[v1.GetPeopleRequest]) (*connect.Response[v1.GetPeopleResponse], error) {
+	return c.getPeople.CallUnary(ctx, req)
+}
+
+// ListPeople calls buf.knit.demo.swapi.person.v1.PersonService.ListPeople.
+func (c *personServiceClient) ListPeople(ctx context.Context, req *connect.Request[v1.ListPeopleRequest]) (*connect.Response[v1.ListPeopleResponse], error) {
+	return c.listPeople.CallUnary(ctx, req)
+}
+
+// StreamPeople calls buf.knit.demo.swapi.person.v1.PersonService.StreamPeople.
+func (c *personServiceClient) StreamPeople(ctx context.Context, req *connect.Request[v1.StreamPeopleRequest]) (*connect.ServerStreamForClient[v1.StreamPeopleResponse], error) {
+	return c.streamPeople.CallServerStream(ctx, req)
+}
+
+// CreatePerson calls buf.knit.demo.swapi.person.v1.PersonService.CreatePerson.
+func (c *personServiceClient) CreatePerson(ctx context.Context, req *connect.Request[v1.CreatePersonRequest]) (*connect.Response[v1.CreatePersonResponse], error) {
+	return c.createPerson.CallUnary(ctx, req)
+}
+
+// UpdatePerson calls buf.knit.demo.swapi.person.v1.PersonService.UpdatePerson.
+func (c *personServiceClient) UpdatePerson(ctx context.Context, req *connect.Request[v1.UpdatePersonRequest]) (*connect.Response[v1.UpdatePersonResponse], error) {
+	return c.updatePerson.CallUnary(ctx, req)
+}
+
+// DeletePerson calls buf.knit.demo.swapi.person.v1.PersonService.DeletePerson.
+func (c *personServiceClient) DeletePerson(ctx context.Context, req *connect.Request[v1.DeletePersonRequest]) (*connect.Response[v1.DeletePersonResponse], error) {
+	return c.deletePerson.CallUnary(ctx, req)
+}
+
+// WatchPeople calls buf.knit.demo.swapi.person.v1.PersonService.WatchPeople.
+func (c *personServiceClient) WatchPeople(ctx context.Context, req *connect.Request[v1.WatchPeopleRequest]) (*connect.ServerStreamForClient[v1.WatchPeopleResponse], error) {
+	return c.watchPeople.CallServerStream(ctx, req)
+}
+
+// PersonServiceHandler is an implementation of the buf.knit.demo.swapi.person.v1.PersonService
+// service.
+type PersonServiceHandler interface {
+	GetPeople(context.Context, *connect.Request[v1.GetPeopleRequest]) (*connect.Response[v1.GetPeopleResponse], error)
+	ListPeople(context.Context, *connect.Request[v1.ListPeopleRequest]) (*connect.Response[v1.ListPeopleResponse], error)
+	// StreamPeople streams all people, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListPeople.
+	StreamPeople(context.Context, *connect.Request[v1.StreamPeopleRequest], *connect.ServerStream[v1.StreamPeopleResponse]) error
+	// CreatePerson creates a new person.
+	CreatePerson(context.Context, *connect.Request[v1.CreatePersonRequest]) (*connect.Response[v1.CreatePersonResponse], error)
+	// UpdatePerson updates an existing person.
+	UpdatePerson(context.Context, *connect.Request[v1.UpdatePersonRequest]) (*connect.Response[v1.UpdatePersonResponse], error)
+	// DeletePerson deletes a person.
+	DeletePerson(context.Context, *connect.Request[v1.DeletePersonRequest]) (*connect.Response[v1.DeletePersonResponse], error)
+	// WatchPeople streams the changes to people, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchPeople(context.Context, *connect.Request[v1.WatchPeopleRequest], *connect.ServerStream[v1.WatchPeopleResponse]) error
+}
+
+// NewPersonServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPersonServiceHandler(svc PersonServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	personServiceGetPeopleHandler := connect.NewUnaryHandler(
+		PersonServiceGetPeopleProcedure,
+		svc.GetPeople,
+		connect.WithSchema(personServiceGetPeopleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personServiceListPeopleHandler := connect.NewUnaryHandler(
+		PersonServiceListPeopleProcedure,
+		svc.ListPeople,
+		connect.WithSchema(personServiceListPeopleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personServiceStreamPeopleHandler := connect.NewServerStreamHandler(
+		PersonServiceStreamPeopleProcedure,
+		svc.StreamPeople,
+		connect.WithSchema(personServiceStreamPeopleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personServiceCreatePersonHandler := connect.NewUnaryHandler(
+		PersonServiceCreatePersonProcedure,
+		svc.CreatePerson,
+		connect.WithSchema(personServiceCreatePersonMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	personServiceUpdatePersonHandler := connect.NewUnaryHandler(
+		PersonServiceUpdatePersonProcedure,
+		svc.UpdatePerson,
+		connect.WithSchema(personServiceUpdatePersonMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	personServiceDeletePersonHandler := connect.NewUnaryHandler(
+		PersonServiceDeletePersonProcedure,
+		svc.DeletePerson,
+		connect.WithSchema(personServiceDeletePersonMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	personServiceWatchPeopleHandler := connect.NewServerStreamHandler(
+		PersonServiceWatchPeopleProcedure,
+		svc.WatchPeople,
+		connect.WithSchema(personServiceWatchPeopleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.person.v1.PersonService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PersonServiceGetPeopleProcedure:
+			personServiceGetPeopleHandler.ServeHTTP(w, r)
+		case PersonServiceListPeopleProcedure:
+			personServiceListPeopleHandler.ServeHTTP(w, r)
+		case PersonServiceStreamPeopleProcedure:
+			personServiceStreamPeopleHandler.ServeHTTP(w, r)
+		case PersonServiceCreatePersonProcedure:
+			personServiceCreatePersonHandler.ServeHTTP(w, r)
+		case PersonServiceUpdatePersonProcedure:
+			personServiceUpdatePersonHandler.ServeHTTP(w, r)
+		case PersonServiceDeletePersonProcedure:
+			personServiceDeletePersonHandler.ServeHTTP(w, r)
+		case PersonServiceWatchPeopleProcedure:
+			personServiceWatchPeopleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPersonServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPersonServiceHandler struct{}
+
+func (UnimplementedPersonServiceHandler) GetPeople(context.Context, *connect.Request[v1.GetPeopleRequest]) (*connect.Response[v1.GetPeopleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.GetPeople is not implemented"))
+}
+
+func (UnimplementedPersonServiceHandler) ListPeople(context.Context, *connect.Request[v1.ListPeopleRequest]) (*connect.Response[v1.ListPeopleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.ListPeople is not implemented"))
+}
+
+func (UnimplementedPersonServiceHandler) StreamPeople(context.Context, *connect.Request[v1.StreamPeopleRequest], *connect.ServerStream[v1.StreamPeopleResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.StreamPeople is not implemented"))
+}
+
+func (UnimplementedPersonServiceHandler) CreatePerson(context.Context, *connect.Request[v1.CreatePersonRequest]) (*connect.Response[v1.CreatePersonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.CreatePerson is not implemented"))
+}
+
+func (UnimplementedPersonServiceHandler) UpdatePerson(context.Context, *connect.Request[v1.UpdatePersonRequest]) (*connect.Response[v1.UpdatePersonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.UpdatePerson is not implemented"))
+}
+
+func (UnimplementedPersonServiceHandler) DeletePerson(context.Context, *connect.Request[v1.DeletePersonRequest]) (*connect.Response[v1.DeletePersonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.DeletePerson is not implemented"))
+}
+
+func (UnimplementedPersonServiceHandler) WatchPeople(context.Context, *connect.Request[v1.WatchPeopleRequest], *connect.ServerStream[v1.WatchPeopleResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.person.v1.PersonService.WatchPeople is not implemented"))
+}
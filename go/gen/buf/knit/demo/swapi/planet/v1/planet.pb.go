@@ -0,0 +1,1775 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/planet/v1/planet.proto
+
+package planetv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Climate is the canonical vocabulary of planet climates.
+type Climate int32
+
+const (
+	Climate_CLIMATE_UNSPECIFIED          Climate = 0
+	Climate_CLIMATE_ARCTIC               Climate = 1
+	Climate_CLIMATE_ARID                 Climate = 2
+	Climate_CLIMATE_ARTIFICIAL_TEMPERATE Climate = 3
+	Climate_CLIMATE_FRIGID               Climate = 4
+	Climate_CLIMATE_FROZEN               Climate = 5
+	Climate_CLIMATE_HOT                  Climate = 6
+	Climate_CLIMATE_HUMID                Climate = 7
+	Climate_CLIMATE_MOIST                Climate = 8
+	Climate_CLIMATE_MURKY                Climate = 9
+	Climate_CLIMATE_POLLUTED             Climate = 10
+	Climate_CLIMATE_ROCKY                Climate = 11
+	Climate_CLIMATE_SUBARCTIC            Climate = 12
+	Climate_CLIMATE_SUPERHEATED          Climate = 13
+	Climate_CLIMATE_TEMPERATE            Climate = 14
+	Climate_CLIMATE_TROPICAL             Climate = 15
+	Climate_CLIMATE_WINDY                Climate = 16
+)
+
+// Enum value maps for Climate.
+var (
+	Climate_name = map[int32]string{
+		0:  "CLIMATE_UNSPECIFIED",
+		1:  "CLIMATE_ARCTIC",
+		2:  "CLIMATE_ARID",
+		3:  "CLIMATE_ARTIFICIAL_TEMPERATE",
+		4:  "CLIMATE_FRIGID",
+		5:  "CLIMATE_FROZEN",
+		6:  "CLIMATE_HOT",
+		7:  "CLIMATE_HUMID",
+		8:  "CLIMATE_MOIST",
+		9:  "CLIMATE_MURKY",
+		10: "CLIMATE_POLLUTED",
+		11: "CLIMATE_ROCKY",
+		12: "CLIMATE_SUBARCTIC",
+		13: "CLIMATE_SUPERHEATED",
+		14: "CLIMATE_TEMPERATE",
+		15: "CLIMATE_TROPICAL",
+		16: "CLIMATE_WINDY",
+	}
+	Climate_value = map[string]int32{
+		"CLIMATE_UNSPECIFIED":          0,
+		"CLIMATE_ARCTIC":               1,
+		"CLIMATE_ARID":                 2,
+		"CLIMATE_ARTIFICIAL_TEMPERATE": 3,
+		"CLIMATE_FRIGID":               4,
+		"CLIMATE_FROZEN":               5,
+		"CLIMATE_HOT":                  6,
+		"CLIMATE_HUMID":                7,
+		"CLIMATE_MOIST":                8,
+		"CLIMATE_MURKY":                9,
+		"CLIMATE_POLLUTED":             10,
+		"CLIMATE_ROCKY":                11,
+		"CLIMATE_SUBARCTIC":            12,
+		"CLIMATE_SUPERHEATED":          13,
+		"CLIMATE_TEMPERATE":            14,
+		"CLIMATE_TROPICAL":             15,
+		"CLIMATE_WINDY":                16,
+	}
+)
+
+func (x Climate) Enum() *Climate {
+	p := new(Climate)
+	*p = x
+	return p
+}
+
+func (x Climate) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Climate) Descriptor() protoreflect.EnumDescriptor {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_enumTypes[0].Descriptor()
+}
+
+func (Climate) Type() protoreflect.EnumType {
+	return &file_buf_knit_demo_swapi_planet_v1_planet_proto_enumTypes[0]
+}
+
+func (x Climate) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Climate.Descriptor instead.
+func (Climate) EnumDescriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{0}
+}
+
+type Planet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The planet's climates, in lower case, such as "temperate". Known
+	// misspellings are corrected, and "unknown" is represented by an empty
+	// list.
+	Climates []string `protobuf:"bytes,2,rep,name=climates,proto3" json:"climates,omitempty"`
+	// The diameter of the planet, in kilometers. Absent if unknown.
+	Diameter *int32 `protobuf:"varint,3,opt,name=diameter,proto3,oneof" json:"diameter,omitempty"`
+	// The planet's gravity, as it appears in swapi.dev data, such as
+	// "1 standard" or "unknown". See gravity_range for the numeric value.
+	Gravity string `protobuf:"bytes,4,opt,name=gravity,proto3" json:"gravity,omitempty"`
+	Name    string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of standard days in a year on the planet. Absent if
+	// unknown.
+	OrbitalPeriod *int32 `protobuf:"varint,6,opt,name=orbital_period,json=orbitalPeriod,proto3,oneof" json:"orbital_period,omitempty"`
+	// The population of the planet. Absent if unknown.
+	Population *float64 `protobuf:"fixed64,7,opt,name=population,proto3,oneof" json:"population,omitempty"`
+	// The number of standard hours in a day on the planet. Absent if
+	// unknown.
+	RotationPeriod *int32 `protobuf:"varint,8,opt,name=rotation_period,json=rotationPeriod,proto3,oneof" json:"rotation_period,omitempty"`
+	// The percentage of the surface that is covered by water. Absent if
+	// unknown.
+	SurfaceWater *float64 `protobuf:"fixed64,9,opt,name=surface_water,json=surfaceWater,proto3,oneof" json:"surface_water,omitempty"`
+	// The planet's terrains, in lower case, such as "grasslands". "unknown"
+	// is represented by an empty list.
+	Terrains    []string               `protobuf:"bytes,10,rep,name=terrains,proto3" json:"terrains,omitempty"`
+	ResidentIds []string               `protobuf:"bytes,11,rep,name=resident_ids,json=residentIds,proto3" json:"resident_ids,omitempty"`
+	FilmIds     []string               `protobuf:"bytes,12,rep,name=film_ids,json=filmIds,proto3" json:"film_ids,omitempty"`
+	Created     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created,proto3" json:"created,omitempty"`
+	Edited      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=edited,proto3" json:"edited,omitempty"`
+	// The canonical form of each of the climates. This is computed by the
+	// server from climates and is ignored in requests. Climates that are not
+	// in the vocabulary of the Climate enum are omitted.
+	ClimateTypes []Climate `protobuf:"varint,15,rep,packed,name=climate_types,json=climateTypes,proto3,enum=buf.knit.demo.swapi.planet.v1.Climate" json:"climate_types,omitempty"`
+	// The range of the planet's gravity. This is computed by the server from
+	// gravity and is ignored in requests. Absent if unknown.
+	GravityRange *GravityRange `protobuf:"bytes,16,opt,name=gravity_range,json=gravityRange,proto3" json:"gravity_range,omitempty"`
+}
+
+func (x *Planet) Reset() {
+	*x = Planet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Planet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Planet) ProtoMessage() {}
+
+func (x *Planet) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Planet.ProtoReflect.Descriptor instead.
+func (*Planet) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Planet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Planet) GetClimates() []string {
+	if x != nil {
+		return x.Climates
+	}
+	return nil
+}
+
+func (x *Planet) GetDiameter() int32 {
+	if x != nil && x.Diameter != nil {
+		return *x.Diameter
+	}
+	return 0
+}
+
+func (x *Planet) GetGravity() string {
+	if x != nil {
+		return x.Gravity
+	}
+	return ""
+}
+
+func (x *Planet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Planet) GetOrbitalPeriod() int32 {
+	if x != nil && x.OrbitalPeriod != nil {
+		return *x.OrbitalPeriod
+	}
+	return 0
+}
+
+func (x *Planet) GetPopulation() float64 {
+	if x != nil && x.Population != nil {
+		return *x.Population
+	}
+	return 0
+}
+
+func (x *Planet) GetRotationPeriod() int32 {
+	if x != nil && x.RotationPeriod != nil {
+		return *x.RotationPeriod
+	}
+	return 0
+}
+
+func (x *Planet) GetSurfaceWater() float64 {
+	if x != nil && x.SurfaceWater != nil {
+		return *x.SurfaceWater
+	}
+	return 0
+}
+
+func (x *Planet) GetTerrains() []string {
+	if x != nil {
+		return x.Terrains
+	}
+	return nil
+}
+
+func (x *Planet) GetResidentIds() []string {
+	if x != nil {
+		return x.ResidentIds
+	}
+	return nil
+}
+
+func (x *Planet) GetFilmIds() []string {
+	if x != nil {
+		return x.FilmIds
+	}
+	return nil
+}
+
+func (x *Planet) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Planet) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *Planet) GetClimateTypes() []Climate {
+	if x != nil {
+		return x.ClimateTypes
+	}
+	return nil
+}
+
+func (x *Planet) GetGravityRange() *GravityRange {
+	if x != nil {
+		return x.GravityRange
+	}
+	return nil
+}
+
+// GravityRange is a range of gravity, in multiples of standard gravity. For a
+// planet with a single value, min and max are equal.
+type GravityRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *GravityRange) Reset() {
+	*x = GravityRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GravityRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GravityRange) ProtoMessage() {}
+
+func (x *GravityRange) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GravityRange.ProtoReflect.Descriptor instead.
+func (*GravityRange) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GravityRange) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *GravityRange) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type GetPlanetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If false, the request fails with a NOT_FOUND error when any of the ids
+	// does not refer to an existing entity. If true, the response contains
+	// the entities that were found, and errors describes the ids that were
+	// not.
+	AllowMissing bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of planets fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetPlanetsRequest) Reset() {
+	*x = GetPlanetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanetsRequest) ProtoMessage() {}
+
+func (x *GetPlanetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanetsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanetsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPlanetsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetPlanetsRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+func (x *GetPlanetsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetPlanetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entities that were found, in the order of the request's ids.
+	Planets []*Planet `protobuf:"bytes,1,rep,name=planets,proto3" json:"planets,omitempty"`
+	// The ids that could not be returned. Only populated when allow_missing
+	// is true in the request.
+	Errors []*v1.EntityError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *GetPlanetsResponse) Reset() {
+	*x = GetPlanetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanetsResponse) ProtoMessage() {}
+
+func (x *GetPlanetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanetsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPlanetsResponse) GetPlanets() []*Planet {
+	if x != nil {
+		return x.Planets
+	}
+	return nil
+}
+
+func (x *GetPlanetsResponse) GetErrors() []*v1.EntityError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListPlanetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of items to return.
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous List request, if any.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An optional filter expression, in the style of AIP-160, that limits the
+	// results to matching entities. For example: `name:"sky" AND film_ids:1`.
+	// If the filter is invalid, the request fails with an INVALID_ARGUMENT
+	// error.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// An optional comma-separated list of fields to sort the results by, in
+	// the style of AIP-132. Each field may be followed by "asc" or "desc", for
+	// example: "population desc, name". Entities that compare equal remain in
+	// their default order. The page_token must have been returned by a request
+	// with the same order_by, or else the request fails with an
+	// INVALID_ARGUMENT error.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of planets fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListPlanetsRequest) Reset() {
+	*x = ListPlanetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlanetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlanetsRequest) ProtoMessage() {}
+
+func (x *ListPlanetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlanetsRequest.ProtoReflect.Descriptor instead.
+func (*ListPlanetsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPlanetsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPlanetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPlanetsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListPlanetsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListPlanetsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListPlanetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Planets that satisfy the request criteria.
+	// Max number of items is limited by page_size in the request.
+	Planets []*Planet `protobuf:"bytes,1,rep,name=planets,proto3" json:"planets,omitempty"`
+	// Token to retrieve the next page of results, or empty if there are no more results in the list.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListPlanetsResponse) Reset() {
+	*x = ListPlanetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlanetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlanetsResponse) ProtoMessage() {}
+
+func (x *ListPlanetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlanetsResponse.ProtoReflect.Descriptor instead.
+func (*ListPlanetsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPlanetsResponse) GetPlanets() []*Planet {
+	if x != nil {
+		return x.Planets
+	}
+	return nil
+}
+
+func (x *ListPlanetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamPlanetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional filter expression, with the same syntax as the filter of
+	// ListPlanetsRequest.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The maximum number of planets in each response. If zero, defaults to
+	// 100. Values greater than 1000 are treated as 1000.
+	ChunkSize int32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *StreamPlanetsRequest) Reset() {
+	*x = StreamPlanetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPlanetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPlanetsRequest) ProtoMessage() {}
+
+func (x *StreamPlanetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPlanetsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPlanetsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamPlanetsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *StreamPlanetsRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamPlanetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of planets. All chunks of a stream come from the same
+	// version of the data, in the same order as ListPlanets.
+	Planets []*Planet `protobuf:"bytes,1,rep,name=planets,proto3" json:"planets,omitempty"`
+}
+
+func (x *StreamPlanetsResponse) Reset() {
+	*x = StreamPlanetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamPlanetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamPlanetsResponse) ProtoMessage() {}
+
+func (x *StreamPlanetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamPlanetsResponse.ProtoReflect.Descriptor instead.
+func (*StreamPlanetsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamPlanetsResponse) GetPlanets() []*Planet {
+	if x != nil {
+		return x.Planets
+	}
+	return nil
+}
+
+type CreatePlanetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The planet to create. If its id is empty, the server assigns one. The
+	// created and edited timestamps are always assigned by the server.
+	// All of the ids it refers to must refer to existing entities. The
+	// inverse relations of those entities are updated to refer back to the
+	// new planet.
+	Planet *Planet `protobuf:"bytes,1,opt,name=planet,proto3" json:"planet,omitempty"`
+}
+
+func (x *CreatePlanetRequest) Reset() {
+	*x = CreatePlanetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlanetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanetRequest) ProtoMessage() {}
+
+func (x *CreatePlanetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanetRequest.ProtoReflect.Descriptor instead.
+func (*CreatePlanetRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreatePlanetRequest) GetPlanet() *Planet {
+	if x != nil {
+		return x.Planet
+	}
+	return nil
+}
+
+type CreatePlanetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The planet that was created.
+	Planet *Planet `protobuf:"bytes,1,opt,name=planet,proto3" json:"planet,omitempty"`
+}
+
+func (x *CreatePlanetResponse) Reset() {
+	*x = CreatePlanetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePlanetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePlanetResponse) ProtoMessage() {}
+
+func (x *CreatePlanetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePlanetResponse.ProtoReflect.Descriptor instead.
+func (*CreatePlanetResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreatePlanetResponse) GetPlanet() *Planet {
+	if x != nil {
+		return x.Planet
+	}
+	return nil
+}
+
+type UpdatePlanetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The planet to update. Its id identifies the planet to update. If its edited
+	// timestamp is present, it must match the current edited timestamp of the
+	// planet, or else the update fails with an ABORTED error. Clients should
+	// set it to the value they last read to avoid overwriting concurrent
+	// changes. As with CreatePlanet, all ids it refers to must refer to existing
+	// entities, and the inverse relations of those entities are updated.
+	Planet *Planet `protobuf:"bytes,1,opt,name=planet,proto3" json:"planet,omitempty"`
+	// The fields to update. If absent, the fields that are populated in the
+	// request are updated, as described by AIP-134, so fields cannot be cleared
+	// without a mask. The id, created, and edited fields cannot be updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdatePlanetRequest) Reset() {
+	*x = UpdatePlanetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePlanetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanetRequest) ProtoMessage() {}
+
+func (x *UpdatePlanetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanetRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePlanetRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdatePlanetRequest) GetPlanet() *Planet {
+	if x != nil {
+		return x.Planet
+	}
+	return nil
+}
+
+func (x *UpdatePlanetRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdatePlanetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The planet after the update was applied.
+	Planet *Planet `protobuf:"bytes,1,opt,name=planet,proto3" json:"planet,omitempty"`
+}
+
+func (x *UpdatePlanetResponse) Reset() {
+	*x = UpdatePlanetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePlanetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePlanetResponse) ProtoMessage() {}
+
+func (x *UpdatePlanetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePlanetResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePlanetResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdatePlanetResponse) GetPlanet() *Planet {
+	if x != nil {
+		return x.Planet
+	}
+	return nil
+}
+
+type DeletePlanetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the planet to delete.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If present, the planet is only deleted if this matches its current
+	// edited timestamp. Otherwise, the delete fails with an ABORTED error.
+	Edited *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=edited,proto3" json:"edited,omitempty"`
+	// If false, the delete fails with a FAILED_PRECONDITION error when any
+	// other entity refers to the planet. If true, all references to the planet
+	// are removed from other entities.
+	Cascade bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DeletePlanetRequest) Reset() {
+	*x = DeletePlanetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlanetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanetRequest) ProtoMessage() {}
+
+func (x *DeletePlanetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanetRequest.ProtoReflect.Descriptor instead.
+func (*DeletePlanetRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeletePlanetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeletePlanetRequest) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *DeletePlanetRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DeletePlanetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePlanetResponse) Reset() {
+	*x = DeletePlanetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePlanetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePlanetResponse) ProtoMessage() {}
+
+func (x *DeletePlanetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePlanetResponse.ProtoReflect.Descriptor instead.
+func (*DeletePlanetResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{13}
+}
+
+type WatchPlanetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If present, the stream resumes after the event that returned this
+	// token. Otherwise, it starts with the next change. Only a limited
+	// number of recent changes are retained, so if the token refers to a
+	// change that is no longer retained, or was returned by a different
+	// server process, the request fails with an OUT_OF_RANGE error. Clients
+	// should then watch again with initial_snapshot set.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// If true, the stream starts with a SNAPSHOT event for every existing
+	// planet. Cannot be combined with resume_token.
+	InitialSnapshot bool `protobuf:"varint,2,opt,name=initial_snapshot,json=initialSnapshot,proto3" json:"initial_snapshot,omitempty"`
+}
+
+func (x *WatchPlanetsRequest) Reset() {
+	*x = WatchPlanetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPlanetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlanetsRequest) ProtoMessage() {}
+
+func (x *WatchPlanetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlanetsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPlanetsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchPlanetsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchPlanetsRequest) GetInitialSnapshot() bool {
+	if x != nil {
+		return x.InitialSnapshot
+	}
+	return false
+}
+
+type WatchPlanetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of change.
+	ChangeType v1.ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=buf.knit.demo.swapi.common.v1.ChangeType" json:"change_type,omitempty"`
+	// The planet after the change, or before it for deletes. Absent for
+	// checkpoints.
+	Planet *Planet `protobuf:"bytes,2,opt,name=planet,proto3" json:"planet,omitempty"`
+	// A token to resume the stream after this event.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchPlanetsResponse) Reset() {
+	*x = WatchPlanetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchPlanetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPlanetsResponse) ProtoMessage() {}
+
+func (x *WatchPlanetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPlanetsResponse.ProtoReflect.Descriptor instead.
+func (*WatchPlanetsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchPlanetsResponse) GetChangeType() v1.ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return v1.ChangeType(0)
+}
+
+func (x *WatchPlanetsResponse) GetPlanet() *Planet {
+	if x != nil {
+		return x.Planet
+	}
+	return nil
+}
+
+func (x *WatchPlanetsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_planet_v1_planet_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x2a, 0x62, 0x75, 0x66,
+	0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe4, 0x05, 0x0a, 0x06, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x08, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x76, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x76, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x62, 0x69,
+	0x74, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a,
+	0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x02, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x0e, 0x72, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x28, 0x0a, 0x0d, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x57, 0x61, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x72,
+	0x72, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x6d,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x6d,
+	0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a,
+	0x0d, 0x63, 0x6c, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x63, 0x6c,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x0d, 0x67, 0x72,
+	0x61, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x72, 0x61, 0x76, 0x69, 0x74, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c,
+	0x67, 0x72, 0x61, 0x76, 0x69, 0x74, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x64, 0x69, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x72,
+	0x62, 0x69, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x75, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77, 0x61, 0x74, 0x65,
+	0x72, 0x22, 0x32, 0x0a, 0x0c, 0x47, 0x72, 0x61, 0x76, 0x69, 0x74, 0x79, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x99, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x37, 0x0a,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x7e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
+	0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x58, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x22,
+	0x54, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x22, 0x55, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x22, 0x91, 0x01, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61,
+	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x22, 0x55, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x22, 0x73, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x16, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x14, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3d,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2a, 0xf5, 0x02, 0x0a, 0x07, 0x43, 0x6c, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13,
+	0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x52, 0x43, 0x54, 0x49, 0x43, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x49,
+	0x4d, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x49, 0x44, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x43,
+	0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x49, 0x43, 0x49, 0x41,
+	0x4c, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x12, 0x0a,
+	0x0e, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x49, 0x47, 0x49, 0x44, 0x10,
+	0x04, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f,
+	0x5a, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45,
+	0x5f, 0x48, 0x4f, 0x54, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54,
+	0x45, 0x5f, 0x48, 0x55, 0x4d, 0x49, 0x44, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4c, 0x49,
+	0x4d, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x4f, 0x49, 0x53, 0x54, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d,
+	0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x55, 0x52, 0x4b, 0x59, 0x10, 0x09, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x4c, 0x55,
+	0x54, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x4f, 0x43, 0x4b, 0x59, 0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4c, 0x49, 0x4d,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x41, 0x52, 0x43, 0x54, 0x49, 0x43, 0x10, 0x0c, 0x12,
+	0x17, 0x0a, 0x13, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x50, 0x45, 0x52,
+	0x48, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4c, 0x49, 0x4d,
+	0x41, 0x54, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x0e, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x52, 0x4f, 0x50, 0x49,
+	0x43, 0x41, 0x4c, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4c, 0x49, 0x4d, 0x41, 0x54, 0x45,
+	0x5f, 0x57, 0x49, 0x4e, 0x44, 0x59, 0x10, 0x10, 0x32, 0xf6, 0x06, 0x0a, 0x0d, 0x50, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x76, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x12, 0x79, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x73, 0x12, 0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x81, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x12,
+	0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30,
+	0x01, 0x12, 0x77, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x02, 0x12, 0x7e, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74,
+	0x73, 0x12, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30,
+	0x01, 0x42, 0x98, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x6b, 0x6e, 0x69, 0x74,
+	0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x66,
+	0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x76, 0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b, 0x44, 0x53, 0x50, 0xaa, 0x02, 0x1d, 0x42, 0x75,
+	0x66, 0x2e, 0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1d, 0x42, 0x75,
+	0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70,
+	0x69, 0x5c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x29, 0x42, 0x75,
+	0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70,
+	0x69, 0x5c, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x22, 0x42, 0x75, 0x66, 0x3a, 0x3a, 0x4b,
+	0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d, 0x6f, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x69,
+	0x3a, 0x3a, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescData = file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_planet_v1_planet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_buf_knit_demo_swapi_planet_v1_planet_proto_goTypes = []interface{}{
+	(Climate)(0),                  // 0: buf.knit.demo.swapi.planet.v1.Climate
+	(*Planet)(nil),                // 1: buf.knit.demo.swapi.planet.v1.Planet
+	(*GravityRange)(nil),          // 2: buf.knit.demo.swapi.planet.v1.GravityRange
+	(*GetPlanetsRequest)(nil),     // 3: buf.knit.demo.swapi.planet.v1.GetPlanetsRequest
+	(*GetPlanetsResponse)(nil),    // 4: buf.knit.demo.swapi.planet.v1.GetPlanetsResponse
+	(*ListPlanetsRequest)(nil),    // 5: buf.knit.demo.swapi.planet.v1.ListPlanetsRequest
+	(*ListPlanetsResponse)(nil),   // 6: buf.knit.demo.swapi.planet.v1.ListPlanetsResponse
+	(*StreamPlanetsRequest)(nil),  // 7: buf.knit.demo.swapi.planet.v1.StreamPlanetsRequest
+	(*StreamPlanetsResponse)(nil), // 8: buf.knit.demo.swapi.planet.v1.StreamPlanetsResponse
+	(*CreatePlanetRequest)(nil),   // 9: buf.knit.demo.swapi.planet.v1.CreatePlanetRequest
+	(*CreatePlanetResponse)(nil),  // 10: buf.knit.demo.swapi.planet.v1.CreatePlanetResponse
+	(*UpdatePlanetRequest)(nil),   // 11: buf.knit.demo.swapi.planet.v1.UpdatePlanetRequest
+	(*UpdatePlanetResponse)(nil),  // 12: buf.knit.demo.swapi.planet.v1.UpdatePlanetResponse
+	(*DeletePlanetRequest)(nil),   // 13: buf.knit.demo.swapi.planet.v1.DeletePlanetRequest
+	(*DeletePlanetResponse)(nil),  // 14: buf.knit.demo.swapi.planet.v1.DeletePlanetResponse
+	(*WatchPlanetsRequest)(nil),   // 15: buf.knit.demo.swapi.planet.v1.WatchPlanetsRequest
+	(*WatchPlanetsResponse)(nil),  // 16: buf.knit.demo.swapi.planet.v1.WatchPlanetsResponse
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 18: google.protobuf.FieldMask
+	(*v1.EntityError)(nil),        // 19: buf.knit.demo.swapi.common.v1.EntityError
+	(v1.ChangeType)(0),            // 20: buf.knit.demo.swapi.common.v1.ChangeType
+}
+var file_buf_knit_demo_swapi_planet_v1_planet_proto_depIdxs = []int32{
+	17, // 0: buf.knit.demo.swapi.planet.v1.Planet.created:type_name -> google.protobuf.Timestamp
+	17, // 1: buf.knit.demo.swapi.planet.v1.Planet.edited:type_name -> google.protobuf.Timestamp
+	0,  // 2: buf.knit.demo.swapi.planet.v1.Planet.climate_types:type_name -> buf.knit.demo.swapi.planet.v1.Climate
+	2,  // 3: buf.knit.demo.swapi.planet.v1.Planet.gravity_range:type_name -> buf.knit.demo.swapi.planet.v1.GravityRange
+	18, // 4: buf.knit.demo.swapi.planet.v1.GetPlanetsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 5: buf.knit.demo.swapi.planet.v1.GetPlanetsResponse.planets:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	19, // 6: buf.knit.demo.swapi.planet.v1.GetPlanetsResponse.errors:type_name -> buf.knit.demo.swapi.common.v1.EntityError
+	18, // 7: buf.knit.demo.swapi.planet.v1.ListPlanetsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	1,  // 8: buf.knit.demo.swapi.planet.v1.ListPlanetsResponse.planets:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	1,  // 9: buf.knit.demo.swapi.planet.v1.StreamPlanetsResponse.planets:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	1,  // 10: buf.knit.demo.swapi.planet.v1.CreatePlanetRequest.planet:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	1,  // 11: buf.knit.demo.swapi.planet.v1.CreatePlanetResponse.planet:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	1,  // 12: buf.knit.demo.swapi.planet.v1.UpdatePlanetRequest.planet:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	18, // 13: buf.knit.demo.swapi.planet.v1.UpdatePlanetRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 14: buf.knit.demo.swapi.planet.v1.UpdatePlanetResponse.planet:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	17, // 15: buf.knit.demo.swapi.planet.v1.DeletePlanetRequest.edited:type_name -> google.protobuf.Timestamp
+	20, // 16: buf.knit.demo.swapi.planet.v1.WatchPlanetsResponse.change_type:type_name -> buf.knit.demo.swapi.common.v1.ChangeType
+	1,  // 17: buf.knit.demo.swapi.planet.v1.WatchPlanetsResponse.planet:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	3,  // 18: buf.knit.demo.swapi.planet.v1.PlanetService.GetPlanets:input_type -> buf.knit.demo.swapi.planet.v1.GetPlanetsRequest
+	5,  // 19: buf.knit.demo.swapi.planet.v1.PlanetService.ListPlanets:input_type -> buf.knit.demo.swapi.planet.v1.ListPlanetsRequest
+	7,  // 20: buf.knit.demo.swapi.planet.v1.PlanetService.StreamPlanets:input_type -> buf.knit.demo.swapi.planet.v1.StreamPlanetsRequest
+	9,  // 21: buf.knit.demo.swapi.planet.v1.PlanetService.CreatePlanet:input_type -> buf.knit.demo.swapi.planet.v1.CreatePlanetRequest
+	11, // 22: buf.knit.demo.swapi.planet.v1.PlanetService.UpdatePlanet:input_type -> buf.knit.demo.swapi.planet.v1.UpdatePlanetRequest
+	13, // 23: buf.knit.demo.swapi.planet.v1.PlanetService.DeletePlanet:input_type -> buf.knit.demo.swapi.planet.v1.DeletePlanetRequest
+	15, // 24: buf.knit.demo.swapi.planet.v1.PlanetService.WatchPlanets:input_type -> buf.knit.demo.swapi.planet.v1.WatchPlanetsRequest
+	4,  // 25: buf.knit.demo.swapi.planet.v1.PlanetService.GetPlanets:output_type -> buf.knit.demo.swapi.planet.v1.GetPlanetsResponse
+	6,  // 26: buf.knit.demo.swapi.planet.v1.PlanetService.ListPlanets:output_type -> buf.knit.demo.swapi.planet.v1.ListPlanetsResponse
+	8,  // 27: buf.knit.demo.swapi.planet.v1.PlanetService.StreamPlanets:output_type -> buf.knit.demo.swapi.planet.v1.StreamPlanetsResponse
+	10, // 28: buf.knit.demo.swapi.planet.v1.PlanetService.CreatePlanet:output_type -> buf.knit.demo.swapi.planet.v1.CreatePlanetResponse
+	12, // 29: buf.knit.demo.swapi.planet.v1.PlanetService.UpdatePlanet:output_type -> buf.knit.demo.swapi.planet.v1.UpdatePlanetResponse
+	14, // 30: buf.knit.demo.swapi.planet.v1.PlanetService.DeletePlanet:output_type -> buf.knit.demo.swapi.planet.v1.DeletePlanetResponse
+	16, // 31: buf.knit.demo.swapi.planet.v1.PlanetService.WatchPlanets:output_type -> buf.knit.demo.swapi.planet.v1.WatchPlanetsResponse
+	25, // [25:32] is the sub-list for method output_type
+	18, // [18:25] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_planet_v1_planet_proto_init() }
+func file_buf_knit_demo_swapi_planet_v1_planet_proto_init() {
+	if File_buf_knit_demo_swapi_planet_v1_planet_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Planet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GravityRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlanetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlanetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlanetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlanetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlanetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamPlanetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlanetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePlanetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlanetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePlanetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlanetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePlanetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPlanetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchPlanetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_planet_v1_planet_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_planet_v1_planet_proto_depIdxs,
+		EnumInfos:         file_buf_knit_demo_swapi_planet_v1_planet_proto_enumTypes,
+		MessageInfos:      file_buf_knit_demo_swapi_planet_v1_planet_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_planet_v1_planet_proto = out.File
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_goTypes = nil
+	file_buf_knit_demo_swapi_planet_v1_planet_proto_depIdxs = nil
+}
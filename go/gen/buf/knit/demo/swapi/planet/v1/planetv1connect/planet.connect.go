@@ -0,0 +1,332 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/planet/v1/planet.proto
+
+package planetv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PlanetServiceName is the fully-qualified name of the PlanetService service.
+	PlanetServiceName = "buf.knit.demo.swapi.planet.v1.PlanetService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PlanetServiceGetPlanetsProcedure is the fully-qualified name of the PlanetService's GetPlanets
+	// RPC.
+	PlanetServiceGetPlanetsProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/GetPlanets"
+	// PlanetServiceListPlanetsProcedure is the fully-qualified name of the PlanetService's ListPlanets
+	// RPC.
+	PlanetServiceListPlanetsProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/ListPlanets"
+	// PlanetServiceStreamPlanetsProcedure is the fully-qualified name of the PlanetService's
+	// StreamPlanets RPC.
+	PlanetServiceStreamPlanetsProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/StreamPlanets"
+	// PlanetServiceCreatePlanetProcedure is the fully-qualified name of the PlanetService's
+	// CreatePlanet RPC.
+	PlanetServiceCreatePlanetProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/CreatePlanet"
+	// PlanetServiceUpdatePlanetProcedure is the fully-qualified name of the PlanetService's
+	// UpdatePlanet RPC.
+	PlanetServiceUpdatePlanetProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/UpdatePlanet"
+	// PlanetServiceDeletePlanetProcedure is the fully-qualified name of the PlanetService's
+	// DeletePlanet RPC.
+	PlanetServiceDeletePlanetProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/DeletePlanet"
+	// PlanetServiceWatchPlanetsProcedure is the fully-qualified name of the PlanetService's
+	// WatchPlanets RPC.
+	PlanetServiceWatchPlanetsProcedure = "/buf.knit.demo.swapi.planet.v1.PlanetService/WatchPlanets"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	planetServiceServiceDescriptor             = v1.File_buf_knit_demo_swapi_planet_v1_planet_proto.Services().ByName("PlanetService")
+	planetServiceGetPlanetsMethodDescriptor    = planetServiceServiceDescriptor.Methods().ByName("GetPlanets")
+	planetServiceListPlanetsMethodDescriptor   = planetServiceServiceDescriptor.Methods().ByName("ListPlanets")
+	planetServiceStreamPlanetsMethodDescriptor = planetServiceServiceDescriptor.Methods().ByName("StreamPlanets")
+	planetServiceCreatePlanetMethodDescriptor  = planetServiceServiceDescriptor.Methods().ByName("CreatePlanet")
+	planetServiceUpdatePlanetMethodDescriptor  = planetServiceServiceDescriptor.Methods().ByName("UpdatePlanet")
+	planetServiceDeletePlanetMethodDescriptor  = planetServiceServiceDescriptor.Methods().ByName("DeletePlanet")
+	planetServiceWatchPlanetsMethodDescriptor  = planetServiceServiceDescriptor.Methods().ByName("WatchPlanets")
+)
+
+// PlanetServiceClient is a client for the buf.knit.demo.swapi.planet.v1.PlanetService service.
+type PlanetServiceClient interface {
+	GetPlanets(context.Context, *connect.Request[v1.GetPlanetsRequest]) (*connect.Response[v1.GetPlanetsResponse], error)
+	ListPlanets(context.Context, *connect.Request[v1.ListPlanetsRequest]) (*connect.Response[v1.ListPlanetsResponse], error)
+	// StreamPlanets streams all planets, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListPlanets.
+	StreamPlanets(context.Context, *connect.Request[v1.StreamPlanetsRequest]) (*connect.ServerStreamForClient[v1.StreamPlanetsResponse], error)
+	// CreatePlanet creates a new planet.
+	CreatePlanet(context.Context, *connect.Request[v1.CreatePlanetRequest]) (*connect.Response[v1.CreatePlanetResponse], error)
+	// UpdatePlanet updates an existing planet.
+	UpdatePlanet(context.Context, *connect.Request[v1.UpdatePlanetRequest]) (*connect.Response[v1.UpdatePlanetResponse], error)
+	// DeletePlanet deletes a planet.
+	DeletePlanet(context.Context, *connect.Request[v1.DeletePlanetRequest]) (*connect.Response[v1.DeletePlanetResponse], error)
+	// WatchPlanets streams the changes to planets, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchPlanets(context.Context, *connect.Request[v1.WatchPlanetsRequest]) (*connect.ServerStreamForClient[v1.WatchPlanetsResponse], error)
+}
+
+// NewPlanetServiceClient constructs a client for the buf.knit.demo.swapi.planet.v1.PlanetService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPlanetServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PlanetServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &planetServiceClient{
+		getPlanets: connect.NewClient[v1.GetPlanetsRequest, v1.GetPlanetsResponse](
+			httpClient,
+			baseURL+PlanetServiceGetPlanetsProcedure,
+			connect.WithSchema(planetServiceGetPlanetsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listPlanets: connect.NewClient[v1.ListPlanetsRequest, v1.ListPlanetsResponse](
+			httpClient,
+			baseURL+PlanetServiceListPlanetsProcedure,
+			connect.WithSchema(planetServiceListPlanetsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamPlanets: connect.NewClient[v1.StreamPlanetsRequest, v1.StreamPlanetsResponse](
+			httpClient,
+			baseURL+PlanetServiceStreamPlanetsProcedure,
+			connect.WithSchema(planetServiceStreamPlanetsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createPlanet: connect.NewClient[v1.CreatePlanetRequest, v1.CreatePlanetResponse](
+			httpClient,
+			baseURL+PlanetServiceCreatePlanetProcedure,
+			connect.WithSchema(planetServiceCreatePlanetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updatePlanet: connect.NewClient[v1.UpdatePlanetRequest, v1.UpdatePlanetResponse](
+			httpClient,
+			baseURL+PlanetServiceUpdatePlanetProcedure,
+			connect.WithSchema(planetServiceUpdatePlanetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlanet: connect.NewClient[v1.DeletePlanetRequest, v1.DeletePlanetResponse](
+			httpClient,
+			baseURL+PlanetServiceDeletePlanetProcedure,
+			connect.WithSchema(planetServiceDeletePlanetMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		watchPlanets: connect.NewClient[v1.WatchPlanetsRequest, v1.WatchPlanetsResponse](
+			httpClient,
+			baseURL+PlanetServiceWatchPlanetsProcedure,
+			connect.WithSchema(planetServiceWatchPlanetsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// planetServiceClient implements PlanetServiceClient.
+type planetServiceClient struct {
+	getPlanets    *connect.Client[v1.GetPlanetsRequest, v1.GetPlanetsResponse]
+	listPlanets   *connect.Client[v1.ListPlanetsRequest, v1.ListPlanetsResponse]
+	streamPlanets *connect.Client[v1.StreamPlanetsRequest, v1.StreamPlanetsResponse]
+	createPlanet  *connect.Client[v1.CreatePlanetRequest, v1.CreatePlanetResponse]
+	updatePlanet  *connect.Client[v1.UpdatePlanetRequest, v1.UpdatePlanetResponse]
+	deletePlanet  *connect.Client[v1.DeletePlanetRequest, v1.DeletePlanetResponse]
+	watchPlanets  *connect.Client[v1.WatchPlanetsRequest, v1.WatchPlanetsResponse]
+}
+
+// GetPlanets calls buf.knit.demo.swapi.planet.v1.PlanetService.GetPlanets.
+func (c *planetServiceClient) GetPlanets(ctx context.Context, req *connect.Request[v1.GetPlanetsRequest]) (*connect.Response[v1.GetPlanetsResponse], error) {
+	return c.getPlanets.CallUnary(ctx, req)
+}
+
+// ListPlanets calls buf.knit.demo.swapi.planet.v1.PlanetService.ListPlanets.
+func (c *planetServiceClient) ListPlanets(ctx context.Context, req *connect.Request[v1.ListPlanetsRequest]) (*connect.Response[v1.ListPlanetsResponse], error) {
+	return c.listPlanets.CallUnary(ctx, req)
+}
+
+// StreamPlanets calls buf.knit.demo.swapi.planet.v1.PlanetService.StreamPlanets.
+func (c *planetServiceClient) StreamPlanets(ctx context.Context, req *connect.Request[v1.StreamPlanetsRequest]) (*connect.ServerStreamForClient[v1.StreamPlanetsResponse], error) {
+	return c.streamPlanets.CallServerStream(ctx, req)
+}
+
+// CreatePlanet calls buf.knit.demo.swapi.planet.v1.PlanetService.CreatePlanet.
+func (c *planetServiceClient) CreatePlanet(ctx context.Context, req *connect.Request[v1.CreatePlanetRequest]) (*connect.Response[v1.CreatePlanetResponse], error) {
+	return c.createPlanet.CallUnary(ctx, req)
+}
+
+// UpdatePlanet calls buf.knit.demo.swapi.planet.v1.PlanetService.UpdatePlanet.
+func (c *planetServiceClient) UpdatePlanet(ctx context.Context, req *connect.Request[v1.UpdatePlanetRequest]) (*connect.Response[v1.UpdatePlanetResponse], error) {
+	return c.updatePlanet.CallUnary(ctx, req)
+}
+
+// DeletePlanet calls buf.knit.demo.swapi.planet.v1.PlanetService.DeletePlanet.
+func (c *planetServiceClient) DeletePlanet(ctx context.Context, req *connect.Request[v1.DeletePlanetRequest]) (*connect.Response[v1.DeletePlanetResponse], error) {
+	return c.deletePlanet.CallUnary(ctx, req)
+}
+
+// WatchPlanets calls buf.knit.demo.swapi.planet.v1.PlanetService.WatchPlanets.
+func (c *planetServiceClient) WatchPlanets(ctx context.Context, req *connect.Request[v1.WatchPlanetsRequest]) (*connect.ServerStreamForClient[v1.WatchPlanetsResponse], error) {
+	return c.watchPlanets.CallServerStream(ctx, req)
+}
+
+// PlanetServiceHandler is an implementation of the buf.knit.demo.swapi.planet.v1.PlanetService
+// service.
+type PlanetServiceHandler interface {
+	GetPlanets(context.Context, *connect.Request[v1.GetPlanetsRequest]) (*connect.Response[v1.GetPlanetsResponse], error)
+	ListPlanets(context.Context, *connect.Request[v1.ListPlanetsRequest]) (*connect.Response[v1.ListPlanetsResponse], error)
+	// StreamPlanets streams all planets, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListPlanets.
+	StreamPlanets(context.Context, *connect.Request[v1.StreamPlanetsRequest], *connect.ServerStream[v1.StreamPlanetsResponse]) error
+	// CreatePlanet creates a new planet.
+	CreatePlanet(context.Context, *connect.Request[v1.CreatePlanetRequest]) (*connect.Response[v1.CreatePlanetResponse], error)
+	// UpdatePlanet updates an existing planet.
+	UpdatePlanet(context.Context, *connect.Request[v1.UpdatePlanetRequest]) (*connect.Response[v1.UpdatePlanetResponse], error)
+	// DeletePlanet deletes a planet.
+	DeletePlanet(context.Context, *connect.Request[v1.DeletePlanetRequest]) (*connect.Response[v1.DeletePlanetResponse], error)
+	// WatchPlanets streams the changes to planets, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchPlanets(context.Context, *connect.Request[v1.WatchPlanetsRequest], *connect.ServerStream[v1.WatchPlanetsResponse]) error
+}
+
+// NewPlanetServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPlanetServiceHandler(svc PlanetServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	planetServiceGetPlanetsHandler := connect.NewUnaryHandler(
+		PlanetServiceGetPlanetsProcedure,
+		svc.GetPlanets,
+		connect.WithSchema(planetServiceGetPlanetsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetServiceListPlanetsHandler := connect.NewUnaryHandler(
+		PlanetServiceListPlanetsProcedure,
+		svc.ListPlanets,
+		connect.WithSchema(planetServiceListPlanetsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetServiceStreamPlanetsHandler := connect.NewServerStreamHandler(
+		PlanetServiceStreamPlanetsProcedure,
+		svc.StreamPlanets,
+		connect.WithSchema(planetServiceStreamPlanetsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetServiceCreatePlanetHandler := connect.NewUnaryHandler(
+		PlanetServiceCreatePlanetProcedure,
+		svc.CreatePlanet,
+		connect.WithSchema(planetServiceCreatePlanetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetServiceUpdatePlanetHandler := connect.NewUnaryHandler(
+		PlanetServiceUpdatePlanetProcedure,
+		svc.UpdatePlanet,
+		connect.WithSchema(planetServiceUpdatePlanetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetServiceDeletePlanetHandler := connect.NewUnaryHandler(
+		PlanetServiceDeletePlanetProcedure,
+		svc.DeletePlanet,
+		connect.WithSchema(planetServiceDeletePlanetMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetServiceWatchPlanetsHandler := connect.NewServerStreamHandler(
+		PlanetServiceWatchPlanetsProcedure,
+		svc.WatchPlanets,
+		connect.WithSchema(planetServiceWatchPlanetsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.planet.v1.PlanetService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PlanetServiceGetPlanetsProcedure:
+			planetServiceGetPlanetsHandler.ServeHTTP(w, r)
+		case PlanetServiceListPlanetsProcedure:
+			planetServiceListPlanetsHandler.ServeHTTP(w, r)
+		case PlanetServiceStreamPlanetsProcedure:
+			planetServiceStreamPlanetsHandler.ServeHTTP(w, r)
+		case PlanetServiceCreatePlanetProcedure:
+			planetServiceCreatePlanetHandler.ServeHTTP(w, r)
+		case PlanetServiceUpdatePlanetProcedure:
+			planetServiceUpdatePlanetHandler.ServeHTTP(w, r)
+		case PlanetServiceDeletePlanetProcedure:
+			planetServiceDeletePlanetHandler.ServeHTTP(w, r)
+		case PlanetServiceWatchPlanetsProcedure:
+			planetServiceWatchPlanetsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPlanetServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPlanetServiceHandler struct{}
+
+func (UnimplementedPlanetServiceHandler) GetPlanets(context.Context, *connect.Request[v1.GetPlanetsRequest]) (*connect.Response[v1.GetPlanetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.GetPlanets is not implemented"))
+}
+
+func (UnimplementedPlanetServiceHandler) ListPlanets(context.Context, *connect.Request[v1.ListPlanetsRequest]) (*connect.Response[v1.ListPlanetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.ListPlanets is not implemented"))
+}
+
+func (UnimplementedPlanetServiceHandler) StreamPlanets(context.Context, *connect.Request[v1.StreamPlanetsRequest], *connect.ServerStream[v1.StreamPlanetsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.StreamPlanets is not implemented"))
+}
+
+func (UnimplementedPlanetServiceHandler) CreatePlanet(context.Context, *connect.Request[v1.CreatePlanetRequest]) (*connect.Response[v1.CreatePlanetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.CreatePlanet is not implemented"))
+}
+
+func (UnimplementedPlanetServiceHandler) UpdatePlanet(context.Context, *connect.Request[v1.UpdatePlanetRequest]) (*connect.Response[v1.UpdatePlanetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.UpdatePlanet is not implemented"))
+}
+
+func (UnimplementedPlanetServiceHandler) DeletePlanet(context.Context, *connect.Request[v1.DeletePlanetRequest]) (*connect.Response[v1.DeletePlanetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.DeletePlanet is not implemented"))
+}
+
+func (UnimplementedPlanetServiceHandler) WatchPlanets(context.Context, *connect.Request[v1.WatchPlanetsRequest], *connect.ServerStream[v1.WatchPlanetsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.planet.v1.PlanetService.WatchPlanets is not implemented"))
+}
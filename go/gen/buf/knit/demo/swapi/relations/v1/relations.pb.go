@@ -0,0 +1,3271 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/relations/v1/relations.proto
+
+package relationsv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/knit/protocolbuffers/go/buf/knit/v1alpha1"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	v16 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	v11 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	v13 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	v12 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	v14 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	v15 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GetFilmRelationsRequest is the request of the resolvers of relations of
+// films. The requests of the resolvers of relations of other types of
+// entities have the same fields, which are documented here.
+type GetFilmRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases []*v1.Film `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	// The maximum number of related entities to return for each base entity.
+	// If there are more, the result for that base has a next_page_token. If
+	// zero, all of them are returned, or if page_token is set, the limit of
+	// the request that returned the token is used.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// An optional comma-separated list of fields to sort the related entities
+	// by, in the same form as the order_by of List RPCs. The limit is applied
+	// after sorting.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// An optional filter expression, in the same form as the filter of List
+	// RPCs, that limits the related entities to those that match. The filter
+	// is applied before the limit, so a limit of N returns the first N
+	// matching entities.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The next_page_token of a previous result, to get the next page of the
+	// related entities of that result's base entity. The token is only valid
+	// for that base entity, with the same order_by and filter.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetFilmRelationsRequest) Reset() {
+	*x = GetFilmRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilmRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilmRelationsRequest) ProtoMessage() {}
+
+func (x *GetFilmRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilmRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetFilmRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetFilmRelationsRequest) GetBases() []*v1.Film {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetFilmRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFilmRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetFilmRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetFilmRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetPersonRelationsRequest is like GetFilmRelationsRequest, for
+// relations of people.
+type GetPersonRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases     []*v11.Person `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Limit     int32         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy   string        `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    string        `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string        `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetPersonRelationsRequest) Reset() {
+	*x = GetPersonRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPersonRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRelationsRequest) ProtoMessage() {}
+
+func (x *GetPersonRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPersonRelationsRequest) GetBases() []*v11.Person {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetPersonRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPersonRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetPersonRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetPersonRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetPersonRelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases []*v11.Person `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+}
+
+func (x *GetPersonRelationRequest) Reset() {
+	*x = GetPersonRelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPersonRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRelationRequest) ProtoMessage() {}
+
+func (x *GetPersonRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRelationRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRelationRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPersonRelationRequest) GetBases() []*v11.Person {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+// GetSpeciesRelationsRequest is like GetFilmRelationsRequest, for
+// relations of species.
+type GetSpeciesRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases     []*v12.Species `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Limit     int32          `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy   string         `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    string         `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string         `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetSpeciesRelationsRequest) Reset() {
+	*x = GetSpeciesRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpeciesRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesRelationsRequest) ProtoMessage() {}
+
+func (x *GetSpeciesRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetSpeciesRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSpeciesRelationsRequest) GetBases() []*v12.Species {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetSpeciesRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSpeciesRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetSpeciesRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetSpeciesRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetSpeciesRelationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases []*v12.Species `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+}
+
+func (x *GetSpeciesRelationRequest) Reset() {
+	*x = GetSpeciesRelationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpeciesRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesRelationRequest) ProtoMessage() {}
+
+func (x *GetSpeciesRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesRelationRequest.ProtoReflect.Descriptor instead.
+func (*GetSpeciesRelationRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSpeciesRelationRequest) GetBases() []*v12.Species {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+// GetPlanetRelationsRequest is like GetFilmRelationsRequest, for
+// relations of planets.
+type GetPlanetRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases     []*v13.Planet `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Limit     int32         `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy   string        `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    string        `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string        `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetPlanetRelationsRequest) Reset() {
+	*x = GetPlanetRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanetRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanetRelationsRequest) ProtoMessage() {}
+
+func (x *GetPlanetRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanetRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlanetRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPlanetRelationsRequest) GetBases() []*v13.Planet {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetPlanetRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPlanetRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetPlanetRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetPlanetRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetStarshipRelationsRequest is like GetFilmRelationsRequest, for
+// relations of starships.
+type GetStarshipRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases     []*v14.Starship `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Limit     int32           `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy   string          `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    string          `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string          `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetStarshipRelationsRequest) Reset() {
+	*x = GetStarshipRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStarshipRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarshipRelationsRequest) ProtoMessage() {}
+
+func (x *GetStarshipRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarshipRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetStarshipRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetStarshipRelationsRequest) GetBases() []*v14.Starship {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetStarshipRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetStarshipRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetStarshipRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetStarshipRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetVehicleRelationsRequest is like GetFilmRelationsRequest, for
+// relations of vehicles.
+type GetVehicleRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases     []*v15.Vehicle `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Limit     int32          `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy   string         `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    string         `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string         `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetVehicleRelationsRequest) Reset() {
+	*x = GetVehicleRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVehicleRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleRelationsRequest) ProtoMessage() {}
+
+func (x *GetVehicleRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetVehicleRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetVehicleRelationsRequest) GetBases() []*v15.Vehicle {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetVehicleRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetVehicleRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetVehicleRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetVehicleRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetManufacturerRelationsRequest is like GetFilmRelationsRequest, for
+// relations of manufacturers.
+type GetManufacturerRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bases     []*v16.Manufacturer `protobuf:"bytes,1,rep,name=bases,proto3" json:"bases,omitempty"`
+	Limit     int32               `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderBy   string              `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    string              `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	PageToken string              `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *GetManufacturerRelationsRequest) Reset() {
+	*x = GetManufacturerRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManufacturerRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturerRelationsRequest) ProtoMessage() {}
+
+func (x *GetManufacturerRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturerRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetManufacturerRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetManufacturerRelationsRequest) GetBases() []*v16.Manufacturer {
+	if x != nil {
+		return x.Bases
+	}
+	return nil
+}
+
+func (x *GetManufacturerRelationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetManufacturerRelationsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetManufacturerRelationsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *GetManufacturerRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetFilmsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetFilmsResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetFilmsResponse) Reset() {
+	*x = GetFilmsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilmsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilmsResponse) ProtoMessage() {}
+
+func (x *GetFilmsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilmsResponse.ProtoReflect.Descriptor instead.
+func (*GetFilmsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFilmsResponse) GetValues() []*GetFilmsResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetCharactersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetCharactersResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetCharactersResponse) Reset() {
+	*x = GetCharactersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCharactersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCharactersResponse) ProtoMessage() {}
+
+func (x *GetCharactersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCharactersResponse.ProtoReflect.Descriptor instead.
+func (*GetCharactersResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCharactersResponse) GetValues() []*GetCharactersResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetPeopleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetPeopleResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetPeopleResponse) Reset() {
+	*x = GetPeopleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeopleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeopleResponse) ProtoMessage() {}
+
+func (x *GetPeopleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeopleResponse.ProtoReflect.Descriptor instead.
+func (*GetPeopleResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPeopleResponse) GetValues() []*GetPeopleResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetResidentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetResidentsResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetResidentsResponse) Reset() {
+	*x = GetResidentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResidentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResidentsResponse) ProtoMessage() {}
+
+func (x *GetResidentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResidentsResponse.ProtoReflect.Descriptor instead.
+func (*GetResidentsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetResidentsResponse) GetValues() []*GetResidentsResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetPilotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetPilotsResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetPilotsResponse) Reset() {
+	*x = GetPilotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPilotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPilotsResponse) ProtoMessage() {}
+
+func (x *GetPilotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPilotsResponse.ProtoReflect.Descriptor instead.
+func (*GetPilotsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPilotsResponse) GetValues() []*GetPilotsResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetCoStarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetCoStarsResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetCoStarsResponse) Reset() {
+	*x = GetCoStarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoStarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoStarsResponse) ProtoMessage() {}
+
+func (x *GetCoStarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoStarsResponse.ProtoReflect.Descriptor instead.
+func (*GetCoStarsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCoStarsResponse) GetValues() []*GetCoStarsResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetPlanetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetPlanetsResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetPlanetsResponse) Reset() {
+	*x = GetPlanetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanetsResponse) ProtoMessage() {}
+
+func (x *GetPlanetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanetsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlanetsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPlanetsResponse) GetValues() []*GetPlanetsResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetHomeworldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetHomeworldResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetHomeworldResponse) Reset() {
+	*x = GetHomeworldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHomeworldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeworldResponse) ProtoMessage() {}
+
+func (x *GetHomeworldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeworldResponse.ProtoReflect.Descriptor instead.
+func (*GetHomeworldResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetHomeworldResponse) GetValues() []*GetHomeworldResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetSpeciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetSpeciesResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetSpeciesResponse) Reset() {
+	*x = GetSpeciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpeciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesResponse) ProtoMessage() {}
+
+func (x *GetSpeciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesResponse.ProtoReflect.Descriptor instead.
+func (*GetSpeciesResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSpeciesResponse) GetValues() []*GetSpeciesResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetNativeSpeciesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetNativeSpeciesResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetNativeSpeciesResponse) Reset() {
+	*x = GetNativeSpeciesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNativeSpeciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNativeSpeciesResponse) ProtoMessage() {}
+
+func (x *GetNativeSpeciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNativeSpeciesResponse.ProtoReflect.Descriptor instead.
+func (*GetNativeSpeciesResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetNativeSpeciesResponse) GetValues() []*GetNativeSpeciesResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetStarshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetStarshipsResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetStarshipsResponse) Reset() {
+	*x = GetStarshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStarshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarshipsResponse) ProtoMessage() {}
+
+func (x *GetStarshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetStarshipsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetStarshipsResponse) GetValues() []*GetStarshipsResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetVehiclesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetVehiclesResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetVehiclesResponse) Reset() {
+	*x = GetVehiclesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehiclesResponse) ProtoMessage() {}
+
+func (x *GetVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*GetVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetVehiclesResponse) GetValues() []*GetVehiclesResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetManufacturersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*GetManufacturersResponse_Result `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *GetManufacturersResponse) Reset() {
+	*x = GetManufacturersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManufacturersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturersResponse) ProtoMessage() {}
+
+func (x *GetManufacturersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturersResponse.ProtoReflect.Descriptor instead.
+func (*GetManufacturersResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetManufacturersResponse) GetValues() []*GetManufacturersResponse_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetFilmsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Films []*v1.Film `protobuf:"bytes,1,rep,name=films,proto3" json:"films,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetFilmsResponse_Result) Reset() {
+	*x = GetFilmsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFilmsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFilmsResponse_Result) ProtoMessage() {}
+
+func (x *GetFilmsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFilmsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetFilmsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *GetFilmsResponse_Result) GetFilms() []*v1.Film {
+	if x != nil {
+		return x.Films
+	}
+	return nil
+}
+
+func (x *GetFilmsResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCharactersResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Characters []*v11.Person `protobuf:"bytes,1,rep,name=characters,proto3" json:"characters,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetCharactersResponse_Result) Reset() {
+	*x = GetCharactersResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCharactersResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCharactersResponse_Result) ProtoMessage() {}
+
+func (x *GetCharactersResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCharactersResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetCharactersResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetCharactersResponse_Result) GetCharacters() []*v11.Person {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *GetCharactersResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPeopleResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	People []*v11.Person `protobuf:"bytes,1,rep,name=people,proto3" json:"people,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetPeopleResponse_Result) Reset() {
+	*x = GetPeopleResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeopleResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeopleResponse_Result) ProtoMessage() {}
+
+func (x *GetPeopleResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeopleResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetPeopleResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GetPeopleResponse_Result) GetPeople() []*v11.Person {
+	if x != nil {
+		return x.People
+	}
+	return nil
+}
+
+func (x *GetPeopleResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetResidentsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Residents []*v11.Person `protobuf:"bytes,1,rep,name=residents,proto3" json:"residents,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetResidentsResponse_Result) Reset() {
+	*x = GetResidentsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResidentsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResidentsResponse_Result) ProtoMessage() {}
+
+func (x *GetResidentsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResidentsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetResidentsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *GetResidentsResponse_Result) GetResidents() []*v11.Person {
+	if x != nil {
+		return x.Residents
+	}
+	return nil
+}
+
+func (x *GetResidentsResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPilotsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pilots []*v11.Person `protobuf:"bytes,1,rep,name=pilots,proto3" json:"pilots,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetPilotsResponse_Result) Reset() {
+	*x = GetPilotsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPilotsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPilotsResponse_Result) ProtoMessage() {}
+
+func (x *GetPilotsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPilotsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetPilotsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetPilotsResponse_Result) GetPilots() []*v11.Person {
+	if x != nil {
+		return x.Pilots
+	}
+	return nil
+}
+
+func (x *GetPilotsResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCoStarsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoStars []*v11.Person `protobuf:"bytes,1,rep,name=co_stars,json=coStars,proto3" json:"co_stars,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetCoStarsResponse_Result) Reset() {
+	*x = GetCoStarsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCoStarsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoStarsResponse_Result) ProtoMessage() {}
+
+func (x *GetCoStarsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoStarsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetCoStarsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *GetCoStarsResponse_Result) GetCoStars() []*v11.Person {
+	if x != nil {
+		return x.CoStars
+	}
+	return nil
+}
+
+func (x *GetCoStarsResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPlanetsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Planets []*v13.Planet `protobuf:"bytes,1,rep,name=planets,proto3" json:"planets,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetPlanetsResponse_Result) Reset() {
+	*x = GetPlanetsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlanetsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlanetsResponse_Result) ProtoMessage() {}
+
+func (x *GetPlanetsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlanetsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetPlanetsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetPlanetsResponse_Result) GetPlanets() []*v13.Planet {
+	if x != nil {
+		return x.Planets
+	}
+	return nil
+}
+
+func (x *GetPlanetsResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetHomeworldResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Homeworld *v13.Planet `protobuf:"bytes,1,opt,name=homeworld,proto3" json:"homeworld,omitempty"`
+}
+
+func (x *GetHomeworldResponse_Result) Reset() {
+	*x = GetHomeworldResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHomeworldResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHomeworldResponse_Result) ProtoMessage() {}
+
+func (x *GetHomeworldResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHomeworldResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetHomeworldResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *GetHomeworldResponse_Result) GetHomeworld() *v13.Planet {
+	if x != nil {
+		return x.Homeworld
+	}
+	return nil
+}
+
+type GetSpeciesResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Species []*v12.Species `protobuf:"bytes,1,rep,name=species,proto3" json:"species,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetSpeciesResponse_Result) Reset() {
+	*x = GetSpeciesResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSpeciesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpeciesResponse_Result) ProtoMessage() {}
+
+func (x *GetSpeciesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpeciesResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetSpeciesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *GetSpeciesResponse_Result) GetSpecies() []*v12.Species {
+	if x != nil {
+		return x.Species
+	}
+	return nil
+}
+
+func (x *GetSpeciesResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetNativeSpeciesResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NativeSpecies []*v12.Species `protobuf:"bytes,1,rep,name=native_species,json=nativeSpecies,proto3" json:"native_species,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetNativeSpeciesResponse_Result) Reset() {
+	*x = GetNativeSpeciesResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNativeSpeciesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNativeSpeciesResponse_Result) ProtoMessage() {}
+
+func (x *GetNativeSpeciesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNativeSpeciesResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetNativeSpeciesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *GetNativeSpeciesResponse_Result) GetNativeSpecies() []*v12.Species {
+	if x != nil {
+		return x.NativeSpecies
+	}
+	return nil
+}
+
+func (x *GetNativeSpeciesResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetStarshipsResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Starships []*v14.Starship `protobuf:"bytes,1,rep,name=starships,proto3" json:"starships,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetStarshipsResponse_Result) Reset() {
+	*x = GetStarshipsResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStarshipsResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarshipsResponse_Result) ProtoMessage() {}
+
+func (x *GetStarshipsResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarshipsResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetStarshipsResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *GetStarshipsResponse_Result) GetStarships() []*v14.Starship {
+	if x != nil {
+		return x.Starships
+	}
+	return nil
+}
+
+func (x *GetStarshipsResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetVehiclesResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*v15.Vehicle `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetVehiclesResponse_Result) Reset() {
+	*x = GetVehiclesResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVehiclesResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehiclesResponse_Result) ProtoMessage() {}
+
+func (x *GetVehiclesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehiclesResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetVehiclesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{20, 0}
+}
+
+func (x *GetVehiclesResponse_Result) GetVehicles() []*v15.Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *GetVehiclesResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetManufacturersResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ManufacturerEntities []*v16.Manufacturer `protobuf:"bytes,1,rep,name=manufacturer_entities,json=manufacturerEntities,proto3" json:"manufacturer_entities,omitempty"`
+	// The token to get the next page of related entities for this base
+	// entity, or empty if there are no more.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetManufacturersResponse_Result) Reset() {
+	*x = GetManufacturersResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetManufacturersResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManufacturersResponse_Result) ProtoMessage() {}
+
+func (x *GetManufacturersResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManufacturersResponse_Result.ProtoReflect.Descriptor instead.
+func (*GetManufacturersResponse_Result) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *GetManufacturersResponse_Result) GetManufacturerEntities() []*v16.Manufacturer {
+	if x != nil {
+		return x.ManufacturerEntities
+	}
+	return nil
+}
+
+func (x *GetManufacturersResponse_Result) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_relations_v1_relations_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x76, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x20, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x1a, 0x26, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64,
+	0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x62, 0x75,
+	0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64,
+	0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x62, 0x75,
+	0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x62, 0x75, 0x66, 0x2f,
+	0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f,
+	0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x62, 0x75, 0x66, 0x2f,
+	0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e,
+	0x69, 0x74, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x22, 0xd1,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
+	0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x5a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3d, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x22, 0xce,
+	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05,
+	0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22,
+	0xd4, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3f, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x05, 0x62,
+	0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47,
+	0x0a, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x52, 0x05, 0x62, 0x61, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a,
+	0x04, 0x08, 0x03, 0x10, 0x04, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xd0, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x37, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x69, 0x6c, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c,
+	0x6d, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xe8, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x77, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x0a,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd8, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x6f, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x3d, 0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe4, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x75, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x73, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd8, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x6f, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdd, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x72, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40,
+	0x0a, 0x08, 0x63, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x71, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3f,
+	0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4d, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x43, 0x0a, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x09, 0x68, 0x6f, 0x6d,
+	0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x22, 0xde, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x73, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x41, 0x0a, 0x07,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a,
+	0x80, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0e, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x1a, 0x79, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x47, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe2, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x75, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x90, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x41, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x98, 0x01, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x66, 0x0a, 0x15, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x6d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x52, 0x14, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xfc, 0x05, 0x0a, 0x13, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x90, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x6d, 0x73,
+	0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x0d, 0xaa, 0x48, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x90, 0x02, 0x01,
+	0x12, 0x92, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x46,
+	0x69, 0x6c, 0x6d, 0x73, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xaa, 0x48, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x6c,
+	0x6d, 0x73, 0x90, 0x02, 0x01, 0x12, 0x90, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xaa, 0x48, 0x07, 0x0a, 0x05,
+	0x66, 0x69, 0x6c, 0x6d, 0x73, 0x90, 0x02, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x12, 0x3d, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x0d, 0xaa, 0x48, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d, 0x73, 0x90, 0x02, 0x01, 0x12,
+	0x92, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x46, 0x69,
+	0x6c, 0x6d, 0x73, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0d, 0xaa, 0x48, 0x07, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x6d,
+	0x73, 0x90, 0x02, 0x01, 0x32, 0xdf, 0x08, 0x0a, 0x15, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9b,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x37, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0xaa, 0x48, 0x0c, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x90, 0x02, 0x01, 0x12, 0xa4, 0x01, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x43, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0xaa, 0x48,
+	0x0c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x88, 0x02, 0x01,
+	0x90, 0x02, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x50, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6f,
+	0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xaa, 0x48, 0x08,
+	0x0a, 0x06, 0x70, 0x65, 0x6f, 0x70, 0x6c, 0x65, 0x90, 0x02, 0x01, 0x12, 0x9c, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0xaa, 0x48, 0x0b, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x90, 0x02, 0x01, 0x12, 0x97, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x3d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xaa, 0x48, 0x08, 0x0a, 0x06, 0x70, 0x69, 0x6c, 0x6f, 0x74,
+	0x73, 0x90, 0x02, 0x01, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x50, 0x69, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0xaa, 0x48,
+	0x08, 0x0a, 0x06, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x73, 0x90, 0x02, 0x01, 0x12, 0x97, 0x01, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x53, 0x74, 0x61, 0x72,
+	0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0xaa, 0x48, 0x0a, 0x0a, 0x08, 0x63, 0x6f, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x90, 0x02, 0x01, 0x32, 0xea, 0x03, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x92, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x50, 0x6c, 0x61, 0x6e,
+	0x65, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xaa, 0x48, 0x09, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x74, 0x73, 0x90, 0x02, 0x01, 0x12, 0x9b, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x3a, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x11, 0xaa, 0x48, 0x0b, 0x0a, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x90, 0x02, 0x01, 0x12, 0x9d, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x65, 0x73, 0x48, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x3b, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x11, 0xaa, 0x48, 0x0b, 0x0a, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6c, 0x64,
+	0x90, 0x02, 0x01, 0x32, 0xf2, 0x03, 0x0a, 0x16, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x92,
+	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65,
+	0x73, 0x12, 0x39, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0f, 0xaa, 0x48, 0x09, 0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73,
+	0x90, 0x02, 0x01, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xaa, 0x48, 0x09,
+	0x0a, 0x07, 0x73, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x90, 0x02, 0x01, 0x12, 0xa9, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x4e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0xaa, 0x48, 0x10, 0x0a, 0x0e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x73, 0x70,
+	0x65, 0x63, 0x69, 0x65, 0x73, 0x90, 0x02, 0x01, 0x32, 0xfe, 0x03, 0x0a, 0x17, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x39, 0x2e, 0x62, 0x75, 0x66, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0xaa, 0x48,
+	0x0b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x90, 0x02, 0x01, 0x12,
+	0x9c, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0xaa, 0x48, 0x0b,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x90, 0x02, 0x01, 0x12, 0xa8,
+	0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x41, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0xaa, 0x48, 0x0b, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x90, 0x02, 0x01, 0x32, 0xf4, 0x03, 0x0a, 0x16, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x6d,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0xaa, 0x48, 0x0a, 0x0a,
+	0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x90, 0x02, 0x01, 0x12, 0x99, 0x01, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x12, 0x3b, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x35, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0xaa, 0x48, 0x0a, 0x0a, 0x08, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x90, 0x02, 0x01, 0x12, 0xa5, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x73, 0x12, 0x41, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66,
+	0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10,
+	0xaa, 0x48, 0x0a, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x90, 0x02, 0x01,
+	0x32, 0x89, 0x03, 0x0a, 0x1b, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0xb4, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0xaa, 0x48, 0x17, 0x0a, 0x15, 0x6d,
+	0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x90, 0x02, 0x01, 0x12, 0xb2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x73, 0x12, 0x3c, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0xaa,
+	0x48, 0x17, 0x0a, 0x15, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x90, 0x02, 0x01, 0x42, 0xb0, 0x02, 0x0a,
+	0x24, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x6b, 0x6e, 0x69,
+	0x74, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75,
+	0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x05, 0x42, 0x4b, 0x44,
+	0x53, 0x52, 0xaa, 0x02, 0x20, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e, 0x69, 0x74, 0x2e, 0x44, 0x65,
+	0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x20, 0x42, 0x75, 0x66, 0x5c, 0x4b, 0x6e, 0x69, 0x74,
+	0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2c, 0x42, 0x75, 0x66, 0x5c, 0x4b,
+	0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x25, 0x42, 0x75, 0x66, 0x3a, 0x3a, 0x4b,
+	0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d, 0x6f, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x69,
+	0x3a, 0x3a, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescData = file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_buf_knit_demo_swapi_relations_v1_relations_proto_goTypes = []interface{}{
+	(*GetFilmRelationsRequest)(nil),         // 0: buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest
+	(*GetPersonRelationsRequest)(nil),       // 1: buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest
+	(*GetPersonRelationRequest)(nil),        // 2: buf.knit.demo.swapi.relations.v1.GetPersonRelationRequest
+	(*GetSpeciesRelationsRequest)(nil),      // 3: buf.knit.demo.swapi.relations.v1.GetSpeciesRelationsRequest
+	(*GetSpeciesRelationRequest)(nil),       // 4: buf.knit.demo.swapi.relations.v1.GetSpeciesRelationRequest
+	(*GetPlanetRelationsRequest)(nil),       // 5: buf.knit.demo.swapi.relations.v1.GetPlanetRelationsRequest
+	(*GetStarshipRelationsRequest)(nil),     // 6: buf.knit.demo.swapi.relations.v1.GetStarshipRelationsRequest
+	(*GetVehicleRelationsRequest)(nil),      // 7: buf.knit.demo.swapi.relations.v1.GetVehicleRelationsRequest
+	(*GetManufacturerRelationsRequest)(nil), // 8: buf.knit.demo.swapi.relations.v1.GetManufacturerRelationsRequest
+	(*GetFilmsResponse)(nil),                // 9: buf.knit.demo.swapi.relations.v1.GetFilmsResponse
+	(*GetCharactersResponse)(nil),           // 10: buf.knit.demo.swapi.relations.v1.GetCharactersResponse
+	(*GetPeopleResponse)(nil),               // 11: buf.knit.demo.swapi.relations.v1.GetPeopleResponse
+	(*GetResidentsResponse)(nil),            // 12: buf.knit.demo.swapi.relations.v1.GetResidentsResponse
+	(*GetPilotsResponse)(nil),               // 13: buf.knit.demo.swapi.relations.v1.GetPilotsResponse
+	(*GetCoStarsResponse)(nil),              // 14: buf.knit.demo.swapi.relations.v1.GetCoStarsResponse
+	(*GetPlanetsResponse)(nil),              // 15: buf.knit.demo.swapi.relations.v1.GetPlanetsResponse
+	(*GetHomeworldResponse)(nil),            // 16: buf.knit.demo.swapi.relations.v1.GetHomeworldResponse
+	(*GetSpeciesResponse)(nil),              // 17: buf.knit.demo.swapi.relations.v1.GetSpeciesResponse
+	(*GetNativeSpeciesResponse)(nil),        // 18: buf.knit.demo.swapi.relations.v1.GetNativeSpeciesResponse
+	(*GetStarshipsResponse)(nil),            // 19: buf.knit.demo.swapi.relations.v1.GetStarshipsResponse
+	(*GetVehiclesResponse)(nil),             // 20: buf.knit.demo.swapi.relations.v1.GetVehiclesResponse
+	(*GetManufacturersResponse)(nil),        // 21: buf.knit.demo.swapi.relations.v1.GetManufacturersResponse
+	(*GetFilmsResponse_Result)(nil),         // 22: buf.knit.demo.swapi.relations.v1.GetFilmsResponse.Result
+	(*GetCharactersResponse_Result)(nil),    // 23: buf.knit.demo.swapi.relations.v1.GetCharactersResponse.Result
+	(*GetPeopleResponse_Result)(nil),        // 24: buf.knit.demo.swapi.relations.v1.GetPeopleResponse.Result
+	(*GetResidentsResponse_Result)(nil),     // 25: buf.knit.demo.swapi.relations.v1.GetResidentsResponse.Result
+	(*GetPilotsResponse_Result)(nil),        // 26: buf.knit.demo.swapi.relations.v1.GetPilotsResponse.Result
+	(*GetCoStarsResponse_Result)(nil),       // 27: buf.knit.demo.swapi.relations.v1.GetCoStarsResponse.Result
+	(*GetPlanetsResponse_Result)(nil),       // 28: buf.knit.demo.swapi.relations.v1.GetPlanetsResponse.Result
+	(*GetHomeworldResponse_Result)(nil),     // 29: buf.knit.demo.swapi.relations.v1.GetHomeworldResponse.Result
+	(*GetSpeciesResponse_Result)(nil),       // 30: buf.knit.demo.swapi.relations.v1.GetSpeciesResponse.Result
+	(*GetNativeSpeciesResponse_Result)(nil), // 31: buf.knit.demo.swapi.relations.v1.GetNativeSpeciesResponse.Result
+	(*GetStarshipsResponse_Result)(nil),     // 32: buf.knit.demo.swapi.relations.v1.GetStarshipsResponse.Result
+	(*GetVehiclesResponse_Result)(nil),      // 33: buf.knit.demo.swapi.relations.v1.GetVehiclesResponse.Result
+	(*GetManufacturersResponse_Result)(nil), // 34: buf.knit.demo.swapi.relations.v1.GetManufacturersResponse.Result
+	(*v1.Film)(nil),                         // 35: buf.knit.demo.swapi.film.v1.Film
+	(*v11.Person)(nil),                      // 36: buf.knit.demo.swapi.person.v1.Person
+	(*v12.Species)(nil),                     // 37: buf.knit.demo.swapi.species.v1.Species
+	(*v13.Planet)(nil),                      // 38: buf.knit.demo.swapi.planet.v1.Planet
+	(*v14.Starship)(nil),                    // 39: buf.knit.demo.swapi.starship.v1.Starship
+	(*v15.Vehicle)(nil),                     // 40: buf.knit.demo.swapi.vehicle.v1.Vehicle
+	(*v16.Manufacturer)(nil),                // 41: buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+}
+var file_buf_knit_demo_swapi_relations_v1_relations_proto_depIdxs = []int32{
+	35, // 0: buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest.bases:type_name -> buf.knit.demo.swapi.film.v1.Film
+	36, // 1: buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest.bases:type_name -> buf.knit.demo.swapi.person.v1.Person
+	36, // 2: buf.knit.demo.swapi.relations.v1.GetPersonRelationRequest.bases:type_name -> buf.knit.demo.swapi.person.v1.Person
+	37, // 3: buf.knit.demo.swapi.relations.v1.GetSpeciesRelationsRequest.bases:type_name -> buf.knit.demo.swapi.species.v1.Species
+	37, // 4: buf.knit.demo.swapi.relations.v1.GetSpeciesRelationRequest.bases:type_name -> buf.knit.demo.swapi.species.v1.Species
+	38, // 5: buf.knit.demo.swapi.relations.v1.GetPlanetRelationsRequest.bases:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	39, // 6: buf.knit.demo.swapi.relations.v1.GetStarshipRelationsRequest.bases:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	40, // 7: buf.knit.demo.swapi.relations.v1.GetVehicleRelationsRequest.bases:type_name -> buf.knit.demo.swapi.vehicle.v1.Vehicle
+	41, // 8: buf.knit.demo.swapi.relations.v1.GetManufacturerRelationsRequest.bases:type_name -> buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+	22, // 9: buf.knit.demo.swapi.relations.v1.GetFilmsResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetFilmsResponse.Result
+	23, // 10: buf.knit.demo.swapi.relations.v1.GetCharactersResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetCharactersResponse.Result
+	24, // 11: buf.knit.demo.swapi.relations.v1.GetPeopleResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetPeopleResponse.Result
+	25, // 12: buf.knit.demo.swapi.relations.v1.GetResidentsResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetResidentsResponse.Result
+	26, // 13: buf.knit.demo.swapi.relations.v1.GetPilotsResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetPilotsResponse.Result
+	27, // 14: buf.knit.demo.swapi.relations.v1.GetCoStarsResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetCoStarsResponse.Result
+	28, // 15: buf.knit.demo.swapi.relations.v1.GetPlanetsResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetPlanetsResponse.Result
+	29, // 16: buf.knit.demo.swapi.relations.v1.GetHomeworldResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetHomeworldResponse.Result
+	30, // 17: buf.knit.demo.swapi.relations.v1.GetSpeciesResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetSpeciesResponse.Result
+	31, // 18: buf.knit.demo.swapi.relations.v1.GetNativeSpeciesResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetNativeSpeciesResponse.Result
+	32, // 19: buf.knit.demo.swapi.relations.v1.GetStarshipsResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetStarshipsResponse.Result
+	33, // 20: buf.knit.demo.swapi.relations.v1.GetVehiclesResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetVehiclesResponse.Result
+	34, // 21: buf.knit.demo.swapi.relations.v1.GetManufacturersResponse.values:type_name -> buf.knit.demo.swapi.relations.v1.GetManufacturersResponse.Result
+	35, // 22: buf.knit.demo.swapi.relations.v1.GetFilmsResponse.Result.films:type_name -> buf.knit.demo.swapi.film.v1.Film
+	36, // 23: buf.knit.demo.swapi.relations.v1.GetCharactersResponse.Result.characters:type_name -> buf.knit.demo.swapi.person.v1.Person
+	36, // 24: buf.knit.demo.swapi.relations.v1.GetPeopleResponse.Result.people:type_name -> buf.knit.demo.swapi.person.v1.Person
+	36, // 25: buf.knit.demo.swapi.relations.v1.GetResidentsResponse.Result.residents:type_name -> buf.knit.demo.swapi.person.v1.Person
+	36, // 26: buf.knit.demo.swapi.relations.v1.GetPilotsResponse.Result.pilots:type_name -> buf.knit.demo.swapi.person.v1.Person
+	36, // 27: buf.knit.demo.swapi.relations.v1.GetCoStarsResponse.Result.co_stars:type_name -> buf.knit.demo.swapi.person.v1.Person
+	38, // 28: buf.knit.demo.swapi.relations.v1.GetPlanetsResponse.Result.planets:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	38, // 29: buf.knit.demo.swapi.relations.v1.GetHomeworldResponse.Result.homeworld:type_name -> buf.knit.demo.swapi.planet.v1.Planet
+	37, // 30: buf.knit.demo.swapi.relations.v1.GetSpeciesResponse.Result.species:type_name -> buf.knit.demo.swapi.species.v1.Species
+	37, // 31: buf.knit.demo.swapi.relations.v1.GetNativeSpeciesResponse.Result.native_species:type_name -> buf.knit.demo.swapi.species.v1.Species
+	39, // 32: buf.knit.demo.swapi.relations.v1.GetStarshipsResponse.Result.starships:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	40, // 33: buf.knit.demo.swapi.relations.v1.GetVehiclesResponse.Result.vehicles:type_name -> buf.knit.demo.swapi.vehicle.v1.Vehicle
+	41, // 34: buf.knit.demo.swapi.relations.v1.GetManufacturersResponse.Result.manufacturer_entities:type_name -> buf.knit.demo.swapi.manufacturer.v1.Manufacturer
+	1,  // 35: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPersonFilms:input_type -> buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest
+	3,  // 36: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetSpeciesFilms:input_type -> buf.knit.demo.swapi.relations.v1.GetSpeciesRelationsRequest
+	5,  // 37: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPlanetFilms:input_type -> buf.knit.demo.swapi.relations.v1.GetPlanetRelationsRequest
+	6,  // 38: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetStarshipFilms:input_type -> buf.knit.demo.swapi.relations.v1.GetStarshipRelationsRequest
+	7,  // 39: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetVehicleFilms:input_type -> buf.knit.demo.swapi.relations.v1.GetVehicleRelationsRequest
+	0,  // 40: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetFilmCharacters:input_type -> buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest
+	3,  // 41: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesCharacters:input_type -> buf.knit.demo.swapi.relations.v1.GetSpeciesRelationsRequest
+	3,  // 42: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesPeople:input_type -> buf.knit.demo.swapi.relations.v1.GetSpeciesRelationsRequest
+	5,  // 43: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPlanetResidents:input_type -> buf.knit.demo.swapi.relations.v1.GetPlanetRelationsRequest
+	6,  // 44: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetStarshipPilots:input_type -> buf.knit.demo.swapi.relations.v1.GetStarshipRelationsRequest
+	7,  // 45: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetVehiclePilots:input_type -> buf.knit.demo.swapi.relations.v1.GetVehicleRelationsRequest
+	1,  // 46: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPersonCoStars:input_type -> buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest
+	0,  // 47: buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetFilmPlanets:input_type -> buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest
+	2,  // 48: buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetPersonHomeworld:input_type -> buf.knit.demo.swapi.relations.v1.GetPersonRelationRequest
+	4,  // 49: buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetSpeciesHomeworld:input_type -> buf.knit.demo.swapi.relations.v1.GetSpeciesRelationRequest
+	0,  // 50: buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetFilmSpecies:input_type -> buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest
+	1,  // 51: buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPersonSpecies:input_type -> buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest
+	5,  // 52: buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPlanetNativeSpecies:input_type -> buf.knit.demo.swapi.relations.v1.GetPlanetRelationsRequest
+	0,  // 53: buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetFilmStarships:input_type -> buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest
+	1,  // 54: buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetPersonStarships:input_type -> buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest
+	8,  // 55: buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetManufacturerStarships:input_type -> buf.knit.demo.swapi.relations.v1.GetManufacturerRelationsRequest
+	0,  // 56: buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetFilmVehicles:input_type -> buf.knit.demo.swapi.relations.v1.GetFilmRelationsRequest
+	1,  // 57: buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetPersonVehicles:input_type -> buf.knit.demo.swapi.relations.v1.GetPersonRelationsRequest
+	8,  // 58: buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetManufacturerVehicles:input_type -> buf.knit.demo.swapi.relations.v1.GetManufacturerRelationsRequest
+	6,  // 59: buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetStarshipManufacturers:input_type -> buf.knit.demo.swapi.relations.v1.GetStarshipRelationsRequest
+	7,  // 60: buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetVehicleManufacturers:input_type -> buf.knit.demo.swapi.relations.v1.GetVehicleRelationsRequest
+	9,  // 61: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPersonFilms:output_type -> buf.knit.demo.swapi.relations.v1.GetFilmsResponse
+	9,  // 62: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetSpeciesFilms:output_type -> buf.knit.demo.swapi.relations.v1.GetFilmsResponse
+	9,  // 63: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPlanetFilms:output_type -> buf.knit.demo.swapi.relations.v1.GetFilmsResponse
+	9,  // 64: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetStarshipFilms:output_type -> buf.knit.demo.swapi.relations.v1.GetFilmsResponse
+	9,  // 65: buf.knit.demo.swapi.relations.v1.FilmResolverService.GetVehicleFilms:output_type -> buf.knit.demo.swapi.relations.v1.GetFilmsResponse
+	10, // 66: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetFilmCharacters:output_type -> buf.knit.demo.swapi.relations.v1.GetCharactersResponse
+	10, // 67: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesCharacters:output_type -> buf.knit.demo.swapi.relations.v1.GetCharactersResponse
+	11, // 68: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesPeople:output_type -> buf.knit.demo.swapi.relations.v1.GetPeopleResponse
+	12, // 69: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPlanetResidents:output_type -> buf.knit.demo.swapi.relations.v1.GetResidentsResponse
+	13, // 70: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetStarshipPilots:output_type -> buf.knit.demo.swapi.relations.v1.GetPilotsResponse
+	13, // 71: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetVehiclePilots:output_type -> buf.knit.demo.swapi.relations.v1.GetPilotsResponse
+	14, // 72: buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPersonCoStars:output_type -> buf.knit.demo.swapi.relations.v1.GetCoStarsResponse
+	15, // 73: buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetFilmPlanets:output_type -> buf.knit.demo.swapi.relations.v1.GetPlanetsResponse
+	16, // 74: buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetPersonHomeworld:output_type -> buf.knit.demo.swapi.relations.v1.GetHomeworldResponse
+	16, // 75: buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetSpeciesHomeworld:output_type -> buf.knit.demo.swapi.relations.v1.GetHomeworldResponse
+	17, // 76: buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetFilmSpecies:output_type -> buf.knit.demo.swapi.relations.v1.GetSpeciesResponse
+	17, // 77: buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPersonSpecies:output_type -> buf.knit.demo.swapi.relations.v1.GetSpeciesResponse
+	18, // 78: buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPlanetNativeSpecies:output_type -> buf.knit.demo.swapi.relations.v1.GetNativeSpeciesResponse
+	19, // 79: buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetFilmStarships:output_type -> buf.knit.demo.swapi.relations.v1.GetStarshipsResponse
+	19, // 80: buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetPersonStarships:output_type -> buf.knit.demo.swapi.relations.v1.GetStarshipsResponse
+	19, // 81: buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetManufacturerStarships:output_type -> buf.knit.demo.swapi.relations.v1.GetStarshipsResponse
+	20, // 82: buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetFilmVehicles:output_type -> buf.knit.demo.swapi.relations.v1.GetVehiclesResponse
+	20, // 83: buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetPersonVehicles:output_type -> buf.knit.demo.swapi.relations.v1.GetVehiclesResponse
+	20, // 84: buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetManufacturerVehicles:output_type -> buf.knit.demo.swapi.relations.v1.GetVehiclesResponse
+	21, // 85: buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetStarshipManufacturers:output_type -> buf.knit.demo.swapi.relations.v1.GetManufacturersResponse
+	21, // 86: buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetVehicleManufacturers:output_type -> buf.knit.demo.swapi.relations.v1.GetManufacturersResponse
+	61, // [61:87] is the sub-list for method output_type
+	35, // [35:61] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_relations_v1_relations_proto_init() }
+func file_buf_knit_demo_swapi_relations_v1_relations_proto_init() {
+	if File_buf_knit_demo_swapi_relations_v1_relations_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilmRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonRelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpeciesRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpeciesRelationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlanetRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStarshipRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVehicleRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetManufacturerRelationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilmsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCharactersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeopleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResidentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPilotsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoStarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlanetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHomeworldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpeciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNativeSpeciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStarshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVehiclesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetManufacturersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFilmsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCharactersResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeopleResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResidentsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPilotsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCoStarsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlanetsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHomeworldResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSpeciesResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNativeSpeciesResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStarshipsResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVehiclesResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetManufacturersResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   35,
+			NumExtensions: 0,
+			NumServices:   7,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_relations_v1_relations_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_relations_v1_relations_proto_depIdxs,
+		MessageInfos:      file_buf_knit_demo_swapi_relations_v1_relations_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_relations_v1_relations_proto = out.File
+	file_buf_knit_demo_swapi_relations_v1_relations_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_relations_v1_relations_proto_goTypes = nil
+	file_buf_knit_demo_swapi_relations_v1_relations_proto_depIdxs = nil
+}
@@ -0,0 +1,1270 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/relations/v1/relations.proto
+
+package relationsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// FilmResolverServiceName is the fully-qualified name of the FilmResolverService service.
+	FilmResolverServiceName = "buf.knit.demo.swapi.relations.v1.FilmResolverService"
+	// PersonResolverServiceName is the fully-qualified name of the PersonResolverService service.
+	PersonResolverServiceName = "buf.knit.demo.swapi.relations.v1.PersonResolverService"
+	// PlanetResolverServiceName is the fully-qualified name of the PlanetResolverService service.
+	PlanetResolverServiceName = "buf.knit.demo.swapi.relations.v1.PlanetResolverService"
+	// SpeciesResolverServiceName is the fully-qualified name of the SpeciesResolverService service.
+	SpeciesResolverServiceName = "buf.knit.demo.swapi.relations.v1.SpeciesResolverService"
+	// StarshipResolverServiceName is the fully-qualified name of the StarshipResolverService service.
+	StarshipResolverServiceName = "buf.knit.demo.swapi.relations.v1.StarshipResolverService"
+	// VehicleResolverServiceName is the fully-qualified name of the VehicleResolverService service.
+	VehicleResolverServiceName = "buf.knit.demo.swapi.relations.v1.VehicleResolverService"
+	// ManufacturerResolverServiceName is the fully-qualified name of the ManufacturerResolverService
+	// service.
+	ManufacturerResolverServiceName = "buf.knit.demo.swapi.relations.v1.ManufacturerResolverService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// FilmResolverServiceGetPersonFilmsProcedure is the fully-qualified name of the
+	// FilmResolverService's GetPersonFilms RPC.
+	FilmResolverServiceGetPersonFilmsProcedure = "/buf.knit.demo.swapi.relations.v1.FilmResolverService/GetPersonFilms"
+	// FilmResolverServiceGetSpeciesFilmsProcedure is the fully-qualified name of the
+	// FilmResolverService's GetSpeciesFilms RPC.
+	FilmResolverServiceGetSpeciesFilmsProcedure = "/buf.knit.demo.swapi.relations.v1.FilmResolverService/GetSpeciesFilms"
+	// FilmResolverServiceGetPlanetFilmsProcedure is the fully-qualified name of the
+	// FilmResolverService's GetPlanetFilms RPC.
+	FilmResolverServiceGetPlanetFilmsProcedure = "/buf.knit.demo.swapi.relations.v1.FilmResolverService/GetPlanetFilms"
+	// FilmResolverServiceGetStarshipFilmsProcedure is the fully-qualified name of the
+	// FilmResolverService's GetStarshipFilms RPC.
+	FilmResolverServiceGetStarshipFilmsProcedure = "/buf.knit.demo.swapi.relations.v1.FilmResolverService/GetStarshipFilms"
+	// FilmResolverServiceGetVehicleFilmsProcedure is the fully-qualified name of the
+	// FilmResolverService's GetVehicleFilms RPC.
+	FilmResolverServiceGetVehicleFilmsProcedure = "/buf.knit.demo.swapi.relations.v1.FilmResolverService/GetVehicleFilms"
+	// PersonResolverServiceGetFilmCharactersProcedure is the fully-qualified name of the
+	// PersonResolverService's GetFilmCharacters RPC.
+	PersonResolverServiceGetFilmCharactersProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetFilmCharacters"
+	// PersonResolverServiceGetSpeciesCharactersProcedure is the fully-qualified name of the
+	// PersonResolverService's GetSpeciesCharacters RPC.
+	PersonResolverServiceGetSpeciesCharactersProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetSpeciesCharacters"
+	// PersonResolverServiceGetSpeciesPeopleProcedure is the fully-qualified name of the
+	// PersonResolverService's GetSpeciesPeople RPC.
+	PersonResolverServiceGetSpeciesPeopleProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetSpeciesPeople"
+	// PersonResolverServiceGetPlanetResidentsProcedure is the fully-qualified name of the
+	// PersonResolverService's GetPlanetResidents RPC.
+	PersonResolverServiceGetPlanetResidentsProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetPlanetResidents"
+	// PersonResolverServiceGetStarshipPilotsProcedure is the fully-qualified name of the
+	// PersonResolverService's GetStarshipPilots RPC.
+	PersonResolverServiceGetStarshipPilotsProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetStarshipPilots"
+	// PersonResolverServiceGetVehiclePilotsProcedure is the fully-qualified name of the
+	// PersonResolverService's GetVehiclePilots RPC.
+	PersonResolverServiceGetVehiclePilotsProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetVehiclePilots"
+	// PersonResolverServiceGetPersonCoStarsProcedure is the fully-qualified name of the
+	// PersonResolverService's GetPersonCoStars RPC.
+	PersonResolverServiceGetPersonCoStarsProcedure = "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetPersonCoStars"
+	// PlanetResolverServiceGetFilmPlanetsProcedure is the fully-qualified name of the
+	// PlanetResolverService's GetFilmPlanets RPC.
+	PlanetResolverServiceGetFilmPlanetsProcedure = "/buf.knit.demo.swapi.relations.v1.PlanetResolverService/GetFilmPlanets"
+	// PlanetResolverServiceGetPersonHomeworldProcedure is the fully-qualified name of the
+	// PlanetResolverService's GetPersonHomeworld RPC.
+	PlanetResolverServiceGetPersonHomeworldProcedure = "/buf.knit.demo.swapi.relations.v1.PlanetResolverService/GetPersonHomeworld"
+	// PlanetResolverServiceGetSpeciesHomeworldProcedure is the fully-qualified name of the
+	// PlanetResolverService's GetSpeciesHomeworld RPC.
+	PlanetResolverServiceGetSpeciesHomeworldProcedure = "/buf.knit.demo.swapi.relations.v1.PlanetResolverService/GetSpeciesHomeworld"
+	// SpeciesResolverServiceGetFilmSpeciesProcedure is the fully-qualified name of the
+	// SpeciesResolverService's GetFilmSpecies RPC.
+	SpeciesResolverServiceGetFilmSpeciesProcedure = "/buf.knit.demo.swapi.relations.v1.SpeciesResolverService/GetFilmSpecies"
+	// SpeciesResolverServiceGetPersonSpeciesProcedure is the fully-qualified name of the
+	// SpeciesResolverService's GetPersonSpecies RPC.
+	SpeciesResolverServiceGetPersonSpeciesProcedure = "/buf.knit.demo.swapi.relations.v1.SpeciesResolverService/GetPersonSpecies"
+	// SpeciesResolverServiceGetPlanetNativeSpeciesProcedure is the fully-qualified name of the
+	// SpeciesResolverService's GetPlanetNativeSpecies RPC.
+	SpeciesResolverServiceGetPlanetNativeSpeciesProcedure = "/buf.knit.demo.swapi.relations.v1.SpeciesResolverService/GetPlanetNativeSpecies"
+	// StarshipResolverServiceGetFilmStarshipsProcedure is the fully-qualified name of the
+	// StarshipResolverService's GetFilmStarships RPC.
+	StarshipResolverServiceGetFilmStarshipsProcedure = "/buf.knit.demo.swapi.relations.v1.StarshipResolverService/GetFilmStarships"
+	// StarshipResolverServiceGetPersonStarshipsProcedure is the fully-qualified name of the
+	// StarshipResolverService's GetPersonStarships RPC.
+	StarshipResolverServiceGetPersonStarshipsProcedure = "/buf.knit.demo.swapi.relations.v1.StarshipResolverService/GetPersonStarships"
+	// StarshipResolverServiceGetManufacturerStarshipsProcedure is the fully-qualified name of the
+	// StarshipResolverService's GetManufacturerStarships RPC.
+	StarshipResolverServiceGetManufacturerStarshipsProcedure = "/buf.knit.demo.swapi.relations.v1.StarshipResolverService/GetManufacturerStarships"
+	// VehicleResolverServiceGetFilmVehiclesProcedure is the fully-qualified name of the
+	// VehicleResolverService's GetFilmVehicles RPC.
+	VehicleResolverServiceGetFilmVehiclesProcedure = "/buf.knit.demo.swapi.relations.v1.VehicleResolverService/GetFilmVehicles"
+	// VehicleResolverServiceGetPersonVehiclesProcedure is the fully-qualified name of the
+	// VehicleResolverService's GetPersonVehicles RPC.
+	VehicleResolverServiceGetPersonVehiclesProcedure = "/buf.knit.demo.swapi.relations.v1.VehicleResolverService/GetPersonVehicles"
+	// VehicleResolverServiceGetManufacturerVehiclesProcedure is the fully-qualified name of the
+	// VehicleResolverService's GetManufacturerVehicles RPC.
+	VehicleResolverServiceGetManufacturerVehiclesProcedure = "/buf.knit.demo.swapi.relations.v1.VehicleResolverService/GetManufacturerVehicles"
+	// ManufacturerResolverServiceGetStarshipManufacturersProcedure is the fully-qualified name of the
+	// ManufacturerResolverService's GetStarshipManufacturers RPC.
+	ManufacturerResolverServiceGetStarshipManufacturersProcedure = "/buf.knit.demo.swapi.relations.v1.ManufacturerResolverService/GetStarshipManufacturers"
+	// ManufacturerResolverServiceGetVehicleManufacturersProcedure is the fully-qualified name of the
+	// ManufacturerResolverService's GetVehicleManufacturers RPC.
+	ManufacturerResolverServiceGetVehicleManufacturersProcedure = "/buf.knit.demo.swapi.relations.v1.ManufacturerResolverService/GetVehicleManufacturers"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	filmResolverServiceServiceDescriptor                                = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("FilmResolverService")
+	filmResolverServiceGetPersonFilmsMethodDescriptor                   = filmResolverServiceServiceDescriptor.Methods().ByName("GetPersonFilms")
+	filmResolverServiceGetSpeciesFilmsMethodDescriptor                  = filmResolverServiceServiceDescriptor.Methods().ByName("GetSpeciesFilms")
+	filmResolverServiceGetPlanetFilmsMethodDescriptor                   = filmResolverServiceServiceDescriptor.Methods().ByName("GetPlanetFilms")
+	filmResolverServiceGetStarshipFilmsMethodDescriptor                 = filmResolverServiceServiceDescriptor.Methods().ByName("GetStarshipFilms")
+	filmResolverServiceGetVehicleFilmsMethodDescriptor                  = filmResolverServiceServiceDescriptor.Methods().ByName("GetVehicleFilms")
+	personResolverServiceServiceDescriptor                              = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("PersonResolverService")
+	personResolverServiceGetFilmCharactersMethodDescriptor              = personResolverServiceServiceDescriptor.Methods().ByName("GetFilmCharacters")
+	personResolverServiceGetSpeciesCharactersMethodDescriptor           = personResolverServiceServiceDescriptor.Methods().ByName("GetSpeciesCharacters")
+	personResolverServiceGetSpeciesPeopleMethodDescriptor               = personResolverServiceServiceDescriptor.Methods().ByName("GetSpeciesPeople")
+	personResolverServiceGetPlanetResidentsMethodDescriptor             = personResolverServiceServiceDescriptor.Methods().ByName("GetPlanetResidents")
+	personResolverServiceGetStarshipPilotsMethodDescriptor              = personResolverServiceServiceDescriptor.Methods().ByName("GetStarshipPilots")
+	personResolverServiceGetVehiclePilotsMethodDescriptor               = personResolverServiceServiceDescriptor.Methods().ByName("GetVehiclePilots")
+	personResolverServiceGetPersonCoStarsMethodDescriptor               = personResolverServiceServiceDescriptor.Methods().ByName("GetPersonCoStars")
+	planetResolverServiceServiceDescriptor                              = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("PlanetResolverService")
+	planetResolverServiceGetFilmPlanetsMethodDescriptor                 = planetResolverServiceServiceDescriptor.Methods().ByName("GetFilmPlanets")
+	planetResolverServiceGetPersonHomeworldMethodDescriptor             = planetResolverServiceServiceDescriptor.Methods().ByName("GetPersonHomeworld")
+	planetResolverServiceGetSpeciesHomeworldMethodDescriptor            = planetResolverServiceServiceDescriptor.Methods().ByName("GetSpeciesHomeworld")
+	speciesResolverServiceServiceDescriptor                             = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("SpeciesResolverService")
+	speciesResolverServiceGetFilmSpeciesMethodDescriptor                = speciesResolverServiceServiceDescriptor.Methods().ByName("GetFilmSpecies")
+	speciesResolverServiceGetPersonSpeciesMethodDescriptor              = speciesResolverServiceServiceDescriptor.Methods().ByName("GetPersonSpecies")
+	speciesResolverServiceGetPlanetNativeSpeciesMethodDescriptor        = speciesResolverServiceServiceDescriptor.Methods().ByName("GetPlanetNativeSpecies")
+	starshipResolverServiceServiceDescriptor                            = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("StarshipResolverService")
+	starshipResolverServiceGetFilmStarshipsMethodDescriptor             = starshipResolverServiceServiceDescriptor.Methods().ByName("GetFilmStarships")
+	starshipResolverServiceGetPersonStarshipsMethodDescriptor           = starshipResolverServiceServiceDescriptor.Methods().ByName("GetPersonStarships")
+	starshipResolverServiceGetManufacturerStarshipsMethodDescriptor     = starshipResolverServiceServiceDescriptor.Methods().ByName("GetManufacturerStarships")
+	vehicleResolverServiceServiceDescriptor                             = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("VehicleResolverService")
+	vehicleResolverServiceGetFilmVehiclesMethodDescriptor               = vehicleResolverServiceServiceDescriptor.Methods().ByName("GetFilmVehicles")
+	vehicleResolverServiceGetPersonVehiclesMethodDescriptor             = vehicleResolverServiceServiceDescriptor.Methods().ByName("GetPersonVehicles")
+	vehicleResolverServiceGetManufacturerVehiclesMethodDescriptor       = vehicleResolverServiceServiceDescriptor.Methods().ByName("GetManufacturerVehicles")
+	manufacturerResolverServiceServiceDescriptor                        = v1.File_buf_knit_demo_swapi_relations_v1_relations_proto.Services().ByName("ManufacturerResolverService")
+	manufacturerResolverServiceGetStarshipManufacturersMethodDescriptor = manufacturerResolverServiceServiceDescriptor.Methods().ByName("GetStarshipManufacturers")
+	manufacturerResolverServiceGetVehicleManufacturersMethodDescriptor  = manufacturerResolverServiceServiceDescriptor.Methods().ByName("GetVehicleManufacturers")
+)
+
+// FilmResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.FilmResolverService service.
+type FilmResolverServiceClient interface {
+	GetPersonFilms(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetSpeciesFilms(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetPlanetFilms(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetStarshipFilms(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetVehicleFilms(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+}
+
+// NewFilmResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.FilmResolverService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewFilmResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) FilmResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &filmResolverServiceClient{
+		getPersonFilms: connect.NewClient[v1.GetPersonRelationsRequest, v1.GetFilmsResponse](
+			httpClient,
+			baseURL+FilmResolverServiceGetPersonFilmsProcedure,
+			connect.WithSchema(filmResolverServiceGetPersonFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSpeciesFilms: connect.NewClient[v1.GetSpeciesRelationsRequest, v1.GetFilmsResponse](
+			httpClient,
+			baseURL+FilmResolverServiceGetSpeciesFilmsProcedure,
+			connect.WithSchema(filmResolverServiceGetSpeciesFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPlanetFilms: connect.NewClient[v1.GetPlanetRelationsRequest, v1.GetFilmsResponse](
+			httpClient,
+			baseURL+FilmResolverServiceGetPlanetFilmsProcedure,
+			connect.WithSchema(filmResolverServiceGetPlanetFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getStarshipFilms: connect.NewClient[v1.GetStarshipRelationsRequest, v1.GetFilmsResponse](
+			httpClient,
+			baseURL+FilmResolverServiceGetStarshipFilmsProcedure,
+			connect.WithSchema(filmResolverServiceGetStarshipFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getVehicleFilms: connect.NewClient[v1.GetVehicleRelationsRequest, v1.GetFilmsResponse](
+			httpClient,
+			baseURL+FilmResolverServiceGetVehicleFilmsProcedure,
+			connect.WithSchema(filmResolverServiceGetVehicleFilmsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// filmResolverServiceClient implements FilmResolverServiceClient.
+type filmResolverServiceClient struct {
+	getPersonFilms   *connect.Client[v1.GetPersonRelationsRequest, v1.GetFilmsResponse]
+	getSpeciesFilms  *connect.Client[v1.GetSpeciesRelationsRequest, v1.GetFilmsResponse]
+	getPlanetFilms   *connect.Client[v1.GetPlanetRelationsRequest, v1.GetFilmsResponse]
+	getStarshipFilms *connect.Client[v1.GetStarshipRelationsRequest, v1.GetFilmsResponse]
+	getVehicleFilms  *connect.Client[v1.GetVehicleRelationsRequest, v1.GetFilmsResponse]
+}
+
+// GetPersonFilms calls buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPersonFilms.
+func (c *filmResolverServiceClient) GetPersonFilms(ctx context.Context, req *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return c.getPersonFilms.CallUnary(ctx, req)
+}
+
+// GetSpeciesFilms calls buf.knit.demo.swapi.relations.v1.FilmResolverService.GetSpeciesFilms.
+func (c *filmResolverServiceClient) GetSpeciesFilms(ctx context.Context, req *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return c.getSpeciesFilms.CallUnary(ctx, req)
+}
+
+// GetPlanetFilms calls buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPlanetFilms.
+func (c *filmResolverServiceClient) GetPlanetFilms(ctx context.Context, req *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return c.getPlanetFilms.CallUnary(ctx, req)
+}
+
+// GetStarshipFilms calls buf.knit.demo.swapi.relations.v1.FilmResolverService.GetStarshipFilms.
+func (c *filmResolverServiceClient) GetStarshipFilms(ctx context.Context, req *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return c.getStarshipFilms.CallUnary(ctx, req)
+}
+
+// GetVehicleFilms calls buf.knit.demo.swapi.relations.v1.FilmResolverService.GetVehicleFilms.
+func (c *filmResolverServiceClient) GetVehicleFilms(ctx context.Context, req *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return c.getVehicleFilms.CallUnary(ctx, req)
+}
+
+// FilmResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.FilmResolverService service.
+type FilmResolverServiceHandler interface {
+	GetPersonFilms(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetSpeciesFilms(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetPlanetFilms(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetStarshipFilms(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+	GetVehicleFilms(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error)
+}
+
+// NewFilmResolverServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewFilmResolverServiceHandler(svc FilmResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	filmResolverServiceGetPersonFilmsHandler := connect.NewUnaryHandler(
+		FilmResolverServiceGetPersonFilmsProcedure,
+		svc.GetPersonFilms,
+		connect.WithSchema(filmResolverServiceGetPersonFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmResolverServiceGetSpeciesFilmsHandler := connect.NewUnaryHandler(
+		FilmResolverServiceGetSpeciesFilmsProcedure,
+		svc.GetSpeciesFilms,
+		connect.WithSchema(filmResolverServiceGetSpeciesFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmResolverServiceGetPlanetFilmsHandler := connect.NewUnaryHandler(
+		FilmResolverServiceGetPlanetFilmsProcedure,
+		svc.GetPlanetFilms,
+		connect.WithSchema(filmResolverServiceGetPlanetFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmResolverServiceGetStarshipFilmsHandler := connect.NewUnaryHandler(
+		FilmResolverServiceGetStarshipFilmsProcedure,
+		svc.GetStarshipFilms,
+		connect.WithSchema(filmResolverServiceGetStarshipFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	filmResolverServiceGetVehicleFilmsHandler := connect.NewUnaryHandler(
+		FilmResolverServiceGetVehicleFilmsProcedure,
+		svc.GetVehicleFilms,
+		connect.WithSchema(filmResolverServiceGetVehicleFilmsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.FilmResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case FilmResolverServiceGetPersonFilmsProcedure:
+			filmResolverServiceGetPersonFilmsHandler.ServeHTTP(w, r)
+		case FilmResolverServiceGetSpeciesFilmsProcedure:
+			filmResolverServiceGetSpeciesFilmsHandler.ServeHTTP(w, r)
+		case FilmResolverServiceGetPlanetFilmsProcedure:
+			filmResolverServiceGetPlanetFilmsHandler.ServeHTTP(w, r)
+		case FilmResolverServiceGetStarshipFilmsProcedure:
+			filmResolverServiceGetStarshipFilmsHandler.ServeHTTP(w, r)
+		case FilmResolverServiceGetVehicleFilmsProcedure:
+			filmResolverServiceGetVehicleFilmsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedFilmResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedFilmResolverServiceHandler struct{}
+
+func (UnimplementedFilmResolverServiceHandler) GetPersonFilms(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPersonFilms is not implemented"))
+}
+
+func (UnimplementedFilmResolverServiceHandler) GetSpeciesFilms(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.FilmResolverService.GetSpeciesFilms is not implemented"))
+}
+
+func (UnimplementedFilmResolverServiceHandler) GetPlanetFilms(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.FilmResolverService.GetPlanetFilms is not implemented"))
+}
+
+func (UnimplementedFilmResolverServiceHandler) GetStarshipFilms(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.FilmResolverService.GetStarshipFilms is not implemented"))
+}
+
+func (UnimplementedFilmResolverServiceHandler) GetVehicleFilms(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetFilmsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.FilmResolverService.GetVehicleFilms is not implemented"))
+}
+
+// PersonResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.PersonResolverService service.
+type PersonResolverServiceClient interface {
+	GetFilmCharacters(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error)
+	// GetSpeciesCharacters resolves the same people as GetSpeciesPeople. It
+	// remains for existing clients, but new clients should use the people
+	// relation, which is named after the people_ids field of Species.
+	//
+	// Deprecated: do not use.
+	GetSpeciesCharacters(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error)
+	// GetSpeciesPeople resolves the people of the species.
+	GetSpeciesPeople(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetPeopleResponse], error)
+	GetPlanetResidents(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetResidentsResponse], error)
+	GetStarshipPilots(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error)
+	GetVehiclePilots(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error)
+	// GetPersonCoStars resolves the other people who appear in any of the
+	// person's films.
+	GetPersonCoStars(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetCoStarsResponse], error)
+}
+
+// NewPersonResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.PersonResolverService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPersonResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PersonResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &personResolverServiceClient{
+		getFilmCharacters: connect.NewClient[v1.GetFilmRelationsRequest, v1.GetCharactersResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetFilmCharactersProcedure,
+			connect.WithSchema(personResolverServiceGetFilmCharactersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSpeciesCharacters: connect.NewClient[v1.GetSpeciesRelationsRequest, v1.GetCharactersResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetSpeciesCharactersProcedure,
+			connect.WithSchema(personResolverServiceGetSpeciesCharactersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSpeciesPeople: connect.NewClient[v1.GetSpeciesRelationsRequest, v1.GetPeopleResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetSpeciesPeopleProcedure,
+			connect.WithSchema(personResolverServiceGetSpeciesPeopleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPlanetResidents: connect.NewClient[v1.GetPlanetRelationsRequest, v1.GetResidentsResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetPlanetResidentsProcedure,
+			connect.WithSchema(personResolverServiceGetPlanetResidentsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getStarshipPilots: connect.NewClient[v1.GetStarshipRelationsRequest, v1.GetPilotsResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetStarshipPilotsProcedure,
+			connect.WithSchema(personResolverServiceGetStarshipPilotsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getVehiclePilots: connect.NewClient[v1.GetVehicleRelationsRequest, v1.GetPilotsResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetVehiclePilotsProcedure,
+			connect.WithSchema(personResolverServiceGetVehiclePilotsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPersonCoStars: connect.NewClient[v1.GetPersonRelationsRequest, v1.GetCoStarsResponse](
+			httpClient,
+			baseURL+PersonResolverServiceGetPersonCoStarsProcedure,
+			connect.WithSchema(personResolverServiceGetPersonCoStarsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// personResolverServiceClient implements PersonResolverServiceClient.
+type personResolverServiceClient struct {
+	getFilmCharacters    *connect.Client[v1.GetFilmRelationsRequest, v1.GetCharactersResponse]
+	getSpeciesCharacters *connect.Client[v1.GetSpeciesRelationsRequest, v1.GetCharactersResponse]
+	getSpeciesPeople     *connect.Client[v1.GetSpeciesRelationsRequest, v1.GetPeopleResponse]
+	getPlanetResidents   *connect.Client[v1.GetPlanetRelationsRequest, v1.GetResidentsResponse]
+	getStarshipPilots    *connect.Client[v1.GetStarshipRelationsRequest, v1.GetPilotsResponse]
+	getVehiclePilots     *connect.Client[v1.GetVehicleRelationsRequest, v1.GetPilotsResponse]
+	getPersonCoStars     *connect.Client[v1.GetPersonRelationsRequest, v1.GetCoStarsResponse]
+}
+
+// GetFilmCharacters calls buf.knit.demo.swapi.relations.v1.PersonResolverService.GetFilmCharacters.
+func (c *personResolverServiceClient) GetFilmCharacters(ctx context.Context, req *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error) {
+	return c.getFilmCharacters.CallUnary(ctx, req)
+}
+
+// GetSpeciesCharacters calls
+// buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesCharacters.
+//
+// Deprecated: do not use.
+func (c *personResolverServiceClient) GetSpeciesCharacters(ctx context.Context, req *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error) {
+	return c.getSpeciesCharacters.CallUnary(ctx, req)
+}
+
+// GetSpeciesPeople calls buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesPeople.
+func (c *personResolverServiceClient) GetSpeciesPeople(ctx context.Context, req *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetPeopleResponse], error) {
+	return c.getSpeciesPeople.CallUnary(ctx, req)
+}
+
+// GetPlanetResidents calls
+// buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPlanetResidents.
+func (c *personResolverServiceClient) GetPlanetResidents(ctx context.Context, req *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetResidentsResponse], error) {
+	return c.getPlanetResidents.CallUnary(ctx, req)
+}
+
+// GetStarshipPilots calls buf.knit.demo.swapi.relations.v1.PersonResolverService.GetStarshipPilots.
+func (c *personResolverServiceClient) GetStarshipPilots(ctx context.Context, req *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error) {
+	return c.getStarshipPilots.CallUnary(ctx, req)
+}
+
+// GetVehiclePilots calls buf.knit.demo.swapi.relations.v1.PersonResolverService.GetVehiclePilots.
+func (c *personResolverServiceClient) GetVehiclePilots(ctx context.Context, req *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error) {
+	return c.getVehiclePilots.CallUnary(ctx, req)
+}
+
+// GetPersonCoStars calls buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPersonCoStars.
+func (c *personResolverServiceClient) GetPersonCoStars(ctx context.Context, req *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetCoStarsResponse], error) {
+	return c.getPersonCoStars.CallUnary(ctx, req)
+}
+
+// PersonResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.PersonResolverService service.
+type PersonResolverServiceHandler interface {
+	GetFilmCharacters(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error)
+	// GetSpeciesCharacters resolves the same people as GetSpeciesPeople. It
+	// remains for existing clients, but new clients should use the people
+	// relation, which is named after the people_ids field of Species.
+	//
+	// Deprecated: do not use.
+	GetSpeciesCharacters(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error)
+	// GetSpeciesPeople resolves the people of the species.
+	GetSpeciesPeople(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetPeopleResponse], error)
+	GetPlanetResidents(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetResidentsResponse], error)
+	GetStarshipPilots(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error)
+	GetVehiclePilots(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error)
+	// GetPersonCoStars resolves the other people who appear in any of the
+	// person's films.
+	GetPersonCoStars(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetCoStarsResponse], error)
+}
+
+// NewPersonResolverServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPersonResolverServiceHandler(svc PersonResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	personResolverServiceGetFilmCharactersHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetFilmCharactersProcedure,
+		svc.GetFilmCharacters,
+		connect.WithSchema(personResolverServiceGetFilmCharactersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personResolverServiceGetSpeciesCharactersHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetSpeciesCharactersProcedure,
+		svc.GetSpeciesCharacters,
+		connect.WithSchema(personResolverServiceGetSpeciesCharactersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personResolverServiceGetSpeciesPeopleHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetSpeciesPeopleProcedure,
+		svc.GetSpeciesPeople,
+		connect.WithSchema(personResolverServiceGetSpeciesPeopleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personResolverServiceGetPlanetResidentsHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetPlanetResidentsProcedure,
+		svc.GetPlanetResidents,
+		connect.WithSchema(personResolverServiceGetPlanetResidentsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personResolverServiceGetStarshipPilotsHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetStarshipPilotsProcedure,
+		svc.GetStarshipPilots,
+		connect.WithSchema(personResolverServiceGetStarshipPilotsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personResolverServiceGetVehiclePilotsHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetVehiclePilotsProcedure,
+		svc.GetVehiclePilots,
+		connect.WithSchema(personResolverServiceGetVehiclePilotsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	personResolverServiceGetPersonCoStarsHandler := connect.NewUnaryHandler(
+		PersonResolverServiceGetPersonCoStarsProcedure,
+		svc.GetPersonCoStars,
+		connect.WithSchema(personResolverServiceGetPersonCoStarsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.PersonResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PersonResolverServiceGetFilmCharactersProcedure:
+			personResolverServiceGetFilmCharactersHandler.ServeHTTP(w, r)
+		case PersonResolverServiceGetSpeciesCharactersProcedure:
+			personResolverServiceGetSpeciesCharactersHandler.ServeHTTP(w, r)
+		case PersonResolverServiceGetSpeciesPeopleProcedure:
+			personResolverServiceGetSpeciesPeopleHandler.ServeHTTP(w, r)
+		case PersonResolverServiceGetPlanetResidentsProcedure:
+			personResolverServiceGetPlanetResidentsHandler.ServeHTTP(w, r)
+		case PersonResolverServiceGetStarshipPilotsProcedure:
+			personResolverServiceGetStarshipPilotsHandler.ServeHTTP(w, r)
+		case PersonResolverServiceGetVehiclePilotsProcedure:
+			personResolverServiceGetVehiclePilotsHandler.ServeHTTP(w, r)
+		case PersonResolverServiceGetPersonCoStarsProcedure:
+			personResolverServiceGetPersonCoStarsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPersonResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPersonResolverServiceHandler struct{}
+
+func (UnimplementedPersonResolverServiceHandler) GetFilmCharacters(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetFilmCharacters is not implemented"))
+}
+
+func (UnimplementedPersonResolverServiceHandler) GetSpeciesCharacters(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetCharactersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesCharacters is not implemented"))
+}
+
+func (UnimplementedPersonResolverServiceHandler) GetSpeciesPeople(context.Context, *connect.Request[v1.GetSpeciesRelationsRequest]) (*connect.Response[v1.GetPeopleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetSpeciesPeople is not implemented"))
+}
+
+func (UnimplementedPersonResolverServiceHandler) GetPlanetResidents(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetResidentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPlanetResidents is not implemented"))
+}
+
+func (UnimplementedPersonResolverServiceHandler) GetStarshipPilots(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetStarshipPilots is not implemented"))
+}
+
+func (UnimplementedPersonResolverServiceHandler) GetVehiclePilots(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetPilotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetVehiclePilots is not implemented"))
+}
+
+func (UnimplementedPersonResolverServiceHandler) GetPersonCoStars(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetCoStarsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PersonResolverService.GetPersonCoStars is not implemented"))
+}
+
+// PlanetResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.PlanetResolverService service.
+type PlanetResolverServiceClient interface {
+	GetFilmPlanets(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetPlanetsResponse], error)
+	GetPersonHomeworld(context.Context, *connect.Request[v1.GetPersonRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error)
+	GetSpeciesHomeworld(context.Context, *connect.Request[v1.GetSpeciesRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error)
+}
+
+// NewPlanetResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.PlanetResolverService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPlanetResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PlanetResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &planetResolverServiceClient{
+		getFilmPlanets: connect.NewClient[v1.GetFilmRelationsRequest, v1.GetPlanetsResponse](
+			httpClient,
+			baseURL+PlanetResolverServiceGetFilmPlanetsProcedure,
+			connect.WithSchema(planetResolverServiceGetFilmPlanetsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPersonHomeworld: connect.NewClient[v1.GetPersonRelationRequest, v1.GetHomeworldResponse](
+			httpClient,
+			baseURL+PlanetResolverServiceGetPersonHomeworldProcedure,
+			connect.WithSchema(planetResolverServiceGetPersonHomeworldMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSpeciesHomeworld: connect.NewClient[v1.GetSpeciesRelationRequest, v1.GetHomeworldResponse](
+			httpClient,
+			baseURL+PlanetResolverServiceGetSpeciesHomeworldProcedure,
+			connect.WithSchema(planetResolverServiceGetSpeciesHomeworldMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// planetResolverServiceClient implements PlanetResolverServiceClient.
+type planetResolverServiceClient struct {
+	getFilmPlanets      *connect.Client[v1.GetFilmRelationsRequest, v1.GetPlanetsResponse]
+	getPersonHomeworld  *connect.Client[v1.GetPersonRelationRequest, v1.GetHomeworldResponse]
+	getSpeciesHomeworld *connect.Client[v1.GetSpeciesRelationRequest, v1.GetHomeworldResponse]
+}
+
+// GetFilmPlanets calls buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetFilmPlanets.
+func (c *planetResolverServiceClient) GetFilmPlanets(ctx context.Context, req *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetPlanetsResponse], error) {
+	return c.getFilmPlanets.CallUnary(ctx, req)
+}
+
+// GetPersonHomeworld calls
+// buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetPersonHomeworld.
+func (c *planetResolverServiceClient) GetPersonHomeworld(ctx context.Context, req *connect.Request[v1.GetPersonRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error) {
+	return c.getPersonHomeworld.CallUnary(ctx, req)
+}
+
+// GetSpeciesHomeworld calls
+// buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetSpeciesHomeworld.
+func (c *planetResolverServiceClient) GetSpeciesHomeworld(ctx context.Context, req *connect.Request[v1.GetSpeciesRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error) {
+	return c.getSpeciesHomeworld.CallUnary(ctx, req)
+}
+
+// PlanetResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.PlanetResolverService service.
+type PlanetResolverServiceHandler interface {
+	GetFilmPlanets(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetPlanetsResponse], error)
+	GetPersonHomeworld(context.Context, *connect.Request[v1.GetPersonRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error)
+	GetSpeciesHomeworld(context.Context, *connect.Request[v1.GetSpeciesRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error)
+}
+
+// NewPlanetResolverServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPlanetResolverServiceHandler(svc PlanetResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	planetResolverServiceGetFilmPlanetsHandler := connect.NewUnaryHandler(
+		PlanetResolverServiceGetFilmPlanetsProcedure,
+		svc.GetFilmPlanets,
+		connect.WithSchema(planetResolverServiceGetFilmPlanetsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetResolverServiceGetPersonHomeworldHandler := connect.NewUnaryHandler(
+		PlanetResolverServiceGetPersonHomeworldProcedure,
+		svc.GetPersonHomeworld,
+		connect.WithSchema(planetResolverServiceGetPersonHomeworldMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	planetResolverServiceGetSpeciesHomeworldHandler := connect.NewUnaryHandler(
+		PlanetResolverServiceGetSpeciesHomeworldProcedure,
+		svc.GetSpeciesHomeworld,
+		connect.WithSchema(planetResolverServiceGetSpeciesHomeworldMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.PlanetResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PlanetResolverServiceGetFilmPlanetsProcedure:
+			planetResolverServiceGetFilmPlanetsHandler.ServeHTTP(w, r)
+		case PlanetResolverServiceGetPersonHomeworldProcedure:
+			planetResolverServiceGetPersonHomeworldHandler.ServeHTTP(w, r)
+		case PlanetResolverServiceGetSpeciesHomeworldProcedure:
+			planetResolverServiceGetSpeciesHomeworldHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPlanetResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPlanetResolverServiceHandler struct{}
+
+func (UnimplementedPlanetResolverServiceHandler) GetFilmPlanets(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetPlanetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetFilmPlanets is not implemented"))
+}
+
+func (UnimplementedPlanetResolverServiceHandler) GetPersonHomeworld(context.Context, *connect.Request[v1.GetPersonRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetPersonHomeworld is not implemented"))
+}
+
+func (UnimplementedPlanetResolverServiceHandler) GetSpeciesHomeworld(context.Context, *connect.Request[v1.GetSpeciesRelationRequest]) (*connect.Response[v1.GetHomeworldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.PlanetResolverService.GetSpeciesHomeworld is not implemented"))
+}
+
+// SpeciesResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.SpeciesResolverService service.
+type SpeciesResolverServiceClient interface {
+	GetFilmSpecies(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error)
+	GetPersonSpecies(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error)
+	// GetPlanetNativeSpecies resolves the species whose homeworld is the
+	// planet.
+	GetPlanetNativeSpecies(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetNativeSpeciesResponse], error)
+}
+
+// NewSpeciesResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.SpeciesResolverService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSpeciesResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SpeciesResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &speciesResolverServiceClient{
+		getFilmSpecies: connect.NewClient[v1.GetFilmRelationsRequest, v1.GetSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesResolverServiceGetFilmSpeciesProcedure,
+			connect.WithSchema(speciesResolverServiceGetFilmSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPersonSpecies: connect.NewClient[v1.GetPersonRelationsRequest, v1.GetSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesResolverServiceGetPersonSpeciesProcedure,
+			connect.WithSchema(speciesResolverServiceGetPersonSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPlanetNativeSpecies: connect.NewClient[v1.GetPlanetRelationsRequest, v1.GetNativeSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesResolverServiceGetPlanetNativeSpeciesProcedure,
+			connect.WithSchema(speciesResolverServiceGetPlanetNativeSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// speciesResolverServiceClient implements SpeciesResolverServiceClient.
+type speciesResolverServiceClient struct {
+	getFilmSpecies         *connect.Client[v1.GetFilmRelationsRequest, v1.GetSpeciesResponse]
+	getPersonSpecies       *connect.Client[v1.GetPersonRelationsRequest, v1.GetSpeciesResponse]
+	getPlanetNativeSpecies *connect.Client[v1.GetPlanetRelationsRequest, v1.GetNativeSpeciesResponse]
+}
+
+// GetFilmSpecies calls buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetFilmSpecies.
+func (c *speciesResolverServiceClient) GetFilmSpecies(ctx context.Context, req *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error) {
+	return c.getFilmSpecies.CallUnary(ctx, req)
+}
+
+// GetPersonSpecies calls buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPersonSpecies.
+func (c *speciesResolverServiceClient) GetPersonSpecies(ctx context.Context, req *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error) {
+	return c.getPersonSpecies.CallUnary(ctx, req)
+}
+
+// GetPlanetNativeSpecies calls
+// buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPlanetNativeSpecies.
+func (c *speciesResolverServiceClient) GetPlanetNativeSpecies(ctx context.Context, req *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetNativeSpeciesResponse], error) {
+	return c.getPlanetNativeSpecies.CallUnary(ctx, req)
+}
+
+// SpeciesResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.SpeciesResolverService service.
+type SpeciesResolverServiceHandler interface {
+	GetFilmSpecies(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error)
+	GetPersonSpecies(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error)
+	// GetPlanetNativeSpecies resolves the species whose homeworld is the
+	// planet.
+	GetPlanetNativeSpecies(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetNativeSpeciesResponse], error)
+}
+
+// NewSpeciesResolverServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSpeciesResolverServiceHandler(svc SpeciesResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	speciesResolverServiceGetFilmSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesResolverServiceGetFilmSpeciesProcedure,
+		svc.GetFilmSpecies,
+		connect.WithSchema(speciesResolverServiceGetFilmSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesResolverServiceGetPersonSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesResolverServiceGetPersonSpeciesProcedure,
+		svc.GetPersonSpecies,
+		connect.WithSchema(speciesResolverServiceGetPersonSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesResolverServiceGetPlanetNativeSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesResolverServiceGetPlanetNativeSpeciesProcedure,
+		svc.GetPlanetNativeSpecies,
+		connect.WithSchema(speciesResolverServiceGetPlanetNativeSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.SpeciesResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SpeciesResolverServiceGetFilmSpeciesProcedure:
+			speciesResolverServiceGetFilmSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesResolverServiceGetPersonSpeciesProcedure:
+			speciesResolverServiceGetPersonSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesResolverServiceGetPlanetNativeSpeciesProcedure:
+			speciesResolverServiceGetPlanetNativeSpeciesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSpeciesResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSpeciesResolverServiceHandler struct{}
+
+func (UnimplementedSpeciesResolverServiceHandler) GetFilmSpecies(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetFilmSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesResolverServiceHandler) GetPersonSpecies(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPersonSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesResolverServiceHandler) GetPlanetNativeSpecies(context.Context, *connect.Request[v1.GetPlanetRelationsRequest]) (*connect.Response[v1.GetNativeSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.SpeciesResolverService.GetPlanetNativeSpecies is not implemented"))
+}
+
+// StarshipResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.StarshipResolverService service.
+type StarshipResolverServiceClient interface {
+	GetFilmStarships(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+	GetPersonStarships(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+	// GetManufacturerStarships resolves the starships that the manufacturer
+	// made.
+	GetManufacturerStarships(context.Context, *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+}
+
+// NewStarshipResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.StarshipResolverService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStarshipResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StarshipResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &starshipResolverServiceClient{
+		getFilmStarships: connect.NewClient[v1.GetFilmRelationsRequest, v1.GetStarshipsResponse](
+			httpClient,
+			baseURL+StarshipResolverServiceGetFilmStarshipsProcedure,
+			connect.WithSchema(starshipResolverServiceGetFilmStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPersonStarships: connect.NewClient[v1.GetPersonRelationsRequest, v1.GetStarshipsResponse](
+			httpClient,
+			baseURL+StarshipResolverServiceGetPersonStarshipsProcedure,
+			connect.WithSchema(starshipResolverServiceGetPersonStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getManufacturerStarships: connect.NewClient[v1.GetManufacturerRelationsRequest, v1.GetStarshipsResponse](
+			httpClient,
+			baseURL+StarshipResolverServiceGetManufacturerStarshipsProcedure,
+			connect.WithSchema(starshipResolverServiceGetManufacturerStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// starshipResolverServiceClient implements StarshipResolverServiceClient.
+type starshipResolverServiceClient struct {
+	getFilmStarships         *connect.Client[v1.GetFilmRelationsRequest, v1.GetStarshipsResponse]
+	getPersonStarships       *connect.Client[v1.GetPersonRelationsRequest, v1.GetStarshipsResponse]
+	getManufacturerStarships *connect.Client[v1.GetManufacturerRelationsRequest, v1.GetStarshipsResponse]
+}
+
+// GetFilmStarships calls buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetFilmStarships.
+func (c *starshipResolverServiceClient) GetFilmStarships(ctx context.Context, req *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return c.getFilmStarships.CallUnary(ctx, req)
+}
+
+// GetPersonStarships calls
+// buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetPersonStarships.
+func (c *starshipResolverServiceClient) GetPersonStarships(ctx context.Context, req *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return c.getPersonStarships.CallUnary(ctx, req)
+}
+
+// GetManufacturerStarships calls
+// buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetManufacturerStarships.
+func (c *starshipResolverServiceClient) GetManufacturerStarships(ctx context.Context, req *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return c.getManufacturerStarships.CallUnary(ctx, req)
+}
+
+// StarshipResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.StarshipResolverService service.
+type StarshipResolverServiceHandler interface {
+	GetFilmStarships(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+	GetPersonStarships(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+	// GetManufacturerStarships resolves the starships that the manufacturer
+	// made.
+	GetManufacturerStarships(context.Context, *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+}
+
+// NewStarshipResolverServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStarshipResolverServiceHandler(svc StarshipResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	starshipResolverServiceGetFilmStarshipsHandler := connect.NewUnaryHandler(
+		StarshipResolverServiceGetFilmStarshipsProcedure,
+		svc.GetFilmStarships,
+		connect.WithSchema(starshipResolverServiceGetFilmStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipResolverServiceGetPersonStarshipsHandler := connect.NewUnaryHandler(
+		StarshipResolverServiceGetPersonStarshipsProcedure,
+		svc.GetPersonStarships,
+		connect.WithSchema(starshipResolverServiceGetPersonStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipResolverServiceGetManufacturerStarshipsHandler := connect.NewUnaryHandler(
+		StarshipResolverServiceGetManufacturerStarshipsProcedure,
+		svc.GetManufacturerStarships,
+		connect.WithSchema(starshipResolverServiceGetManufacturerStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.StarshipResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StarshipResolverServiceGetFilmStarshipsProcedure:
+			starshipResolverServiceGetFilmStarshipsHandler.ServeHTTP(w, r)
+		case StarshipResolverServiceGetPersonStarshipsProcedure:
+			starshipResolverServiceGetPersonStarshipsHandler.ServeHTTP(w, r)
+		case StarshipResolverServiceGetManufacturerStarshipsProcedure:
+			starshipResolverServiceGetManufacturerStarshipsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStarshipResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStarshipResolverServiceHandler struct{}
+
+func (UnimplementedStarshipResolverServiceHandler) GetFilmStarships(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetFilmStarships is not implemented"))
+}
+
+func (UnimplementedStarshipResolverServiceHandler) GetPersonStarships(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetPersonStarships is not implemented"))
+}
+
+func (UnimplementedStarshipResolverServiceHandler) GetManufacturerStarships(context.Context, *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.StarshipResolverService.GetManufacturerStarships is not implemented"))
+}
+
+// VehicleResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.VehicleResolverService service.
+type VehicleResolverServiceClient interface {
+	GetFilmVehicles(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+	GetPersonVehicles(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+	// GetManufacturerVehicles resolves the vehicles that the manufacturer
+	// made.
+	GetManufacturerVehicles(context.Context, *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+}
+
+// NewVehicleResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.VehicleResolverService service. By default, it uses the Connect
+// protocol with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed
+// requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewVehicleResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VehicleResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &vehicleResolverServiceClient{
+		getFilmVehicles: connect.NewClient[v1.GetFilmRelationsRequest, v1.GetVehiclesResponse](
+			httpClient,
+			baseURL+VehicleResolverServiceGetFilmVehiclesProcedure,
+			connect.WithSchema(vehicleResolverServiceGetFilmVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPersonVehicles: connect.NewClient[v1.GetPersonRelationsRequest, v1.GetVehiclesResponse](
+			httpClient,
+			baseURL+VehicleResolverServiceGetPersonVehiclesProcedure,
+			connect.WithSchema(vehicleResolverServiceGetPersonVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getManufacturerVehicles: connect.NewClient[v1.GetManufacturerRelationsRequest, v1.GetVehiclesResponse](
+			httpClient,
+			baseURL+VehicleResolverServiceGetManufacturerVehiclesProcedure,
+			connect.WithSchema(vehicleResolverServiceGetManufacturerVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// vehicleResolverServiceClient implements VehicleResolverServiceClient.
+type vehicleResolverServiceClient struct {
+	getFilmVehicles         *connect.Client[v1.GetFilmRelationsRequest, v1.GetVehiclesResponse]
+	getPersonVehicles       *connect.Client[v1.GetPersonRelationsRequest, v1.GetVehiclesResponse]
+	getManufacturerVehicles *connect.Client[v1.GetManufacturerRelationsRequest, v1.GetVehiclesResponse]
+}
+
+// GetFilmVehicles calls buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetFilmVehicles.
+func (c *vehicleResolverServiceClient) GetFilmVehicles(ctx context.Context, req *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return c.getFilmVehicles.CallUnary(ctx, req)
+}
+
+// GetPersonVehicles calls
+// buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetPersonVehicles.
+func (c *vehicleResolverServiceClient) GetPersonVehicles(ctx context.Context, req *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return c.getPersonVehicles.CallUnary(ctx, req)
+}
+
+// GetManufacturerVehicles calls
+// buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetManufacturerVehicles.
+func (c *vehicleResolverServiceClient) GetManufacturerVehicles(ctx context.Context, req *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return c.getManufacturerVehicles.CallUnary(ctx, req)
+}
+
+// VehicleResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.VehicleResolverService service.
+type VehicleResolverServiceHandler interface {
+	GetFilmVehicles(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+	GetPersonVehicles(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+	// GetManufacturerVehicles resolves the vehicles that the manufacturer
+	// made.
+	GetManufacturerVehicles(context.Context, *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+}
+
+// NewVehicleResolverServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewVehicleResolverServiceHandler(svc VehicleResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vehicleResolverServiceGetFilmVehiclesHandler := connect.NewUnaryHandler(
+		VehicleResolverServiceGetFilmVehiclesProcedure,
+		svc.GetFilmVehicles,
+		connect.WithSchema(vehicleResolverServiceGetFilmVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleResolverServiceGetPersonVehiclesHandler := connect.NewUnaryHandler(
+		VehicleResolverServiceGetPersonVehiclesProcedure,
+		svc.GetPersonVehicles,
+		connect.WithSchema(vehicleResolverServiceGetPersonVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleResolverServiceGetManufacturerVehiclesHandler := connect.NewUnaryHandler(
+		VehicleResolverServiceGetManufacturerVehiclesProcedure,
+		svc.GetManufacturerVehicles,
+		connect.WithSchema(vehicleResolverServiceGetManufacturerVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.VehicleResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case VehicleResolverServiceGetFilmVehiclesProcedure:
+			vehicleResolverServiceGetFilmVehiclesHandler.ServeHTTP(w, r)
+		case VehicleResolverServiceGetPersonVehiclesProcedure:
+			vehicleResolverServiceGetPersonVehiclesHandler.ServeHTTP(w, r)
+		case VehicleResolverServiceGetManufacturerVehiclesProcedure:
+			vehicleResolverServiceGetManufacturerVehiclesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedVehicleResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedVehicleResolverServiceHandler struct{}
+
+func (UnimplementedVehicleResolverServiceHandler) GetFilmVehicles(context.Context, *connect.Request[v1.GetFilmRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetFilmVehicles is not implemented"))
+}
+
+func (UnimplementedVehicleResolverServiceHandler) GetPersonVehicles(context.Context, *connect.Request[v1.GetPersonRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetPersonVehicles is not implemented"))
+}
+
+func (UnimplementedVehicleResolverServiceHandler) GetManufacturerVehicles(context.Context, *connect.Request[v1.GetManufacturerRelationsRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.VehicleResolverService.GetManufacturerVehicles is not implemented"))
+}
+
+// ManufacturerResolverServiceClient is a client for the
+// buf.knit.demo.swapi.relations.v1.ManufacturerResolverService service.
+type ManufacturerResolverServiceClient interface {
+	// GetStarshipManufacturers resolves the manufacturers that made the
+	// starship.
+	GetStarshipManufacturers(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error)
+	// GetVehicleManufacturers resolves the manufacturers that made the
+	// vehicle.
+	GetVehicleManufacturers(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error)
+}
+
+// NewManufacturerResolverServiceClient constructs a client for the
+// buf.knit.demo.swapi.relations.v1.ManufacturerResolverService service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewManufacturerResolverServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ManufacturerResolverServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &manufacturerResolverServiceClient{
+		getStarshipManufacturers: connect.NewClient[v1.GetStarshipRelationsRequest, v1.GetManufacturersResponse](
+			httpClient,
+			baseURL+ManufacturerResolverServiceGetStarshipManufacturersProcedure,
+			connect.WithSchema(manufacturerResolverServiceGetStarshipManufacturersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getVehicleManufacturers: connect.NewClient[v1.GetVehicleRelationsRequest, v1.GetManufacturersResponse](
+			httpClient,
+			baseURL+ManufacturerResolverServiceGetVehicleManufacturersProcedure,
+			connect.WithSchema(manufacturerResolverServiceGetVehicleManufacturersMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// manufacturerResolverServiceClient implements ManufacturerResolverServiceClient.
+type manufacturerResolverServiceClient struct {
+	getStarshipManufacturers *connect.Client[v1.GetStarshipRelationsRequest, v1.GetManufacturersResponse]
+	getVehicleManufacturers  *connect.Client[v1.GetVehicleRelationsRequest, v1.GetManufacturersResponse]
+}
+
+// GetStarshipManufacturers calls
+// buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetStarshipManufacturers.
+func (c *manufacturerResolverServiceClient) GetStarshipManufacturers(ctx context.Context, req *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error) {
+	return c.getStarshipManufacturers.CallUnary(ctx, req)
+}
+
+// GetVehicleManufacturers calls
+// buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetVehicleManufacturers.
+func (c *manufacturerResolverServiceClient) GetVehicleManufacturers(ctx context.Context, req *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error) {
+	return c.getVehicleManufacturers.CallUnary(ctx, req)
+}
+
+// ManufacturerResolverServiceHandler is an implementation of the
+// buf.knit.demo.swapi.relations.v1.ManufacturerResolverService service.
+type ManufacturerResolverServiceHandler interface {
+	// GetStarshipManufacturers resolves the manufacturers that made the
+	// starship.
+	GetStarshipManufacturers(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error)
+	// GetVehicleManufacturers resolves the manufacturers that made the
+	// vehicle.
+	GetVehicleManufacturers(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error)
+}
+
+// NewManufacturerResolverServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewManufacturerResolverServiceHandler(svc ManufacturerResolverServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	manufacturerResolverServiceGetStarshipManufacturersHandler := connect.NewUnaryHandler(
+		ManufacturerResolverServiceGetStarshipManufacturersProcedure,
+		svc.GetStarshipManufacturers,
+		connect.WithSchema(manufacturerResolverServiceGetStarshipManufacturersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	manufacturerResolverServiceGetVehicleManufacturersHandler := connect.NewUnaryHandler(
+		ManufacturerResolverServiceGetVehicleManufacturersProcedure,
+		svc.GetVehicleManufacturers,
+		connect.WithSchema(manufacturerResolverServiceGetVehicleManufacturersMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.relations.v1.ManufacturerResolverService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ManufacturerResolverServiceGetStarshipManufacturersProcedure:
+			manufacturerResolverServiceGetStarshipManufacturersHandler.ServeHTTP(w, r)
+		case ManufacturerResolverServiceGetVehicleManufacturersProcedure:
+			manufacturerResolverServiceGetVehicleManufacturersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedManufacturerResolverServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedManufacturerResolverServiceHandler struct{}
+
+func (UnimplementedManufacturerResolverServiceHandler) GetStarshipManufacturers(context.Context, *connect.Request[v1.GetStarshipRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetStarshipManufacturers is not implemented"))
+}
+
+func (UnimplementedManufacturerResolverServiceHandler) GetVehicleManufacturers(context.Context, *connect.Request[v1.GetVehicleRelationsRequest]) (*connect.Response[v1.GetManufacturersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.relations.v1.ManufacturerResolverService.GetVehicleManufacturers is not implemented"))
+}
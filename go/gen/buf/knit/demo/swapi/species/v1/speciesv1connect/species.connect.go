@@ -0,0 +1,332 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/species/v1/species.proto
+
+package speciesv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SpeciesServiceName is the fully-qualified name of the SpeciesService service.
+	SpeciesServiceName = "buf.knit.demo.swapi.species.v1.SpeciesService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SpeciesServiceGetSpeciesProcedure is the fully-qualified name of the SpeciesService's GetSpecies
+	// RPC.
+	SpeciesServiceGetSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/GetSpecies"
+	// SpeciesServiceListSpeciesProcedure is the fully-qualified name of the SpeciesService's
+	// ListSpecies RPC.
+	SpeciesServiceListSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/ListSpecies"
+	// SpeciesServiceStreamSpeciesProcedure is the fully-qualified name of the SpeciesService's
+	// StreamSpecies RPC.
+	SpeciesServiceStreamSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/StreamSpecies"
+	// SpeciesServiceCreateSpeciesProcedure is the fully-qualified name of the SpeciesService's
+	// CreateSpecies RPC.
+	SpeciesServiceCreateSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/CreateSpecies"
+	// SpeciesServiceUpdateSpeciesProcedure is the fully-qualified name of the SpeciesService's
+	// UpdateSpecies RPC.
+	SpeciesServiceUpdateSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/UpdateSpecies"
+	// SpeciesServiceDeleteSpeciesProcedure is the fully-qualified name of the SpeciesService's
+	// DeleteSpecies RPC.
+	SpeciesServiceDeleteSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/DeleteSpecies"
+	// SpeciesServiceWatchSpeciesProcedure is the fully-qualified name of the SpeciesService's
+	// WatchSpecies RPC.
+	SpeciesServiceWatchSpeciesProcedure = "/buf.knit.demo.swapi.species.v1.SpeciesService/WatchSpecies"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	speciesServiceServiceDescriptor             = v1.File_buf_knit_demo_swapi_species_v1_species_proto.Services().ByName("SpeciesService")
+	speciesServiceGetSpeciesMethodDescriptor    = speciesServiceServiceDescriptor.Methods().ByName("GetSpecies")
+	speciesServiceListSpeciesMethodDescriptor   = speciesServiceServiceDescriptor.Methods().ByName("ListSpecies")
+	speciesServiceStreamSpeciesMethodDescriptor = speciesServiceServiceDescriptor.Methods().ByName("StreamSpecies")
+	speciesServiceCreateSpeciesMethodDescriptor = speciesServiceServiceDescriptor.Methods().ByName("CreateSpecies")
+	speciesServiceUpdateSpeciesMethodDescriptor = speciesServiceServiceDescriptor.Methods().ByName("UpdateSpecies")
+	speciesServiceDeleteSpeciesMethodDescriptor = speciesServiceServiceDescriptor.Methods().ByName("DeleteSpecies")
+	speciesServiceWatchSpeciesMethodDescriptor  = speciesServiceServiceDescriptor.Methods().ByName("WatchSpecies")
+)
+
+// SpeciesServiceClient is a client for the buf.knit.demo.swapi.species.v1.SpeciesService service.
+type SpeciesServiceClient interface {
+	GetSpecies(context.Context, *connect.Request[v1.GetSpeciesRequest]) (*connect.Response[v1.GetSpeciesResponse], error)
+	ListSpecies(context.Context, *connect.Request[v1.ListSpeciesRequest]) (*connect.Response[v1.ListSpeciesResponse], error)
+	// StreamSpecies streams all species, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListSpecies.
+	StreamSpecies(context.Context, *connect.Request[v1.StreamSpeciesRequest]) (*connect.ServerStreamForClient[v1.StreamSpeciesResponse], error)
+	// CreateSpecies creates a new species.
+	CreateSpecies(context.Context, *connect.Request[v1.CreateSpeciesRequest]) (*connect.Response[v1.CreateSpeciesResponse], error)
+	// UpdateSpecies updates an existing species.
+	UpdateSpecies(context.Context, *connect.Request[v1.UpdateSpeciesRequest]) (*connect.Response[v1.UpdateSpeciesResponse], error)
+	// DeleteSpecies deletes a species.
+	DeleteSpecies(context.Context, *connect.Request[v1.DeleteSpeciesRequest]) (*connect.Response[v1.DeleteSpeciesResponse], error)
+	// WatchSpecies streams the changes to species, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchSpecies(context.Context, *connect.Request[v1.WatchSpeciesRequest]) (*connect.ServerStreamForClient[v1.WatchSpeciesResponse], error)
+}
+
+// NewSpeciesServiceClient constructs a client for the buf.knit.demo.swapi.species.v1.SpeciesService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSpeciesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SpeciesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &speciesServiceClient{
+		getSpecies: connect.NewClient[v1.GetSpeciesRequest, v1.GetSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceGetSpeciesProcedure,
+			connect.WithSchema(speciesServiceGetSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listSpecies: connect.NewClient[v1.ListSpeciesRequest, v1.ListSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceListSpeciesProcedure,
+			connect.WithSchema(speciesServiceListSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamSpecies: connect.NewClient[v1.StreamSpeciesRequest, v1.StreamSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceStreamSpeciesProcedure,
+			connect.WithSchema(speciesServiceStreamSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createSpecies: connect.NewClient[v1.CreateSpeciesRequest, v1.CreateSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceCreateSpeciesProcedure,
+			connect.WithSchema(speciesServiceCreateSpeciesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateSpecies: connect.NewClient[v1.UpdateSpeciesRequest, v1.UpdateSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceUpdateSpeciesProcedure,
+			connect.WithSchema(speciesServiceUpdateSpeciesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteSpecies: connect.NewClient[v1.DeleteSpeciesRequest, v1.DeleteSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceDeleteSpeciesProcedure,
+			connect.WithSchema(speciesServiceDeleteSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		watchSpecies: connect.NewClient[v1.WatchSpeciesRequest, v1.WatchSpeciesResponse](
+			httpClient,
+			baseURL+SpeciesServiceWatchSpeciesProcedure,
+			connect.WithSchema(speciesServiceWatchSpeciesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// speciesServiceClient implements SpeciesServiceClient.
+type speciesServiceClient struct {
+	getSpecies    *connect.Client[v1.GetSpeciesRequest, v1.GetSpeciesResponse]
+	listSpecies   *connect.Client[v1.ListSpeciesRequest, v1.ListSpeciesResponse]
+	streamSpecies *connect.Client[v1.StreamSpeciesRequest, v1.StreamSpeciesResponse]
+	createSpecies *connect.Client[v1.CreateSpeciesRequest, v1.CreateSpeciesResponse]
+	updateSpecies *connect.Client[v1.UpdateSpeciesRequest, v1.UpdateSpeciesResponse]
+	deleteSpecies *connect.Client[v1.DeleteSpeciesRequest, v1.DeleteSpeciesResponse]
+	watchSpecies  *connect.Client[v1.WatchSpeciesRequest, v1.WatchSpeciesResponse]
+}
+
+// GetSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.GetSpecies.
+func (c *speciesServiceClient) GetSpecies(ctx context.Context, req *connect.Request[v1.GetSpeciesRequest]) (*connect.Response[v1.GetSpeciesResponse], error) {
+	return c.getSpecies.CallUnary(ctx, req)
+}
+
+// ListSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.ListSpecies.
+func (c *speciesServiceClient) ListSpecies(ctx context.Context, req *connect.Request[v1.ListSpeciesRequest]) (*connect.Response[v1.ListSpeciesResponse], error) {
+	return c.listSpecies.CallUnary(ctx, req)
+}
+
+// StreamSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.StreamSpecies.
+func (c *speciesServiceClient) StreamSpecies(ctx context.Context, req *connect.Request[v1.StreamSpeciesRequest]) (*connect.ServerStreamForClient[v1.StreamSpeciesResponse], error) {
+	return c.streamSpecies.CallServerStream(ctx, req)
+}
+
+// CreateSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.CreateSpecies.
+func (c *speciesServiceClient) CreateSpecies(ctx context.Context, req *connect.Request[v1.CreateSpeciesRequest]) (*connect.Response[v1.CreateSpeciesResponse], error) {
+	return c.createSpecies.CallUnary(ctx, req)
+}
+
+// UpdateSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.UpdateSpecies.
+func (c *speciesServiceClient) UpdateSpecies(ctx context.Context, req *connect.Request[v1.UpdateSpeciesRequest]) (*connect.Response[v1.UpdateSpeciesResponse], error) {
+	return c.updateSpecies.CallUnary(ctx, req)
+}
+
+// DeleteSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.DeleteSpecies.
+func (c *speciesServiceClient) DeleteSpecies(ctx context.Context, req *connect.Request[v1.DeleteSpeciesRequest]) (*connect.Response[v1.DeleteSpeciesResponse], error) {
+	return c.deleteSpecies.CallUnary(ctx, req)
+}
+
+// WatchSpecies calls buf.knit.demo.swapi.species.v1.SpeciesService.WatchSpecies.
+func (c *speciesServiceClient) WatchSpecies(ctx context.Context, req *connect.Request[v1.WatchSpeciesRequest]) (*connect.ServerStreamForClient[v1.WatchSpeciesResponse], error) {
+	return c.watchSpecies.CallServerStream(ctx, req)
+}
+
+// SpeciesServiceHandler is an implementation of the buf.knit.demo.swapi.species.v1.SpeciesService
+// service.
+type SpeciesServiceHandler interface {
+	GetSpecies(context.Context, *connect.Request[v1.GetSpeciesRequest]) (*connect.Response[v1.GetSpeciesResponse], error)
+	ListSpecies(context.Context, *connect.Request[v1.ListSpeciesRequest]) (*connect.Response[v1.ListSpeciesResponse], error)
+	// StreamSpecies streams all species, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListSpecies.
+	StreamSpecies(context.Context, *connect.Request[v1.StreamSpeciesRequest], *connect.ServerStream[v1.StreamSpeciesResponse]) error
+	// CreateSpecies creates a new species.
+	CreateSpecies(context.Context, *connect.Request[v1.CreateSpeciesRequest]) (*connect.Response[v1.CreateSpeciesResponse], error)
+	// UpdateSpecies updates an existing species.
+	UpdateSpecies(context.Context, *connect.Request[v1.UpdateSpeciesRequest]) (*connect.Response[v1.UpdateSpeciesResponse], error)
+	// DeleteSpecies deletes a species.
+	DeleteSpecies(context.Context, *connect.Request[v1.DeleteSpeciesRequest]) (*connect.Response[v1.DeleteSpeciesResponse], error)
+	// WatchSpecies streams the changes to species, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchSpecies(context.Context, *connect.Request[v1.WatchSpeciesRequest], *connect.ServerStream[v1.WatchSpeciesResponse]) error
+}
+
+// NewSpeciesServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSpeciesServiceHandler(svc SpeciesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	speciesServiceGetSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesServiceGetSpeciesProcedure,
+		svc.GetSpecies,
+		connect.WithSchema(speciesServiceGetSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesServiceListSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesServiceListSpeciesProcedure,
+		svc.ListSpecies,
+		connect.WithSchema(speciesServiceListSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesServiceStreamSpeciesHandler := connect.NewServerStreamHandler(
+		SpeciesServiceStreamSpeciesProcedure,
+		svc.StreamSpecies,
+		connect.WithSchema(speciesServiceStreamSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesServiceCreateSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesServiceCreateSpeciesProcedure,
+		svc.CreateSpecies,
+		connect.WithSchema(speciesServiceCreateSpeciesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesServiceUpdateSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesServiceUpdateSpeciesProcedure,
+		svc.UpdateSpecies,
+		connect.WithSchema(speciesServiceUpdateSpeciesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesServiceDeleteSpeciesHandler := connect.NewUnaryHandler(
+		SpeciesServiceDeleteSpeciesProcedure,
+		svc.DeleteSpecies,
+		connect.WithSchema(speciesServiceDeleteSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	speciesServiceWatchSpeciesHandler := connect.NewServerStreamHandler(
+		SpeciesServiceWatchSpeciesProcedure,
+		svc.WatchSpecies,
+		connect.WithSchema(speciesServiceWatchSpeciesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.species.v1.SpeciesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SpeciesServiceGetSpeciesProcedure:
+			speciesServiceGetSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesServiceListSpeciesProcedure:
+			speciesServiceListSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesServiceStreamSpeciesProcedure:
+			speciesServiceStreamSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesServiceCreateSpeciesProcedure:
+			speciesServiceCreateSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesServiceUpdateSpeciesProcedure:
+			speciesServiceUpdateSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesServiceDeleteSpeciesProcedure:
+			speciesServiceDeleteSpeciesHandler.ServeHTTP(w, r)
+		case SpeciesServiceWatchSpeciesProcedure:
+			speciesServiceWatchSpeciesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSpeciesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSpeciesServiceHandler struct{}
+
+func (UnimplementedSpeciesServiceHandler) GetSpecies(context.Context, *connect.Request[v1.GetSpeciesRequest]) (*connect.Response[v1.GetSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.GetSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesServiceHandler) ListSpecies(context.Context, *connect.Request[v1.ListSpeciesRequest]) (*connect.Response[v1.ListSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.ListSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesServiceHandler) StreamSpecies(context.Context, *connect.Request[v1.StreamSpeciesRequest], *connect.ServerStream[v1.StreamSpeciesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.StreamSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesServiceHandler) CreateSpecies(context.Context, *connect.Request[v1.CreateSpeciesRequest]) (*connect.Response[v1.CreateSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.CreateSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesServiceHandler) UpdateSpecies(context.Context, *connect.Request[v1.UpdateSpeciesRequest]) (*connect.Response[v1.UpdateSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.UpdateSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesServiceHandler) DeleteSpecies(context.Context, *connect.Request[v1.DeleteSpeciesRequest]) (*connect.Response[v1.DeleteSpeciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.DeleteSpecies is not implemented"))
+}
+
+func (UnimplementedSpeciesServiceHandler) WatchSpecies(context.Context, *connect.Request[v1.WatchSpeciesRequest], *connect.ServerStream[v1.WatchSpeciesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.species.v1.SpeciesService.WatchSpecies is not implemented"))
+}
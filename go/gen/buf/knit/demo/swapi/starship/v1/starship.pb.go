@@ -0,0 +1,1593 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: buf/knit/demo/swapi/starship/v1/starship.proto
+
+package starshipv1
+
+import (
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Starship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of megalights per hour. Absent if unknown.
+	Mglt *int64 `protobuf:"varint,3,opt,name=mglt,proto3,oneof" json:"mglt,omitempty"`
+	// The cargo capacity, in kilograms. Absent if unknown.
+	CargoCapacity *float64 `protobuf:"fixed64,4,opt,name=cargo_capacity,json=cargoCapacity,proto3,oneof" json:"cargo_capacity,omitempty"`
+	Consumable    string   `protobuf:"bytes,5,opt,name=consumable,proto3" json:"consumable,omitempty"`
+	CostInCredits *int64   `protobuf:"varint,6,opt,name=cost_in_credits,json=costInCredits,proto3,oneof" json:"cost_in_credits,omitempty"`
+	// The number of crew members. Absent if unknown, or if it is given as
+	// a range.
+	Crew *int64 `protobuf:"varint,7,opt,name=crew,proto3,oneof" json:"crew,omitempty"`
+	// The hyperdrive class. Absent if unknown.
+	HyperDriveRating *float64 `protobuf:"fixed64,8,opt,name=hyper_drive_rating,json=hyperDriveRating,proto3,oneof" json:"hyper_drive_rating,omitempty"`
+	// The length, in meters. Absent if unknown.
+	Length               *float64 `protobuf:"fixed64,9,opt,name=length,proto3,oneof" json:"length,omitempty"`
+	Manufacturers        []string `protobuf:"bytes,10,rep,name=manufacturers,proto3" json:"manufacturers,omitempty"`
+	Model                string   `protobuf:"bytes,11,opt,name=model,proto3" json:"model,omitempty"`
+	MaxAtmospheringSpeed *int64   `protobuf:"varint,12,opt,name=max_atmosphering_speed,json=maxAtmospheringSpeed,proto3,oneof" json:"max_atmosphering_speed,omitempty"`
+	// The number of passengers. Absent if unknown or not applicable.
+	Passengers *int64                 `protobuf:"varint,13,opt,name=passengers,proto3,oneof" json:"passengers,omitempty"`
+	Class      string                 `protobuf:"bytes,14,opt,name=class,proto3" json:"class,omitempty"`
+	PilotIds   []string               `protobuf:"bytes,15,rep,name=pilot_ids,json=pilotIds,proto3" json:"pilot_ids,omitempty"`
+	FilmIds    []string               `protobuf:"bytes,16,rep,name=film_ids,json=filmIds,proto3" json:"film_ids,omitempty"`
+	Created    *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created,proto3" json:"created,omitempty"`
+	Edited     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=edited,proto3" json:"edited,omitempty"`
+}
+
+func (x *Starship) Reset() {
+	*x = Starship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Starship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Starship) ProtoMessage() {}
+
+func (x *Starship) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Starship.ProtoReflect.Descriptor instead.
+func (*Starship) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Starship) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Starship) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Starship) GetMglt() int64 {
+	if x != nil && x.Mglt != nil {
+		return *x.Mglt
+	}
+	return 0
+}
+
+func (x *Starship) GetCargoCapacity() float64 {
+	if x != nil && x.CargoCapacity != nil {
+		return *x.CargoCapacity
+	}
+	return 0
+}
+
+func (x *Starship) GetConsumable() string {
+	if x != nil {
+		return x.Consumable
+	}
+	return ""
+}
+
+func (x *Starship) GetCostInCredits() int64 {
+	if x != nil && x.CostInCredits != nil {
+		return *x.CostInCredits
+	}
+	return 0
+}
+
+func (x *Starship) GetCrew() int64 {
+	if x != nil && x.Crew != nil {
+		return *x.Crew
+	}
+	return 0
+}
+
+func (x *Starship) GetHyperDriveRating() float64 {
+	if x != nil && x.HyperDriveRating != nil {
+		return *x.HyperDriveRating
+	}
+	return 0
+}
+
+func (x *Starship) GetLength() float64 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
+func (x *Starship) GetManufacturers() []string {
+	if x != nil {
+		return x.Manufacturers
+	}
+	return nil
+}
+
+func (x *Starship) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Starship) GetMaxAtmospheringSpeed() int64 {
+	if x != nil && x.MaxAtmospheringSpeed != nil {
+		return *x.MaxAtmospheringSpeed
+	}
+	return 0
+}
+
+func (x *Starship) GetPassengers() int64 {
+	if x != nil && x.Passengers != nil {
+		return *x.Passengers
+	}
+	return 0
+}
+
+func (x *Starship) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Starship) GetPilotIds() []string {
+	if x != nil {
+		return x.PilotIds
+	}
+	return nil
+}
+
+func (x *Starship) GetFilmIds() []string {
+	if x != nil {
+		return x.FilmIds
+	}
+	return nil
+}
+
+func (x *Starship) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *Starship) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+type GetStarshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// If false, the request fails with a NOT_FOUND error when any of the ids
+	// does not refer to an existing entity. If true, the response contains
+	// the entities that were found, and errors describes the ids that were
+	// not.
+	AllowMissing bool `protobuf:"varint,2,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of starships fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *GetStarshipsRequest) Reset() {
+	*x = GetStarshipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStarshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarshipsRequest) ProtoMessage() {}
+
+func (x *GetStarshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarshipsRequest.ProtoReflect.Descriptor instead.
+func (*GetStarshipsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetStarshipsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *GetStarshipsRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+func (x *GetStarshipsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetStarshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The entities that were found, in the order of the request's ids.
+	Starships []*Starship `protobuf:"bytes,1,rep,name=starships,proto3" json:"starships,omitempty"`
+	// The ids that could not be returned. Only populated when allow_missing
+	// is true in the request.
+	Errors []*v1.EntityError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *GetStarshipsResponse) Reset() {
+	*x = GetStarshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStarshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStarshipsResponse) ProtoMessage() {}
+
+func (x *GetStarshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStarshipsResponse.ProtoReflect.Descriptor instead.
+func (*GetStarshipsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetStarshipsResponse) GetStarships() []*Starship {
+	if x != nil {
+		return x.Starships
+	}
+	return nil
+}
+
+func (x *GetStarshipsResponse) GetErrors() []*v1.EntityError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ListStarshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// An optional filter expression, in the style of AIP-160, that limits the
+	// results to matching entities. For example: `name:"sky" AND film_ids:1`.
+	// If the filter is invalid, the request fails with an INVALID_ARGUMENT
+	// error.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// An optional comma-separated list of fields to sort the results by, in
+	// the style of AIP-132. Each field may be followed by "asc" or "desc", for
+	// example: "population desc, name". Entities that compare equal remain in
+	// their default order. The page_token must have been returned by a request
+	// with the same order_by, or else the request fails with an
+	// INVALID_ARGUMENT error.
+	OrderBy string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// The fields to return. If absent, all fields are returned. Paths that
+	// do not refer to fields of starships fail the request with an
+	// INVALID_ARGUMENT error.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+}
+
+func (x *ListStarshipsRequest) Reset() {
+	*x = ListStarshipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStarshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStarshipsRequest) ProtoMessage() {}
+
+func (x *ListStarshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStarshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListStarshipsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListStarshipsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStarshipsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListStarshipsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListStarshipsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListStarshipsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListStarshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Starships     []*Starship `protobuf:"bytes,1,rep,name=starships,proto3" json:"starships,omitempty"`
+	NextPageToken string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListStarshipsResponse) Reset() {
+	*x = ListStarshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStarshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStarshipsResponse) ProtoMessage() {}
+
+func (x *ListStarshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStarshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListStarshipsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListStarshipsResponse) GetStarships() []*Starship {
+	if x != nil {
+		return x.Starships
+	}
+	return nil
+}
+
+func (x *ListStarshipsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StreamStarshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// An optional filter expression, with the same syntax as the filter of
+	// ListStarshipsRequest.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The maximum number of starships in each response. If zero, defaults to
+	// 100. Values greater than 1000 are treated as 1000.
+	ChunkSize int32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+}
+
+func (x *StreamStarshipsRequest) Reset() {
+	*x = StreamStarshipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStarshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStarshipsRequest) ProtoMessage() {}
+
+func (x *StreamStarshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStarshipsRequest.ProtoReflect.Descriptor instead.
+func (*StreamStarshipsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamStarshipsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *StreamStarshipsRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type StreamStarshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The next chunk of starships. All chunks of a stream come from the same
+	// version of the data, in the same order as ListStarships.
+	Starships []*Starship `protobuf:"bytes,1,rep,name=starships,proto3" json:"starships,omitempty"`
+}
+
+func (x *StreamStarshipsResponse) Reset() {
+	*x = StreamStarshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStarshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStarshipsResponse) ProtoMessage() {}
+
+func (x *StreamStarshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStarshipsResponse.ProtoReflect.Descriptor instead.
+func (*StreamStarshipsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamStarshipsResponse) GetStarships() []*Starship {
+	if x != nil {
+		return x.Starships
+	}
+	return nil
+}
+
+type CreateStarshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The starship to create. If its id is empty, the server assigns one. The
+	// created and edited timestamps are always assigned by the server.
+	// All of the ids it refers to must refer to existing entities. The
+	// inverse relations of those entities are updated to refer back to the
+	// new starship.
+	Starship *Starship `protobuf:"bytes,1,opt,name=starship,proto3" json:"starship,omitempty"`
+}
+
+func (x *CreateStarshipRequest) Reset() {
+	*x = CreateStarshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStarshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStarshipRequest) ProtoMessage() {}
+
+func (x *CreateStarshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStarshipRequest.ProtoReflect.Descriptor instead.
+func (*CreateStarshipRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateStarshipRequest) GetStarship() *Starship {
+	if x != nil {
+		return x.Starship
+	}
+	return nil
+}
+
+type CreateStarshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The starship that was created.
+	Starship *Starship `protobuf:"bytes,1,opt,name=starship,proto3" json:"starship,omitempty"`
+}
+
+func (x *CreateStarshipResponse) Reset() {
+	*x = CreateStarshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStarshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStarshipResponse) ProtoMessage() {}
+
+func (x *CreateStarshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStarshipResponse.ProtoReflect.Descriptor instead.
+func (*CreateStarshipResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateStarshipResponse) GetStarship() *Starship {
+	if x != nil {
+		return x.Starship
+	}
+	return nil
+}
+
+type UpdateStarshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The starship to update. Its id identifies the starship to update. If its edited
+	// timestamp is present, it must match the current edited timestamp of the
+	// starship, or else the update fails with an ABORTED error. Clients should
+	// set it to the value they last read to avoid overwriting concurrent
+	// changes. As with CreateStarship, all ids it refers to must refer to existing
+	// entities, and the inverse relations of those entities are updated.
+	Starship *Starship `protobuf:"bytes,1,opt,name=starship,proto3" json:"starship,omitempty"`
+	// The fields to update. If absent, the fields that are populated in the
+	// request are updated, as described by AIP-134, so fields cannot be cleared
+	// without a mask. The id, created, and edited fields cannot be updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+}
+
+func (x *UpdateStarshipRequest) Reset() {
+	*x = UpdateStarshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStarshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStarshipRequest) ProtoMessage() {}
+
+func (x *UpdateStarshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStarshipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStarshipRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateStarshipRequest) GetStarship() *Starship {
+	if x != nil {
+		return x.Starship
+	}
+	return nil
+}
+
+func (x *UpdateStarshipRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateStarshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The starship after the update was applied.
+	Starship *Starship `protobuf:"bytes,1,opt,name=starship,proto3" json:"starship,omitempty"`
+}
+
+func (x *UpdateStarshipResponse) Reset() {
+	*x = UpdateStarshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStarshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStarshipResponse) ProtoMessage() {}
+
+func (x *UpdateStarshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStarshipResponse.ProtoReflect.Descriptor instead.
+func (*UpdateStarshipResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateStarshipResponse) GetStarship() *Starship {
+	if x != nil {
+		return x.Starship
+	}
+	return nil
+}
+
+type DeleteStarshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the starship to delete.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If present, the starship is only deleted if this matches its current
+	// edited timestamp. Otherwise, the delete fails with an ABORTED error.
+	Edited *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=edited,proto3" json:"edited,omitempty"`
+	// If false, the delete fails with a FAILED_PRECONDITION error when any
+	// other entity refers to the starship. If true, all references to the starship
+	// are removed from other entities.
+	Cascade bool `protobuf:"varint,3,opt,name=cascade,proto3" json:"cascade,omitempty"`
+}
+
+func (x *DeleteStarshipRequest) Reset() {
+	*x = DeleteStarshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStarshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStarshipRequest) ProtoMessage() {}
+
+func (x *DeleteStarshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStarshipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStarshipRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteStarshipRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteStarshipRequest) GetEdited() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Edited
+	}
+	return nil
+}
+
+func (x *DeleteStarshipRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+type DeleteStarshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteStarshipResponse) Reset() {
+	*x = DeleteStarshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStarshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStarshipResponse) ProtoMessage() {}
+
+func (x *DeleteStarshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStarshipResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStarshipResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{12}
+}
+
+type WatchStarshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If present, the stream resumes after the event that returned this
+	// token. Otherwise, it starts with the next change. Only a limited
+	// number of recent changes are retained, so if the token refers to a
+	// change that is no longer retained, or was returned by a different
+	// server process, the request fails with an OUT_OF_RANGE error. Clients
+	// should then watch again with initial_snapshot set.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// If true, the stream starts with a SNAPSHOT event for every existing
+	// starship. Cannot be combined with resume_token.
+	InitialSnapshot bool `protobuf:"varint,2,opt,name=initial_snapshot,json=initialSnapshot,proto3" json:"initial_snapshot,omitempty"`
+}
+
+func (x *WatchStarshipsRequest) Reset() {
+	*x = WatchStarshipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStarshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStarshipsRequest) ProtoMessage() {}
+
+func (x *WatchStarshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStarshipsRequest.ProtoReflect.Descriptor instead.
+func (*WatchStarshipsRequest) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchStarshipsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchStarshipsRequest) GetInitialSnapshot() bool {
+	if x != nil {
+		return x.InitialSnapshot
+	}
+	return false
+}
+
+type WatchStarshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of change.
+	ChangeType v1.ChangeType `protobuf:"varint,1,opt,name=change_type,json=changeType,proto3,enum=buf.knit.demo.swapi.common.v1.ChangeType" json:"change_type,omitempty"`
+	// The starship after the change, or before it for deletes. Absent for
+	// checkpoints.
+	Starship *Starship `protobuf:"bytes,2,opt,name=starship,proto3" json:"starship,omitempty"`
+	// A token to resume the stream after this event.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchStarshipsResponse) Reset() {
+	*x = WatchStarshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStarshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStarshipsResponse) ProtoMessage() {}
+
+func (x *WatchStarshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStarshipsResponse.ProtoReflect.Descriptor instead.
+func (*WatchStarshipsResponse) Descriptor() ([]byte, []int) {
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchStarshipsResponse) GetChangeType() v1.ChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return v1.ChangeType(0)
+}
+
+func (x *WatchStarshipsResponse) GetStarship() *Starship {
+	if x != nil {
+		return x.Starship
+	}
+	return nil
+}
+
+func (x *WatchStarshipsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+var File_buf_knit_demo_swapi_starship_v1_starship_proto protoreflect.FileDescriptor
+
+var file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x1f, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
+	0x31, 0x1a, 0x2a, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d, 0x6f,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x82, 0x06, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x67, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x04, 0x6d, 0x67, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x0d, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x43, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x0f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x02, 0x52, 0x0d, 0x63, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x72, 0x65, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x03, 0x52, 0x04, 0x63, 0x72, 0x65, 0x77, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x12,
+	0x68, 0x79, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x10, 0x68, 0x79, 0x70, 0x65,
+	0x72, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0d,
+	0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61, 0x6e, 0x75, 0x66, 0x61, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x74, 0x6d, 0x6f, 0x73, 0x70, 0x68, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x41,
+	0x74, 0x6d, 0x6f, 0x73, 0x70, 0x68, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x67, 0x6c, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x72, 0x65, 0x77, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x68, 0x79,
+	0x70, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x19, 0x0a, 0x17, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x6d, 0x6f, 0x73, 0x70, 0x68, 0x65, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0xa3, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e,
+	0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12,
+	0x42, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x4f, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x62, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x22, 0x5e, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x22, 0x5f, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x45, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d,
+	0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x22, 0x5f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x22, 0x75, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x64, 0x69, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x61, 0x73, 0x63, 0x61, 0x64, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x65, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xce, 0x01, 0x0a,
+	0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74,
+	0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0xc4, 0x07,
+	0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x80, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x73, 0x12, 0x34, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65,
+	0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b,
+	0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x83, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x35, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69,
+	0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x8b, 0x01, 0x0a, 0x0f, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x37,
+	0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x30, 0x01, 0x12, 0x81, 0x01, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x36, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12,
+	0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e,
+	0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x86, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x36, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64,
+	0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62, 0x75,
+	0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x02, 0x12, 0x88, 0x01, 0x0a, 0x0e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x36, 0x2e, 0x62,
+	0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x62, 0x75, 0x66, 0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e,
+	0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x73,
+	0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x30, 0x01, 0x42, 0xa8, 0x02, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x75, 0x66,
+	0x2e, 0x6b, 0x6e, 0x69, 0x74, 0x2e, 0x64, 0x65, 0x6d, 0x6f, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x53, 0x74,
+	0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x66, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2d, 0x64, 0x65, 0x6d, 0x6f, 0x2f, 0x67, 0x6f, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x62, 0x75, 0x66, 0x2f, 0x6b, 0x6e, 0x69, 0x74, 0x2f, 0x64, 0x65, 0x6d,
+	0x6f, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x76, 0x31, 0xa2, 0x02,
+	0x05, 0x42, 0x4b, 0x44, 0x53, 0x53, 0xaa, 0x02, 0x1f, 0x42, 0x75, 0x66, 0x2e, 0x4b, 0x6e, 0x69,
+	0x74, 0x2e, 0x44, 0x65, 0x6d, 0x6f, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1f, 0x42, 0x75, 0x66, 0x5c, 0x4b,
+	0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69, 0x5c, 0x53,
+	0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2b, 0x42, 0x75, 0x66,
+	0x5c, 0x4b, 0x6e, 0x69, 0x74, 0x5c, 0x44, 0x65, 0x6d, 0x6f, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x69,
+	0x5c, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x24, 0x42, 0x75, 0x66, 0x3a, 0x3a,
+	0x4b, 0x6e, 0x69, 0x74, 0x3a, 0x3a, 0x44, 0x65, 0x6d, 0x6f, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70,
+	0x69, 0x3a, 0x3a, 0x53, 0x74, 0x61, 0x72, 0x73, 0x68, 0x69, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescOnce sync.Once
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescData = file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDesc
+)
+
+func file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescGZIP() []byte {
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescOnce.Do(func() {
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescData = protoimpl.X.CompressGZIP(file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescData)
+	})
+	return file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDescData
+}
+
+var file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_buf_knit_demo_swapi_starship_v1_starship_proto_goTypes = []interface{}{
+	(*Starship)(nil),                // 0: buf.knit.demo.swapi.starship.v1.Starship
+	(*GetStarshipsRequest)(nil),     // 1: buf.knit.demo.swapi.starship.v1.GetStarshipsRequest
+	(*GetStarshipsResponse)(nil),    // 2: buf.knit.demo.swapi.starship.v1.GetStarshipsResponse
+	(*ListStarshipsRequest)(nil),    // 3: buf.knit.demo.swapi.starship.v1.ListStarshipsRequest
+	(*ListStarshipsResponse)(nil),   // 4: buf.knit.demo.swapi.starship.v1.ListStarshipsResponse
+	(*StreamStarshipsRequest)(nil),  // 5: buf.knit.demo.swapi.starship.v1.StreamStarshipsRequest
+	(*StreamStarshipsResponse)(nil), // 6: buf.knit.demo.swapi.starship.v1.StreamStarshipsResponse
+	(*CreateStarshipRequest)(nil),   // 7: buf.knit.demo.swapi.starship.v1.CreateStarshipRequest
+	(*CreateStarshipResponse)(nil),  // 8: buf.knit.demo.swapi.starship.v1.CreateStarshipResponse
+	(*UpdateStarshipRequest)(nil),   // 9: buf.knit.demo.swapi.starship.v1.UpdateStarshipRequest
+	(*UpdateStarshipResponse)(nil),  // 10: buf.knit.demo.swapi.starship.v1.UpdateStarshipResponse
+	(*DeleteStarshipRequest)(nil),   // 11: buf.knit.demo.swapi.starship.v1.DeleteStarshipRequest
+	(*DeleteStarshipResponse)(nil),  // 12: buf.knit.demo.swapi.starship.v1.DeleteStarshipResponse
+	(*WatchStarshipsRequest)(nil),   // 13: buf.knit.demo.swapi.starship.v1.WatchStarshipsRequest
+	(*WatchStarshipsResponse)(nil),  // 14: buf.knit.demo.swapi.starship.v1.WatchStarshipsResponse
+	(*timestamppb.Timestamp)(nil),   // 15: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),   // 16: google.protobuf.FieldMask
+	(*v1.EntityError)(nil),          // 17: buf.knit.demo.swapi.common.v1.EntityError
+	(v1.ChangeType)(0),              // 18: buf.knit.demo.swapi.common.v1.ChangeType
+}
+var file_buf_knit_demo_swapi_starship_v1_starship_proto_depIdxs = []int32{
+	15, // 0: buf.knit.demo.swapi.starship.v1.Starship.created:type_name -> google.protobuf.Timestamp
+	15, // 1: buf.knit.demo.swapi.starship.v1.Starship.edited:type_name -> google.protobuf.Timestamp
+	16, // 2: buf.knit.demo.swapi.starship.v1.GetStarshipsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 3: buf.knit.demo.swapi.starship.v1.GetStarshipsResponse.starships:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	17, // 4: buf.knit.demo.swapi.starship.v1.GetStarshipsResponse.errors:type_name -> buf.knit.demo.swapi.common.v1.EntityError
+	16, // 5: buf.knit.demo.swapi.starship.v1.ListStarshipsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: buf.knit.demo.swapi.starship.v1.ListStarshipsResponse.starships:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	0,  // 7: buf.knit.demo.swapi.starship.v1.StreamStarshipsResponse.starships:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	0,  // 8: buf.knit.demo.swapi.starship.v1.CreateStarshipRequest.starship:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	0,  // 9: buf.knit.demo.swapi.starship.v1.CreateStarshipResponse.starship:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	0,  // 10: buf.knit.demo.swapi.starship.v1.UpdateStarshipRequest.starship:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	16, // 11: buf.knit.demo.swapi.starship.v1.UpdateStarshipRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 12: buf.knit.demo.swapi.starship.v1.UpdateStarshipResponse.starship:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	15, // 13: buf.knit.demo.swapi.starship.v1.DeleteStarshipRequest.edited:type_name -> google.protobuf.Timestamp
+	18, // 14: buf.knit.demo.swapi.starship.v1.WatchStarshipsResponse.change_type:type_name -> buf.knit.demo.swapi.common.v1.ChangeType
+	0,  // 15: buf.knit.demo.swapi.starship.v1.WatchStarshipsResponse.starship:type_name -> buf.knit.demo.swapi.starship.v1.Starship
+	1,  // 16: buf.knit.demo.swapi.starship.v1.StarshipService.GetStarships:input_type -> buf.knit.demo.swapi.starship.v1.GetStarshipsRequest
+	3,  // 17: buf.knit.demo.swapi.starship.v1.StarshipService.ListStarships:input_type -> buf.knit.demo.swapi.starship.v1.ListStarshipsRequest
+	5,  // 18: buf.knit.demo.swapi.starship.v1.StarshipService.StreamStarships:input_type -> buf.knit.demo.swapi.starship.v1.StreamStarshipsRequest
+	7,  // 19: buf.knit.demo.swapi.starship.v1.StarshipService.CreateStarship:input_type -> buf.knit.demo.swapi.starship.v1.CreateStarshipRequest
+	9,  // 20: buf.knit.demo.swapi.starship.v1.StarshipService.UpdateStarship:input_type -> buf.knit.demo.swapi.starship.v1.UpdateStarshipRequest
+	11, // 21: buf.knit.demo.swapi.starship.v1.StarshipService.DeleteStarship:input_type -> buf.knit.demo.swapi.starship.v1.DeleteStarshipRequest
+	13, // 22: buf.knit.demo.swapi.starship.v1.StarshipService.WatchStarships:input_type -> buf.knit.demo.swapi.starship.v1.WatchStarshipsRequest
+	2,  // 23: buf.knit.demo.swapi.starship.v1.StarshipService.GetStarships:output_type -> buf.knit.demo.swapi.starship.v1.GetStarshipsResponse
+	4,  // 24: buf.knit.demo.swapi.starship.v1.StarshipService.ListStarships:output_type -> buf.knit.demo.swapi.starship.v1.ListStarshipsResponse
+	6,  // 25: buf.knit.demo.swapi.starship.v1.StarshipService.StreamStarships:output_type -> buf.knit.demo.swapi.starship.v1.StreamStarshipsResponse
+	8,  // 26: buf.knit.demo.swapi.starship.v1.StarshipService.CreateStarship:output_type -> buf.knit.demo.swapi.starship.v1.CreateStarshipResponse
+	10, // 27: buf.knit.demo.swapi.starship.v1.StarshipService.UpdateStarship:output_type -> buf.knit.demo.swapi.starship.v1.UpdateStarshipResponse
+	12, // 28: buf.knit.demo.swapi.starship.v1.StarshipService.DeleteStarship:output_type -> buf.knit.demo.swapi.starship.v1.DeleteStarshipResponse
+	14, // 29: buf.knit.demo.swapi.starship.v1.StarshipService.WatchStarships:output_type -> buf.knit.demo.swapi.starship.v1.WatchStarshipsResponse
+	23, // [23:30] is the sub-list for method output_type
+	16, // [16:23] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_buf_knit_demo_swapi_starship_v1_starship_proto_init() }
+func file_buf_knit_demo_swapi_starship_v1_starship_proto_init() {
+	if File_buf_knit_demo_swapi_starship_v1_starship_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Starship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStarshipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStarshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStarshipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStarshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStarshipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStarshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStarshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStarshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStarshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStarshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStarshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStarshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStarshipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStarshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_buf_knit_demo_swapi_starship_v1_starship_proto_goTypes,
+		DependencyIndexes: file_buf_knit_demo_swapi_starship_v1_starship_proto_depIdxs,
+		MessageInfos:      file_buf_knit_demo_swapi_starship_v1_starship_proto_msgTypes,
+	}.Build()
+	File_buf_knit_demo_swapi_starship_v1_starship_proto = out.File
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_rawDesc = nil
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_goTypes = nil
+	file_buf_knit_demo_swapi_starship_v1_starship_proto_depIdxs = nil
+}
@@ -0,0 +1,334 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/starship/v1/starship.proto
+
+package starshipv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StarshipServiceName is the fully-qualified name of the StarshipService service.
+	StarshipServiceName = "buf.knit.demo.swapi.starship.v1.StarshipService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StarshipServiceGetStarshipsProcedure is the fully-qualified name of the StarshipService's
+	// GetStarships RPC.
+	StarshipServiceGetStarshipsProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/GetStarships"
+	// StarshipServiceListStarshipsProcedure is the fully-qualified name of the StarshipService's
+	// ListStarships RPC.
+	StarshipServiceListStarshipsProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/ListStarships"
+	// StarshipServiceStreamStarshipsProcedure is the fully-qualified name of the StarshipService's
+	// StreamStarships RPC.
+	StarshipServiceStreamStarshipsProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/StreamStarships"
+	// StarshipServiceCreateStarshipProcedure is the fully-qualified name of the StarshipService's
+	// CreateStarship RPC.
+	StarshipServiceCreateStarshipProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/CreateStarship"
+	// StarshipServiceUpdateStarshipProcedure is the fully-qualified name of the StarshipService's
+	// UpdateStarship RPC.
+	StarshipServiceUpdateStarshipProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/UpdateStarship"
+	// StarshipServiceDeleteStarshipProcedure is the fully-qualified name of the StarshipService's
+	// DeleteStarship RPC.
+	StarshipServiceDeleteStarshipProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/DeleteStarship"
+	// StarshipServiceWatchStarshipsProcedure is the fully-qualified name of the StarshipService's
+	// WatchStarships RPC.
+	StarshipServiceWatchStarshipsProcedure = "/buf.knit.demo.swapi.starship.v1.StarshipService/WatchStarships"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	starshipServiceServiceDescriptor               = v1.File_buf_knit_demo_swapi_starship_v1_starship_proto.Services().ByName("StarshipService")
+	starshipServiceGetStarshipsMethodDescriptor    = starshipServiceServiceDescriptor.Methods().ByName("GetStarships")
+	starshipServiceListStarshipsMethodDescriptor   = starshipServiceServiceDescriptor.Methods().ByName("ListStarships")
+	starshipServiceStreamStarshipsMethodDescriptor = starshipServiceServiceDescriptor.Methods().ByName("StreamStarships")
+	starshipServiceCreateStarshipMethodDescriptor  = starshipServiceServiceDescriptor.Methods().ByName("CreateStarship")
+	starshipServiceUpdateStarshipMethodDescriptor  = starshipServiceServiceDescriptor.Methods().ByName("UpdateStarship")
+	starshipServiceDeleteStarshipMethodDescriptor  = starshipServiceServiceDescriptor.Methods().ByName("DeleteStarship")
+	starshipServiceWatchStarshipsMethodDescriptor  = starshipServiceServiceDescriptor.Methods().ByName("WatchStarships")
+)
+
+// StarshipServiceClient is a client for the buf.knit.demo.swapi.starship.v1.StarshipService
+// service.
+type StarshipServiceClient interface {
+	GetStarships(context.Context, *connect.Request[v1.GetStarshipsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+	ListStarships(context.Context, *connect.Request[v1.ListStarshipsRequest]) (*connect.Response[v1.ListStarshipsResponse], error)
+	// StreamStarships streams all starships, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListStarships.
+	StreamStarships(context.Context, *connect.Request[v1.StreamStarshipsRequest]) (*connect.ServerStreamForClient[v1.StreamStarshipsResponse], error)
+	// CreateStarship creates a new starship.
+	CreateStarship(context.Context, *connect.Request[v1.CreateStarshipRequest]) (*connect.Response[v1.CreateStarshipResponse], error)
+	// UpdateStarship updates an existing starship.
+	UpdateStarship(context.Context, *connect.Request[v1.UpdateStarshipRequest]) (*connect.Response[v1.UpdateStarshipResponse], error)
+	// DeleteStarship deletes a starship.
+	DeleteStarship(context.Context, *connect.Request[v1.DeleteStarshipRequest]) (*connect.Response[v1.DeleteStarshipResponse], error)
+	// WatchStarships streams the changes to starships, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchStarships(context.Context, *connect.Request[v1.WatchStarshipsRequest]) (*connect.ServerStreamForClient[v1.WatchStarshipsResponse], error)
+}
+
+// NewStarshipServiceClient constructs a client for the
+// buf.knit.demo.swapi.starship.v1.StarshipService service. By default, it uses the Connect protocol
+// with the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To
+// use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb()
+// options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStarshipServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StarshipServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &starshipServiceClient{
+		getStarships: connect.NewClient[v1.GetStarshipsRequest, v1.GetStarshipsResponse](
+			httpClient,
+			baseURL+StarshipServiceGetStarshipsProcedure,
+			connect.WithSchema(starshipServiceGetStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listStarships: connect.NewClient[v1.ListStarshipsRequest, v1.ListStarshipsResponse](
+			httpClient,
+			baseURL+StarshipServiceListStarshipsProcedure,
+			connect.WithSchema(starshipServiceListStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamStarships: connect.NewClient[v1.StreamStarshipsRequest, v1.StreamStarshipsResponse](
+			httpClient,
+			baseURL+StarshipServiceStreamStarshipsProcedure,
+			connect.WithSchema(starshipServiceStreamStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createStarship: connect.NewClient[v1.CreateStarshipRequest, v1.CreateStarshipResponse](
+			httpClient,
+			baseURL+StarshipServiceCreateStarshipProcedure,
+			connect.WithSchema(starshipServiceCreateStarshipMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateStarship: connect.NewClient[v1.UpdateStarshipRequest, v1.UpdateStarshipResponse](
+			httpClient,
+			baseURL+StarshipServiceUpdateStarshipProcedure,
+			connect.WithSchema(starshipServiceUpdateStarshipMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteStarship: connect.NewClient[v1.DeleteStarshipRequest, v1.DeleteStarshipResponse](
+			httpClient,
+			baseURL+StarshipServiceDeleteStarshipProcedure,
+			connect.WithSchema(starshipServiceDeleteStarshipMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		watchStarships: connect.NewClient[v1.WatchStarshipsRequest, v1.WatchStarshipsResponse](
+			httpClient,
+			baseURL+StarshipServiceWatchStarshipsProcedure,
+			connect.WithSchema(starshipServiceWatchStarshipsMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// starshipServiceClient implements StarshipServiceClient.
+type starshipServiceClient struct {
+	getStarships    *connect.Client[v1.GetStarshipsRequest, v1.GetStarshipsResponse]
+	listStarships   *connect.Client[v1.ListStarshipsRequest, v1.ListStarshipsResponse]
+	streamStarships *connect.Client[v1.StreamStarshipsRequest, v1.StreamStarshipsResponse]
+	createStarship  *connect.Client[v1.CreateStarshipRequest, v1.CreateStarshipResponse]
+	updateStarship  *connect.Client[v1.UpdateStarshipRequest, v1.UpdateStarshipResponse]
+	deleteStarship  *connect.Client[v1.DeleteStarshipRequest, v1.DeleteStarshipResponse]
+	watchStarships  *connect.Client[v1.WatchStarshipsRequest, v1.WatchStarshipsResponse]
+}
+
+// GetStarships calls buf.knit.demo.swapi.starship.v1.StarshipService.GetStarships.
+func (c *starshipServiceClient) GetStarships(ctx context.Context, req *connect.Request[v1.GetStarshipsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return c.getStarships.CallUnary(ctx, req)
+}
+
+// ListStarships calls buf.knit.demo.swapi.starship.v1.StarshipService.ListStarships.
+func (c *starshipServiceClient) ListStarships(ctx context.Context, req *connect.Request[v1.ListStarshipsRequest]) (*connect.Response[v1.ListStarshipsResponse], error) {
+	return c.listStarships.CallUnary(ctx, req)
+}
+
+// StreamStarships calls buf.knit.demo.swapi.starship.v1.StarshipService.StreamStarships.
+func (c *starshipServiceClient) StreamStarships(ctx context.Context, req *connect.Request[v1.StreamStarshipsRequest]) (*connect.ServerStreamForClient[v1.StreamStarshipsResponse], error) {
+	return c.streamStarships.CallServerStream(ctx, req)
+}
+
+// CreateStarship calls buf.knit.demo.swapi.starship.v1.StarshipService.CreateStarship.
+func (c *starshipServiceClient) CreateStarship(ctx context.Context, req *connect.Request[v1.CreateStarshipRequest]) (*connect.Response[v1.CreateStarshipResponse], error) {
+	return c.createStarship.CallUnary(ctx, req)
+}
+
+// UpdateStarship calls buf.knit.demo.swapi.starship.v1.StarshipService.UpdateStarship.
+func (c *starshipServiceClient) UpdateStarship(ctx context.Context, req *connect.Request[v1.UpdateStarshipRequest]) (*connect.Response[v1.UpdateStarshipResponse], error) {
+	return c.updateStarship.CallUnary(ctx, req)
+}
+
+// DeleteStarship calls buf.knit.demo.swapi.starship.v1.StarshipService.DeleteStarship.
+func (c *starshipServiceClient) DeleteStarship(ctx context.Context, req *connect.Request[v1.DeleteStarshipRequest]) (*connect.Response[v1.DeleteStarshipResponse], error) {
+	return c.deleteStarship.CallUnary(ctx, req)
+}
+
+// WatchStarships calls buf.knit.demo.swapi.starship.v1.StarshipService.WatchStarships.
+func (c *starshipServiceClient) WatchStarships(ctx context.Context, req *connect.Request[v1.WatchStarshipsRequest]) (*connect.ServerStreamForClient[v1.WatchStarshipsResponse], error) {
+	return c.watchStarships.CallServerStream(ctx, req)
+}
+
+// StarshipServiceHandler is an implementation of the
+// buf.knit.demo.swapi.starship.v1.StarshipService service.
+type StarshipServiceHandler interface {
+	GetStarships(context.Context, *connect.Request[v1.GetStarshipsRequest]) (*connect.Response[v1.GetStarshipsResponse], error)
+	ListStarships(context.Context, *connect.Request[v1.ListStarshipsRequest]) (*connect.Response[v1.ListStarshipsResponse], error)
+	// StreamStarships streams all starships, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListStarships.
+	StreamStarships(context.Context, *connect.Request[v1.StreamStarshipsRequest], *connect.ServerStream[v1.StreamStarshipsResponse]) error
+	// CreateStarship creates a new starship.
+	CreateStarship(context.Context, *connect.Request[v1.CreateStarshipRequest]) (*connect.Response[v1.CreateStarshipResponse], error)
+	// UpdateStarship updates an existing starship.
+	UpdateStarship(context.Context, *connect.Request[v1.UpdateStarshipRequest]) (*connect.Response[v1.UpdateStarshipResponse], error)
+	// DeleteStarship deletes a starship.
+	DeleteStarship(context.Context, *connect.Request[v1.DeleteStarshipRequest]) (*connect.Response[v1.DeleteStarshipResponse], error)
+	// WatchStarships streams the changes to starships, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchStarships(context.Context, *connect.Request[v1.WatchStarshipsRequest], *connect.ServerStream[v1.WatchStarshipsResponse]) error
+}
+
+// NewStarshipServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStarshipServiceHandler(svc StarshipServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	starshipServiceGetStarshipsHandler := connect.NewUnaryHandler(
+		StarshipServiceGetStarshipsProcedure,
+		svc.GetStarships,
+		connect.WithSchema(starshipServiceGetStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipServiceListStarshipsHandler := connect.NewUnaryHandler(
+		StarshipServiceListStarshipsProcedure,
+		svc.ListStarships,
+		connect.WithSchema(starshipServiceListStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipServiceStreamStarshipsHandler := connect.NewServerStreamHandler(
+		StarshipServiceStreamStarshipsProcedure,
+		svc.StreamStarships,
+		connect.WithSchema(starshipServiceStreamStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipServiceCreateStarshipHandler := connect.NewUnaryHandler(
+		StarshipServiceCreateStarshipProcedure,
+		svc.CreateStarship,
+		connect.WithSchema(starshipServiceCreateStarshipMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipServiceUpdateStarshipHandler := connect.NewUnaryHandler(
+		StarshipServiceUpdateStarshipProcedure,
+		svc.UpdateStarship,
+		connect.WithSchema(starshipServiceUpdateStarshipMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipServiceDeleteStarshipHandler := connect.NewUnaryHandler(
+		StarshipServiceDeleteStarshipProcedure,
+		svc.DeleteStarship,
+		connect.WithSchema(starshipServiceDeleteStarshipMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	starshipServiceWatchStarshipsHandler := connect.NewServerStreamHandler(
+		StarshipServiceWatchStarshipsProcedure,
+		svc.WatchStarships,
+		connect.WithSchema(starshipServiceWatchStarshipsMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.starship.v1.StarshipService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StarshipServiceGetStarshipsProcedure:
+			starshipServiceGetStarshipsHandler.ServeHTTP(w, r)
+		case StarshipServiceListStarshipsProcedure:
+			starshipServiceListStarshipsHandler.ServeHTTP(w, r)
+		case StarshipServiceStreamStarshipsProcedure:
+			starshipServiceStreamStarshipsHandler.ServeHTTP(w, r)
+		case StarshipServiceCreateStarshipProcedure:
+			starshipServiceCreateStarshipHandler.ServeHTTP(w, r)
+		case StarshipServiceUpdateStarshipProcedure:
+			starshipServiceUpdateStarshipHandler.ServeHTTP(w, r)
+		case StarshipServiceDeleteStarshipProcedure:
+			starshipServiceDeleteStarshipHandler.ServeHTTP(w, r)
+		case StarshipServiceWatchStarshipsProcedure:
+			starshipServiceWatchStarshipsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStarshipServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStarshipServiceHandler struct{}
+
+func (UnimplementedStarshipServiceHandler) GetStarships(context.Context, *connect.Request[v1.GetStarshipsRequest]) (*connect.Response[v1.GetStarshipsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.GetStarships is not implemented"))
+}
+
+func (UnimplementedStarshipServiceHandler) ListStarships(context.Context, *connect.Request[v1.ListStarshipsRequest]) (*connect.Response[v1.ListStarshipsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.ListStarships is not implemented"))
+}
+
+func (UnimplementedStarshipServiceHandler) StreamStarships(context.Context, *connect.Request[v1.StreamStarshipsRequest], *connect.ServerStream[v1.StreamStarshipsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.StreamStarships is not implemented"))
+}
+
+func (UnimplementedStarshipServiceHandler) CreateStarship(context.Context, *connect.Request[v1.CreateStarshipRequest]) (*connect.Response[v1.CreateStarshipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.CreateStarship is not implemented"))
+}
+
+func (UnimplementedStarshipServiceHandler) UpdateStarship(context.Context, *connect.Request[v1.UpdateStarshipRequest]) (*connect.Response[v1.UpdateStarshipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.UpdateStarship is not implemented"))
+}
+
+func (UnimplementedStarshipServiceHandler) DeleteStarship(context.Context, *connect.Request[v1.DeleteStarshipRequest]) (*connect.Response[v1.DeleteStarshipResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.DeleteStarship is not implemented"))
+}
+
+func (UnimplementedStarshipServiceHandler) WatchStarships(context.Context, *connect.Request[v1.WatchStarshipsRequest], *connect.ServerStream[v1.WatchStarshipsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.starship.v1.StarshipService.WatchStarships is not implemented"))
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/stats/v1/stats.proto
+
+package statsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/stats/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StatsServiceName is the fully-qualified name of the StatsService service.
+	StatsServiceName = "buf.knit.demo.swapi.stats.v1.StatsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StatsServiceAggregateProcedure is the fully-qualified name of the StatsService's Aggregate RPC.
+	StatsServiceAggregateProcedure = "/buf.knit.demo.swapi.stats.v1.StatsService/Aggregate"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	statsServiceServiceDescriptor         = v1.File_buf_knit_demo_swapi_stats_v1_stats_proto.Services().ByName("StatsService")
+	statsServiceAggregateMethodDescriptor = statsServiceServiceDescriptor.Methods().ByName("Aggregate")
+)
+
+// StatsServiceClient is a client for the buf.knit.demo.swapi.stats.v1.StatsService service.
+type StatsServiceClient interface {
+	// Aggregate groups the entities of one type by the value of a field and
+	// computes aggregates of each group, such as the average length of
+	// starships by class or the number of people by homeworld.
+	Aggregate(context.Context, *connect.Request[v1.AggregateRequest]) (*connect.Response[v1.AggregateResponse], error)
+}
+
+// NewStatsServiceClient constructs a client for the buf.knit.demo.swapi.stats.v1.StatsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStatsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StatsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &statsServiceClient{
+		aggregate: connect.NewClient[v1.AggregateRequest, v1.AggregateResponse](
+			httpClient,
+			baseURL+StatsServiceAggregateProcedure,
+			connect.WithSchema(statsServiceAggregateMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// statsServiceClient implements StatsServiceClient.
+type statsServiceClient struct {
+	aggregate *connect.Client[v1.AggregateRequest, v1.AggregateResponse]
+}
+
+// Aggregate calls buf.knit.demo.swapi.stats.v1.StatsService.Aggregate.
+func (c *statsServiceClient) Aggregate(ctx context.Context, req *connect.Request[v1.AggregateRequest]) (*connect.Response[v1.AggregateResponse], error) {
+	return c.aggregate.CallUnary(ctx, req)
+}
+
+// StatsServiceHandler is an implementation of the buf.knit.demo.swapi.stats.v1.StatsService
+// service.
+type StatsServiceHandler interface {
+	// Aggregate groups the entities of one type by the value of a field and
+	// computes aggregates of each group, such as the average length of
+	// starships by class or the number of people by homeworld.
+	Aggregate(context.Context, *connect.Request[v1.AggregateRequest]) (*connect.Response[v1.AggregateResponse], error)
+}
+
+// NewStatsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStatsServiceHandler(svc StatsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	statsServiceAggregateHandler := connect.NewUnaryHandler(
+		StatsServiceAggregateProcedure,
+		svc.Aggregate,
+		connect.WithSchema(statsServiceAggregateMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.stats.v1.StatsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StatsServiceAggregateProcedure:
+			statsServiceAggregateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStatsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStatsServiceHandler struct{}
+
+func (UnimplementedStatsServiceHandler) Aggregate(context.Context, *connect.Request[v1.AggregateRequest]) (*connect.Response[v1.AggregateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.stats.v1.StatsService.Aggregate is not implemented"))
+}
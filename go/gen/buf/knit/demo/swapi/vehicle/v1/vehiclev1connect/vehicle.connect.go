@@ -0,0 +1,332 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: buf/knit/demo/swapi/vehicle/v1/vehicle.proto
+
+package vehiclev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// VehicleServiceName is the fully-qualified name of the VehicleService service.
+	VehicleServiceName = "buf.knit.demo.swapi.vehicle.v1.VehicleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// VehicleServiceGetVehiclesProcedure is the fully-qualified name of the VehicleService's
+	// GetVehicles RPC.
+	VehicleServiceGetVehiclesProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/GetVehicles"
+	// VehicleServiceListVehiclesProcedure is the fully-qualified name of the VehicleService's
+	// ListVehicles RPC.
+	VehicleServiceListVehiclesProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/ListVehicles"
+	// VehicleServiceStreamVehiclesProcedure is the fully-qualified name of the VehicleService's
+	// StreamVehicles RPC.
+	VehicleServiceStreamVehiclesProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/StreamVehicles"
+	// VehicleServiceCreateVehicleProcedure is the fully-qualified name of the VehicleService's
+	// CreateVehicle RPC.
+	VehicleServiceCreateVehicleProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/CreateVehicle"
+	// VehicleServiceUpdateVehicleProcedure is the fully-qualified name of the VehicleService's
+	// UpdateVehicle RPC.
+	VehicleServiceUpdateVehicleProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/UpdateVehicle"
+	// VehicleServiceDeleteVehicleProcedure is the fully-qualified name of the VehicleService's
+	// DeleteVehicle RPC.
+	VehicleServiceDeleteVehicleProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/DeleteVehicle"
+	// VehicleServiceWatchVehiclesProcedure is the fully-qualified name of the VehicleService's
+	// WatchVehicles RPC.
+	VehicleServiceWatchVehiclesProcedure = "/buf.knit.demo.swapi.vehicle.v1.VehicleService/WatchVehicles"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	vehicleServiceServiceDescriptor              = v1.File_buf_knit_demo_swapi_vehicle_v1_vehicle_proto.Services().ByName("VehicleService")
+	vehicleServiceGetVehiclesMethodDescriptor    = vehicleServiceServiceDescriptor.Methods().ByName("GetVehicles")
+	vehicleServiceListVehiclesMethodDescriptor   = vehicleServiceServiceDescriptor.Methods().ByName("ListVehicles")
+	vehicleServiceStreamVehiclesMethodDescriptor = vehicleServiceServiceDescriptor.Methods().ByName("StreamVehicles")
+	vehicleServiceCreateVehicleMethodDescriptor  = vehicleServiceServiceDescriptor.Methods().ByName("CreateVehicle")
+	vehicleServiceUpdateVehicleMethodDescriptor  = vehicleServiceServiceDescriptor.Methods().ByName("UpdateVehicle")
+	vehicleServiceDeleteVehicleMethodDescriptor  = vehicleServiceServiceDescriptor.Methods().ByName("DeleteVehicle")
+	vehicleServiceWatchVehiclesMethodDescriptor  = vehicleServiceServiceDescriptor.Methods().ByName("WatchVehicles")
+)
+
+// VehicleServiceClient is a client for the buf.knit.demo.swapi.vehicle.v1.VehicleService service.
+type VehicleServiceClient interface {
+	GetVehicles(context.Context, *connect.Request[v1.GetVehiclesRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+	ListVehicles(context.Context, *connect.Request[v1.ListVehiclesRequest]) (*connect.Response[v1.ListVehiclesResponse], error)
+	// StreamVehicles streams all vehicles, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListVehicles.
+	StreamVehicles(context.Context, *connect.Request[v1.StreamVehiclesRequest]) (*connect.ServerStreamForClient[v1.StreamVehiclesResponse], error)
+	// CreateVehicle creates a new vehicle.
+	CreateVehicle(context.Context, *connect.Request[v1.CreateVehicleRequest]) (*connect.Response[v1.CreateVehicleResponse], error)
+	// UpdateVehicle updates an existing vehicle.
+	UpdateVehicle(context.Context, *connect.Request[v1.UpdateVehicleRequest]) (*connect.Response[v1.UpdateVehicleResponse], error)
+	// DeleteVehicle deletes a vehicle.
+	DeleteVehicle(context.Context, *connect.Request[v1.DeleteVehicleRequest]) (*connect.Response[v1.DeleteVehicleResponse], error)
+	// WatchVehicles streams the changes to vehicles, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchVehicles(context.Context, *connect.Request[v1.WatchVehiclesRequest]) (*connect.ServerStreamForClient[v1.WatchVehiclesResponse], error)
+}
+
+// NewVehicleServiceClient constructs a client for the buf.knit.demo.swapi.vehicle.v1.VehicleService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewVehicleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) VehicleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &vehicleServiceClient{
+		getVehicles: connect.NewClient[v1.GetVehiclesRequest, v1.GetVehiclesResponse](
+			httpClient,
+			baseURL+VehicleServiceGetVehiclesProcedure,
+			connect.WithSchema(vehicleServiceGetVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listVehicles: connect.NewClient[v1.ListVehiclesRequest, v1.ListVehiclesResponse](
+			httpClient,
+			baseURL+VehicleServiceListVehiclesProcedure,
+			connect.WithSchema(vehicleServiceListVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		streamVehicles: connect.NewClient[v1.StreamVehiclesRequest, v1.StreamVehiclesResponse](
+			httpClient,
+			baseURL+VehicleServiceStreamVehiclesProcedure,
+			connect.WithSchema(vehicleServiceStreamVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createVehicle: connect.NewClient[v1.CreateVehicleRequest, v1.CreateVehicleResponse](
+			httpClient,
+			baseURL+VehicleServiceCreateVehicleProcedure,
+			connect.WithSchema(vehicleServiceCreateVehicleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateVehicle: connect.NewClient[v1.UpdateVehicleRequest, v1.UpdateVehicleResponse](
+			httpClient,
+			baseURL+VehicleServiceUpdateVehicleProcedure,
+			connect.WithSchema(vehicleServiceUpdateVehicleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteVehicle: connect.NewClient[v1.DeleteVehicleRequest, v1.DeleteVehicleResponse](
+			httpClient,
+			baseURL+VehicleServiceDeleteVehicleProcedure,
+			connect.WithSchema(vehicleServiceDeleteVehicleMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		watchVehicles: connect.NewClient[v1.WatchVehiclesRequest, v1.WatchVehiclesResponse](
+			httpClient,
+			baseURL+VehicleServiceWatchVehiclesProcedure,
+			connect.WithSchema(vehicleServiceWatchVehiclesMethodDescriptor),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// vehicleServiceClient implements VehicleServiceClient.
+type vehicleServiceClient struct {
+	getVehicles    *connect.Client[v1.GetVehiclesRequest, v1.GetVehiclesResponse]
+	listVehicles   *connect.Client[v1.ListVehiclesRequest, v1.ListVehiclesResponse]
+	streamVehicles *connect.Client[v1.StreamVehiclesRequest, v1.StreamVehiclesResponse]
+	createVehicle  *connect.Client[v1.CreateVehicleRequest, v1.CreateVehicleResponse]
+	updateVehicle  *connect.Client[v1.UpdateVehicleRequest, v1.UpdateVehicleResponse]
+	deleteVehicle  *connect.Client[v1.DeleteVehicleRequest, v1.DeleteVehicleResponse]
+	watchVehicles  *connect.Client[v1.WatchVehiclesRequest, v1.WatchVehiclesResponse]
+}
+
+// GetVehicles calls buf.knit.demo.swapi.vehicle.v1.VehicleService.GetVehicles.
+func (c *vehicleServiceClient) GetVehicles(ctx context.Context, req *connect.Request[v1.GetVehiclesRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return c.getVehicles.CallUnary(ctx, req)
+}
+
+// ListVehicles calls buf.knit.demo.swapi.vehicle.v1.VehicleService.ListVehicles.
+func (c *vehicleServiceClient) ListVehicles(ctx context.Context, req *connect.Request[v1.ListVehiclesRequest]) (*connect.Response[v1.ListVehiclesResponse], error) {
+	return c.listVehicles.CallUnary(ctx, req)
+}
+
+// StreamVehicles calls buf.knit.demo.swapi.vehicle.v1.VehicleService.StreamVehicles.
+func (c *vehicleServiceClient) StreamVehicles(ctx context.Context, req *connect.Request[v1.StreamVehiclesRequest]) (*connect.ServerStreamForClient[v1.StreamVehiclesResponse], error) {
+	return c.streamVehicles.CallServerStream(ctx, req)
+}
+
+// CreateVehicle calls buf.knit.demo.swapi.vehicle.v1.VehicleService.CreateVehicle.
+func (c *vehicleServiceClient) CreateVehicle(ctx context.Context, req *connect.Request[v1.CreateVehicleRequest]) (*connect.Response[v1.CreateVehicleResponse], error) {
+	return c.createVehicle.CallUnary(ctx, req)
+}
+
+// UpdateVehicle calls buf.knit.demo.swapi.vehicle.v1.VehicleService.UpdateVehicle.
+func (c *vehicleServiceClient) UpdateVehicle(ctx context.Context, req *connect.Request[v1.UpdateVehicleRequest]) (*connect.Response[v1.UpdateVehicleResponse], error) {
+	return c.updateVehicle.CallUnary(ctx, req)
+}
+
+// DeleteVehicle calls buf.knit.demo.swapi.vehicle.v1.VehicleService.DeleteVehicle.
+func (c *vehicleServiceClient) DeleteVehicle(ctx context.Context, req *connect.Request[v1.DeleteVehicleRequest]) (*connect.Response[v1.DeleteVehicleResponse], error) {
+	return c.deleteVehicle.CallUnary(ctx, req)
+}
+
+// WatchVehicles calls buf.knit.demo.swapi.vehicle.v1.VehicleService.WatchVehicles.
+func (c *vehicleServiceClient) WatchVehicles(ctx context.Context, req *connect.Request[v1.WatchVehiclesRequest]) (*connect.ServerStreamForClient[v1.WatchVehiclesResponse], error) {
+	return c.watchVehicles.CallServerStream(ctx, req)
+}
+
+// VehicleServiceHandler is an implementation of the buf.knit.demo.swapi.vehicle.v1.VehicleService
+// service.
+type VehicleServiceHandler interface {
+	GetVehicles(context.Context, *connect.Request[v1.GetVehiclesRequest]) (*connect.Response[v1.GetVehiclesResponse], error)
+	ListVehicles(context.Context, *connect.Request[v1.ListVehiclesRequest]) (*connect.Response[v1.ListVehiclesResponse], error)
+	// StreamVehicles streams all vehicles, or those that match a filter, in chunks.
+	// It is meant for bulk exports, in place of paging through ListVehicles.
+	StreamVehicles(context.Context, *connect.Request[v1.StreamVehiclesRequest], *connect.ServerStream[v1.StreamVehiclesResponse]) error
+	// CreateVehicle creates a new vehicle.
+	CreateVehicle(context.Context, *connect.Request[v1.CreateVehicleRequest]) (*connect.Response[v1.CreateVehicleResponse], error)
+	// UpdateVehicle updates an existing vehicle.
+	UpdateVehicle(context.Context, *connect.Request[v1.UpdateVehicleRequest]) (*connect.Response[v1.UpdateVehicleResponse], error)
+	// DeleteVehicle deletes a vehicle.
+	DeleteVehicle(context.Context, *connect.Request[v1.DeleteVehicleRequest]) (*connect.Response[v1.DeleteVehicleResponse], error)
+	// WatchVehicles streams the changes to vehicles, whether by mutations or by
+	// reloads of the data, as they happen.
+	WatchVehicles(context.Context, *connect.Request[v1.WatchVehiclesRequest], *connect.ServerStream[v1.WatchVehiclesResponse]) error
+}
+
+// NewVehicleServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewVehicleServiceHandler(svc VehicleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	vehicleServiceGetVehiclesHandler := connect.NewUnaryHandler(
+		VehicleServiceGetVehiclesProcedure,
+		svc.GetVehicles,
+		connect.WithSchema(vehicleServiceGetVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleServiceListVehiclesHandler := connect.NewUnaryHandler(
+		VehicleServiceListVehiclesProcedure,
+		svc.ListVehicles,
+		connect.WithSchema(vehicleServiceListVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleServiceStreamVehiclesHandler := connect.NewServerStreamHandler(
+		VehicleServiceStreamVehiclesProcedure,
+		svc.StreamVehicles,
+		connect.WithSchema(vehicleServiceStreamVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleServiceCreateVehicleHandler := connect.NewUnaryHandler(
+		VehicleServiceCreateVehicleProcedure,
+		svc.CreateVehicle,
+		connect.WithSchema(vehicleServiceCreateVehicleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleServiceUpdateVehicleHandler := connect.NewUnaryHandler(
+		VehicleServiceUpdateVehicleProcedure,
+		svc.UpdateVehicle,
+		connect.WithSchema(vehicleServiceUpdateVehicleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleServiceDeleteVehicleHandler := connect.NewUnaryHandler(
+		VehicleServiceDeleteVehicleProcedure,
+		svc.DeleteVehicle,
+		connect.WithSchema(vehicleServiceDeleteVehicleMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	vehicleServiceWatchVehiclesHandler := connect.NewServerStreamHandler(
+		VehicleServiceWatchVehiclesProcedure,
+		svc.WatchVehicles,
+		connect.WithSchema(vehicleServiceWatchVehiclesMethodDescriptor),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/buf.knit.demo.swapi.vehicle.v1.VehicleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case VehicleServiceGetVehiclesProcedure:
+			vehicleServiceGetVehiclesHandler.ServeHTTP(w, r)
+		case VehicleServiceListVehiclesProcedure:
+			vehicleServiceListVehiclesHandler.ServeHTTP(w, r)
+		case VehicleServiceStreamVehiclesProcedure:
+			vehicleServiceStreamVehiclesHandler.ServeHTTP(w, r)
+		case VehicleServiceCreateVehicleProcedure:
+			vehicleServiceCreateVehicleHandler.ServeHTTP(w, r)
+		case VehicleServiceUpdateVehicleProcedure:
+			vehicleServiceUpdateVehicleHandler.ServeHTTP(w, r)
+		case VehicleServiceDeleteVehicleProcedure:
+			vehicleServiceDeleteVehicleHandler.ServeHTTP(w, r)
+		case VehicleServiceWatchVehiclesProcedure:
+			vehicleServiceWatchVehiclesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedVehicleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedVehicleServiceHandler struct{}
+
+func (UnimplementedVehicleServiceHandler) GetVehicles(context.Context, *connect.Request[v1.GetVehiclesRequest]) (*connect.Response[v1.GetVehiclesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.GetVehicles is not implemented"))
+}
+
+func (UnimplementedVehicleServiceHandler) ListVehicles(context.Context, *connect.Request[v1.ListVehiclesRequest]) (*connect.Response[v1.ListVehiclesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.ListVehicles is not implemented"))
+}
+
+func (UnimplementedVehicleServiceHandler) StreamVehicles(context.Context, *connect.Request[v1.StreamVehiclesRequest], *connect.ServerStream[v1.StreamVehiclesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.StreamVehicles is not implemented"))
+}
+
+func (UnimplementedVehicleServiceHandler) CreateVehicle(context.Context, *connect.Request[v1.CreateVehicleRequest]) (*connect.Response[v1.CreateVehicleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.CreateVehicle is not implemented"))
+}
+
+func (UnimplementedVehicleServiceHandler) UpdateVehicle(context.Context, *connect.Request[v1.UpdateVehicleRequest]) (*connect.Response[v1.UpdateVehicleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.UpdateVehicle is not implemented"))
+}
+
+func (UnimplementedVehicleServiceHandler) DeleteVehicle(context.Context, *connect.Request[v1.DeleteVehicleRequest]) (*connect.Response[v1.DeleteVehicleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.DeleteVehicle is not implemented"))
+}
+
+func (UnimplementedVehicleServiceHandler) WatchVehicles(context.Context, *connect.Request[v1.WatchVehiclesRequest], *connect.ServerStream[v1.WatchVehiclesResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("buf.knit.demo.swapi.vehicle.v1.VehicleService.WatchVehicles is not implemented"))
+}
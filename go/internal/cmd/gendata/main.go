@@ -23,6 +23,7 @@ import (
 	"os"
 	"reflect"
 
+	demoswapi "github.com/bufbuild/knit-demo/go/internal/swapi"
 	"github.com/peterhellberg/swapi"
 )
 
@@ -68,7 +69,7 @@ func generateSwapiData(ctx context.Context, pkgName, outputName string) error {
 	}
 
 	log.Println("Retrieving films...")
-	allFilms, err := readAll[*swapi.Film](ctx, filmsURL)
+	allFilms, err := readAll[*demoswapi.Film](ctx, filmsURL)
 	if err != nil {
 		return err
 	}
@@ -117,24 +118,44 @@ func generateSwapiData(ctx context.Context, pkgName, outputName string) error {
 
 func printItems[T any](varName string, items []*T) {
 	var t T
-	fmt.Printf("\t%s = []*swapi.%s{\n", varName, reflect.TypeOf(t).Name())
+	fmt.Printf("\t%s = []*%s{\n", varName, typeName(reflect.TypeOf(t)))
 	for _, item := range items {
-		fmt.Println("\t\t{")
-		v := reflect.ValueOf(item).Elem()
-		vt := v.Type()
-		for i := range v.NumField() {
-			fmt.Printf("\t\t\t%s: ", vt.Field(i).Name)
-			printItem(v.Field(i).Interface())
-			fmt.Println(",")
-		}
-		fmt.Println("\t\t},")
+		fmt.Print("\t\t")
+		printStruct(reflect.ValueOf(item).Elem(), "\t\t")
+		fmt.Println(",")
 	}
 	fmt.Println("\t}")
 }
 
-func printItem(item any) {
-	// NB: We only need to support ints, strings, and string slices for now
-	//     since those arethe only actual data types in the swapi structs.
+func printStruct(v reflect.Value, indent string) {
+	fmt.Println("{")
+	vt := v.Type()
+	for i := range v.NumField() {
+		fmt.Printf("%s\t%s: ", indent, vt.Field(i).Name)
+		printItem(v.Field(i).Interface(), indent+"\t")
+		fmt.Println(",")
+	}
+	fmt.Printf("%s}", indent)
+}
+
+// typeName returns the name of the given type as it is referred to in the
+// generated code, which is in the same package as demoswapi.Film.
+func typeName(t reflect.Type) string {
+	if t.PkgPath() == reflect.TypeOf(swapi.Film{}).PkgPath() {
+		return "swapi." + t.Name()
+	}
+	return t.Name()
+}
+
+func printItem(item any, indent string) {
+	// NB: We only need to support ints, strings, string slices, and embedded
+	//     swapi structs for now since those are the only actual data types
+	//     in the structs.
+	if v := reflect.ValueOf(item); v.Kind() == reflect.Struct {
+		fmt.Print(typeName(v.Type()))
+		printStruct(v, indent)
+		return
+	}
 	switch item := item.(type) {
 	case int:
 		fmt.Printf("%d", item)
@@ -0,0 +1,304 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	timestampName = "google.protobuf.Timestamp"
+	dateName      = "google.type.Date"
+)
+
+type node interface {
+	match(msg protoreflect.Message) bool
+}
+
+type andNode []node
+
+func newAndNode(nodes []node) node {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	return andNode(nodes)
+}
+
+func (n andNode) match(msg protoreflect.Message) bool {
+	for _, child := range n {
+		if !child.match(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+type orNode []node
+
+func (n orNode) match(msg protoreflect.Message) bool {
+	for _, child := range n {
+		if child.match(msg) {
+			return true
+		}
+	}
+	return false
+}
+
+type notNode struct {
+	child node
+}
+
+func (n notNode) match(msg protoreflect.Message) bool {
+	return !n.child.match(msg)
+}
+
+// restriction is a comparison of a field against a value.
+type restriction struct {
+	path []protoreflect.FieldDescriptor
+	// presence is true if the restriction only checks whether the field
+	// is present.
+	presence bool
+	// matchValue matches the value of the field or, for repeated fields,
+	// the value of an element of the field.
+	matchValue func(protoreflect.Value) bool
+}
+
+func newRestriction(path []protoreflect.FieldDescriptor, comparator, arg token) (node, error) {
+	field := path[len(path)-1]
+	if arg.kind == tokenStar {
+		if comparator.text != ":" {
+			return nil, errorf(arg, `"*" can only be used with ":"`)
+		}
+		return &restriction{path: path, presence: true}, nil
+	}
+	if field.IsMap() {
+		return nil, errorf(comparator, "map field %q can only be tested for presence", fieldPathString(path))
+	}
+	op := comparator.text
+	if field.IsList() {
+		if op != ":" {
+			return nil, errorf(comparator, `repeated field %q can only be used with ":"`, fieldPathString(path))
+		}
+		// An element of a list matches if it is equal to the value.
+		op = "="
+	}
+	matchValue, err := newValueMatcher(field, op, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &restriction{path: path, matchValue: matchValue}, nil
+}
+
+func (r *restriction) match(msg protoreflect.Message) bool {
+	for _, field := range r.path[:len(r.path)-1] {
+		if !msg.Has(field) {
+			return false
+		}
+		msg = msg.Get(field).Message()
+	}
+	field := r.path[len(r.path)-1]
+	if r.presence {
+		return msg.Has(field)
+	}
+	if field.HasPresence() && !msg.Has(field) {
+		return false
+	}
+	value := msg.Get(field)
+	if !field.IsList() {
+		return r.matchValue(value)
+	}
+	list := value.List()
+	for i := range list.Len() {
+		if r.matchValue(list.Get(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func newValueMatcher(field protoreflect.FieldDescriptor, op string, arg token) (func(protoreflect.Value) bool, error) {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return newStringMatcher(op, arg), nil
+	case protoreflect.BoolKind:
+		if arg.kind != tokenIdent || (arg.text != "true" && arg.text != "false") {
+			return nil, errorf(arg, "expected true or false but found %s", arg)
+		}
+		if !isEquality(op) {
+			return nil, errorf(arg, "bool field %q only supports =, !=, and :", field.Name())
+		}
+		want := arg.text == "true"
+		return func(value protoreflect.Value) bool {
+			return compareOp(op, compareBool(value.Bool(), want))
+		}, nil
+	case protoreflect.EnumKind:
+		enumValue := field.Enum().Values().ByName(protoreflect.Name(arg.text))
+		if arg.kind != tokenIdent || enumValue == nil {
+			return nil, errorf(arg, "expected a value of enum %s but found %s", field.Enum().FullName(), arg)
+		}
+		if !isEquality(op) {
+			return nil, errorf(arg, "enum field %q only supports =, !=, and :", field.Name())
+		}
+		want := enumValue.Number()
+		return func(value protoreflect.Value) bool {
+			return compareOp(op, cmp.Compare(value.Enum(), want))
+		}, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		want, err := parseNumber(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func(value protoreflect.Value) bool {
+			return compareOp(op, cmp.Compare(float64(value.Int()), want))
+		}, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		want, err := parseNumber(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func(value protoreflect.Value) bool {
+			return compareOp(op, cmp.Compare(float64(value.Uint()), want))
+		}, nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		want, err := parseNumber(arg)
+		if err != nil {
+			return nil, err
+		}
+		return func(value protoreflect.Value) bool {
+			return compareOp(op, cmp.Compare(value.Float(), want))
+		}, nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return newMessageMatcher(field, op, arg)
+	case protoreflect.BytesKind:
+		fallthrough
+	default:
+		return nil, errorf(arg, "field %q can only be tested for presence", field.Name())
+	}
+}
+
+func newStringMatcher(op string, arg token) func(protoreflect.Value) bool {
+	want := arg.text
+	switch op {
+	case ":":
+		want = strings.ToLower(want)
+		return func(value protoreflect.Value) bool {
+			return strings.Contains(strings.ToLower(value.String()), want)
+		}
+	case "=", "!=":
+		prefix, suffix := strings.HasSuffix(want, "*"), strings.HasPrefix(want, "*")
+		want = strings.TrimSuffix(strings.TrimPrefix(want, "*"), "*")
+		return func(value protoreflect.Value) bool {
+			str := value.String()
+			var matches bool
+			switch {
+			case prefix && suffix:
+				matches = strings.Contains(str, want)
+			case prefix:
+				matches = strings.HasPrefix(str, want)
+			case suffix:
+				matches = strings.HasSuffix(str, want)
+			default:
+				matches = str == want
+			}
+			return matches == (op == "=")
+		}
+	default:
+		return func(value protoreflect.Value) bool {
+			return compareOp(op, strings.Compare(value.String(), want))
+		}
+	}
+}
+
+func newMessageMatcher(field protoreflect.FieldDescriptor, op string, arg token) (func(protoreflect.Value) bool, error) {
+	switch field.Message().FullName() {
+	case timestampName:
+		when, err := time.Parse(time.RFC3339Nano, arg.text)
+		if arg.kind != tokenString || err != nil {
+			return nil, errorf(arg, "expected an RFC 3339 timestamp string but found %s", arg)
+		}
+		want := when.UnixNano()
+		return func(value protoreflect.Value) bool {
+			msg := value.Message()
+			fields := msg.Descriptor().Fields()
+			seconds, nanos := msg.Get(fields.ByNumber(1)).Int(), msg.Get(fields.ByNumber(2)).Int()
+			return compareOp(op, cmp.Compare(time.Unix(seconds, nanos).UnixNano(), want))
+		}, nil
+	case dateName:
+		when, err := time.Parse(time.DateOnly, arg.text)
+		if arg.kind != tokenString || err != nil {
+			return nil, errorf(arg, `expected a date string like "1977-05-25" but found %s`, arg)
+		}
+		want := dateKey(int64(when.Year()), int64(when.Month()), int64(when.Day()))
+		return func(value protoreflect.Value) bool {
+			msg := value.Message()
+			fields := msg.Descriptor().Fields()
+			got := dateKey(msg.Get(fields.ByNumber(1)).Int(), msg.Get(fields.ByNumber(2)).Int(), msg.Get(fields.ByNumber(3)).Int())
+			return compareOp(op, cmp.Compare(got, want))
+		}, nil
+	default:
+		return nil, errorf(arg, `message field %q can only be tested for presence with ":*"`, field.Name())
+	}
+}
+
+func dateKey(year, month, day int64) int64 {
+	return year*10000 + month*100 + day
+}
+
+func parseNumber(arg token) (float64, error) {
+	if arg.kind == tokenNumber {
+		if val, err := strconv.ParseFloat(arg.text, 64); err == nil {
+			return val, nil
+		}
+	}
+	return 0, errorf(arg, "expected a number but found %s", arg)
+}
+
+func compareBool(got, want bool) int {
+	if got == want {
+		return 0
+	}
+	return 1
+}
+
+func isEquality(op string) bool {
+	return op == "=" || op == "!=" || op == ":"
+}
+
+// compareOp applies the given comparator to the result of comparing a field
+// value to a restriction's value.
+func compareOp(op string, result int) bool {
+	switch op {
+	case "=", ":":
+		return result == 0
+	case "!=":
+		return result != 0
+	case "<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	case ">":
+		return result > 0
+	case ">=":
+		return result >= 0
+	default:
+		return false
+	}
+}
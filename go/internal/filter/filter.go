@@ -0,0 +1,101 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filter implements filter expressions in the style of AIP-160
+// (https://google.aip.dev/160), which are evaluated against proto messages.
+//
+// A filter consists of restrictions, such as `gender = "female"` or
+// `hyper_drive_rating < 1.0`, combined with AND, OR, NOT, and parentheses.
+// Restrictions that are separated only by whitespace are implicitly combined
+// with AND. As in AIP-160, OR has higher precedence than AND, so
+// `a AND b OR c` means `a AND (b OR c)`.
+//
+// The left-hand side of a restriction is a field path, like `name` or
+// `release_date.year`. Fields may be named using either their proto names or
+// their JSON names. The supported comparators are =, !=, <, <=, >, >=, and
+// the "has" operator (:).
+//
+//   - String fields can be compared to quoted strings or bare words. With =
+//     and !=, a leading or trailing * in the value is a wildcard. With :, the
+//     value matches if it is a case-insensitive substring of the field.
+//   - Numeric fields can be compared to numbers.
+//   - Bool fields can be compared to true and false.
+//   - Enum fields can be compared to the names of their values.
+//   - Timestamp fields can be compared to RFC 3339 strings, and date fields
+//     can be compared to strings in the form "YYYY-MM-DD".
+//   - Repeated fields only support :, which matches if any element of the
+//     list is equal to the value.
+//   - The restriction `field:*` matches if the field is present: non-empty
+//     for repeated fields and non-zero for other fields.
+//
+// Fields that track presence, like proto3 optional fields, do not match any
+// comparison when they are absent.
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Filter is a compiled filter expression. A nil *Filter matches everything.
+type Filter struct {
+	root node
+}
+
+// Error describes a problem with a filter expression.
+type Error struct {
+	// Pos is the 1-based position, in bytes, in the filter expression
+	// where the problem was found.
+	Pos int
+	// Msg describes the problem.
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Pos, e.Msg)
+}
+
+// Compile parses the given filter expression and resolves its field paths
+// against the given message descriptor. If the expression is empty or only
+// whitespace, the returned filter is nil, which matches everything. If the
+// expression is invalid, the returned error is an *Error.
+func Compile(expr string, desc protoreflect.MessageDescriptor) (*Filter, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil //nolint:nilnil
+	}
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, desc: desc}
+	root, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, errorf(tok, "unexpected %s", tok)
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match returns true if the given message matches the filter. The message
+// must be of the type whose descriptor was used to compile the filter.
+func (f *Filter) Match(msg protoreflect.Message) bool {
+	if f == nil {
+		return true
+	}
+	return f.root.match(msg)
+}
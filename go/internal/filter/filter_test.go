@@ -0,0 +1,159 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+	population := 200000.0
+	tatooine := &planetv1.Planet{
+		Id:           "1",
+		Name:         "Tatooine",
+		Population:   &population,
+		Climates:     []string{"arid"},
+		ClimateTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_ARID},
+		Terrains:     []string{"desert"},
+		FilmIds:      []string{"1", "3"},
+		GravityRange: &planetv1.GravityRange{Min: 1, Max: 1},
+		Created:      timestamppb.New(mustParseTime(t, "2014-12-09T13:50:49.641Z")),
+	}
+	// Hoth's population is unknown, so it is absent.
+	hoth := &planetv1.Planet{
+		Id:           "4",
+		Name:         "Hoth",
+		Climates:     []string{"frozen"},
+		ClimateTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_FROZEN},
+		FilmIds:      []string{"2"},
+		GravityRange: &planetv1.GravityRange{Min: 1.1, Max: 1.1},
+	}
+	film := &filmv1.Film{
+		Title:         "A New Hope",
+		EpisodeNumber: 4,
+		ReleaseDate:   &date.Date{Year: 1977, Month: 5, Day: 25},
+	}
+	testCases := []struct {
+		name string
+		expr string
+		msg  proto.Message
+		want bool
+	}{
+		{name: "empty", expr: "  ", msg: hoth, want: true},
+		{name: "string equality", expr: `name = "Tatooine"`, msg: tatooine, want: true},
+		{name: "string inequality", expr: `name != "Tatooine"`, msg: tatooine, want: false},
+		{name: "bare word", expr: `name = Hoth`, msg: hoth, want: true},
+		{name: "prefix wildcard", expr: `name = "Tat*"`, msg: tatooine, want: true},
+		{name: "suffix wildcard", expr: `name = "*oth"`, msg: hoth, want: true},
+		{name: "string has is case-insensitive substring", expr: `name:"TOO"`, msg: tatooine, want: true},
+		{name: "json name", expr: `gravityRange.max > 1`, msg: hoth, want: true},
+		{name: "nested field", expr: `gravity_range.min >= 1.1`, msg: tatooine, want: false},
+		{name: "number", expr: `population > 1e5`, msg: tatooine, want: true},
+		{name: "absent optional never compares", expr: `population < 1`, msg: hoth, want: false},
+		{name: "absent optional never compares negated", expr: `population != 1`, msg: hoth, want: false},
+		{name: "presence of optional", expr: `population:*`, msg: hoth, want: false},
+		{name: "presence of list", expr: `terrains:*`, msg: tatooine, want: true},
+		{name: "presence of empty list", expr: `terrains:*`, msg: hoth, want: false},
+		{name: "list has element", expr: `film_ids:3`, msg: tatooine, want: true},
+		{name: "list has element is exact", expr: `film_ids:"1*"`, msg: hoth, want: false},
+		{name: "list has missing element", expr: `film_ids:2`, msg: tatooine, want: false},
+		{name: "list has enum", expr: `climate_types:CLIMATE_FROZEN`, msg: hoth, want: true},
+		{name: "timestamp", expr: `created < "2015-01-01T00:00:00Z"`, msg: tatooine, want: true},
+		{name: "date", expr: `release_date = "1977-05-25"`, msg: film, want: true},
+		{name: "date comparison", expr: `release_date > "1980-01-01"`, msg: film, want: false},
+		{name: "implicit and", expr: `name:hoth film_ids:2`, msg: hoth, want: true},
+		{name: "and", expr: `name:hoth AND film_ids:1`, msg: hoth, want: false},
+		{name: "or", expr: `film_ids:1 OR film_ids:2`, msg: hoth, want: true},
+		{name: "not", expr: `NOT name:hoth`, msg: hoth, want: false},
+		{name: "minus", expr: `-name:hoth`, msg: tatooine, want: true},
+		// OR binds more tightly than AND, so this is
+		// `name:tat AND (film_ids:1 OR film_ids:2)`, which does not match
+		// Hoth. With the usual precedence, it would.
+		{name: "or binds tighter than and", expr: `name:tat AND film_ids:1 OR film_ids:2`, msg: hoth, want: false},
+		{name: "parentheses override precedence", expr: `(name:tat AND film_ids:1) OR film_ids:2`, msg: hoth, want: true},
+		{name: "not applies to one term", expr: `NOT name:tat OR film_ids:1`, msg: tatooine, want: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			compiled, err := Compile(testCase.expr, testCase.msg.ProtoReflect().Descriptor())
+			if err != nil {
+				t.Fatalf("Compile(%q) returned error: %v", testCase.expr, err)
+			}
+			if got := compiled.Match(testCase.msg.ProtoReflect()); got != testCase.want {
+				t.Errorf("Match(%q) = %v, want %v", testCase.expr, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		expr    string
+		wantPos int
+		wantMsg string
+	}{
+		{expr: `bogus = 1`, wantPos: 1, wantMsg: `unknown field "bogus"`},
+		{expr: `name = "x" AND bogus = 1`, wantPos: 16, wantMsg: `unknown field "bogus"`},
+		{expr: `gravity_range.bogus > 1`, wantPos: 15, wantMsg: `unknown field "bogus" in "gravity_range"`},
+		{expr: `name.length = 1`, wantPos: 6, wantMsg: `cannot refer to fields of "name" because it is not a message`},
+		{expr: `film_ids = 1`, wantPos: 10, wantMsg: `repeated field "film_ids" can only be used with ":"`},
+		{expr: `diameter > "big"`, wantPos: 12, wantMsg: `expected a number but found "big"`},
+		{expr: `climate_types:ARID`, wantPos: 15, wantMsg: `expected a value of enum buf.knit.demo.swapi.planet.v1.Climate but found "ARID"`},
+		{expr: `created > "yesterday"`, wantPos: 11, wantMsg: `expected an RFC 3339 timestamp string but found "yesterday"`},
+		{expr: `name = *`, wantPos: 8, wantMsg: `"*" can only be used with ":"`},
+		{expr: `name`, wantPos: 5, wantMsg: `expected a comparison operator after "name" but found end of filter`},
+		{expr: `name = `, wantPos: 8, wantMsg: `expected a value but found end of filter`},
+		{expr: `(name = x`, wantPos: 10, wantMsg: `expected ")" but found end of filter`},
+		{expr: `name = x)`, wantPos: 9, wantMsg: `unexpected ")"`},
+		{expr: `name ! x`, wantPos: 6, wantMsg: `expected "!="`},
+		{expr: `name = "x`, wantPos: 8, wantMsg: `unterminated string`},
+		{expr: `name = x & y`, wantPos: 10, wantMsg: `unexpected character '&'`},
+	}
+	desc := (&planetv1.Planet{}).ProtoReflect().Descriptor()
+	for _, testCase := range testCases {
+		t.Run(testCase.expr, func(t *testing.T) {
+			t.Parallel()
+			_, err := Compile(testCase.expr, desc)
+			var filterErr *Error
+			if !errors.As(err, &filterErr) {
+				t.Fatalf("Compile(%q) returned %v, want *Error", testCase.expr, err)
+			}
+			if filterErr.Pos != testCase.wantPos || filterErr.Msg != testCase.wantMsg {
+				t.Errorf("Compile(%q) returned error at %d %q, want at %d %q",
+					testCase.expr, filterErr.Pos, filterErr.Msg, testCase.wantPos, testCase.wantMsg)
+			}
+		})
+	}
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	when, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return when
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenComparator
+	tokenLParen
+	tokenRParen
+	tokenDot
+	tokenStar
+	tokenMinus
+	tokenAnd
+	tokenOr
+	tokenNot
+)
+
+type token struct {
+	kind tokenKind
+	// text is the token's text. For string literals, this is the unquoted
+	// value.
+	text string
+	// pos is the 1-based byte offset of the token in the expression.
+	pos int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of filter"
+	case tokenString:
+		return strconv.Quote(t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+func lex(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		char, size := utf8.DecodeRuneInString(expr[i:])
+		start := i
+		switch {
+		case unicode.IsSpace(char):
+			i += size
+			continue
+		case char == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: start + 1})
+			i++
+		case char == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: start + 1})
+			i++
+		case char == '.':
+			tokens = append(tokens, token{kind: tokenDot, text: ".", pos: start + 1})
+			i++
+		case char == '*':
+			tokens = append(tokens, token{kind: tokenStar, text: "*", pos: start + 1})
+			i++
+		case char == ':' || char == '=':
+			tokens = append(tokens, token{kind: tokenComparator, text: string(char), pos: start + 1})
+			i++
+		case char == '<' || char == '>' || char == '!':
+			i++
+			if i < len(expr) && expr[i] == '=' {
+				i++
+			} else if char == '!' {
+				return nil, &Error{Pos: start + 1, Msg: `expected "!="`}
+			}
+			tokens = append(tokens, token{kind: tokenComparator, text: expr[start:i], pos: start + 1})
+		case char == '"' || char == '\'':
+			text, end, err := lexString(expr, start)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: start + 1})
+			i = end
+		case char == '-':
+			if i+1 < len(expr) && isDigit(expr[i+1]) {
+				end := lexNumber(expr, i+1)
+				tokens = append(tokens, token{kind: tokenNumber, text: expr[start:end], pos: start + 1})
+				i = end
+			} else {
+				tokens = append(tokens, token{kind: tokenMinus, text: "-", pos: start + 1})
+				i++
+			}
+		case isDigit(expr[i]):
+			end := lexNumber(expr, i)
+			tokens = append(tokens, token{kind: tokenNumber, text: expr[start:end], pos: start + 1})
+			i = end
+		case char == '_' || unicode.IsLetter(char):
+			for i < len(expr) {
+				char, size := utf8.DecodeRuneInString(expr[i:])
+				if char != '_' && !unicode.IsLetter(char) && !unicode.IsDigit(char) {
+					break
+				}
+				i += size
+			}
+			text := expr[start:i]
+			kind := tokenIdent
+			switch text {
+			case "AND":
+				kind = tokenAnd
+			case "OR":
+				kind = tokenOr
+			case "NOT":
+				kind = tokenNot
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: start + 1})
+		default:
+			return nil, &Error{Pos: start + 1, Msg: fmt.Sprintf("unexpected character %q", char)}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(expr) + 1}), nil
+}
+
+func lexString(expr string, start int) (string, int, error) {
+	quote := expr[start]
+	var text strings.Builder
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case quote:
+			return text.String(), i + 1, nil
+		case '\\':
+			i++
+			if i == len(expr) {
+				break
+			}
+			switch expr[i] {
+			case 'n':
+				text.WriteByte('\n')
+			case 't':
+				text.WriteByte('\t')
+			default:
+				text.WriteByte(expr[i])
+			}
+		default:
+			text.WriteByte(expr[i])
+		}
+	}
+	return "", 0, &Error{Pos: start + 1, Msg: "unterminated string"}
+}
+
+func lexNumber(expr string, i int) int {
+	for i < len(expr) && isDigit(expr[i]) {
+		i++
+	}
+	if i+1 < len(expr) && expr[i] == '.' && isDigit(expr[i+1]) {
+		i++
+		for i < len(expr) && isDigit(expr[i]) {
+			i++
+		}
+	}
+	if i < len(expr) && (expr[i] == 'e' || expr[i] == 'E') {
+		j := i + 1
+		if j < len(expr) && (expr[j] == '+' || expr[j] == '-') {
+			j++
+		}
+		if j < len(expr) && isDigit(expr[j]) {
+			i = j
+			for i < len(expr) && isDigit(expr[i]) {
+				i++
+			}
+		}
+	}
+	return i
+}
+
+func isDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
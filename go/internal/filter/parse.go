@@ -0,0 +1,198 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bufbuild/knit-demo/go/internal/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parser is a recursive descent parser for the following grammar, which is
+// a subset of the one in AIP-160:
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" | "-" ] simple
+//	simple      = restriction | "(" expression ")"
+//	restriction = member comparator arg
+//	member      = ident { "." ident }
+//	arg         = string | number | ident | "*"
+type parser struct {
+	tokens []token
+	desc   protoreflect.MessageDescriptor
+}
+
+func (p *parser) peek() token {
+	return p.tokens[0]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[0]
+	if tok.kind != tokenEOF {
+		p.tokens = p.tokens[1:]
+	}
+	return tok
+}
+
+func (p *parser) parseExpression() (node, error) {
+	first, err := p.parseSequence()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{first}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		next, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	return newAndNode(nodes), nil
+}
+
+func (p *parser) parseSequence() (node, error) {
+	first, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{first}
+	for {
+		switch p.peek().kind { //nolint:exhaustive
+		case tokenIdent, tokenNot, tokenMinus, tokenLParen:
+			next, err := p.parseFactor()
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, next)
+		default:
+			return newAndNode(nodes), nil
+		}
+	}
+}
+
+func (p *parser) parseFactor() (node, error) {
+	first, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []node{first}
+	for p.peek().kind == tokenOr {
+		p.next()
+		next, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, next)
+	}
+	if len(nodes) == 1 {
+		return first, nil
+	}
+	return orNode(nodes), nil
+}
+
+func (p *parser) parseTerm() (node, error) {
+	if kind := p.peek().kind; kind == tokenNot || kind == tokenMinus {
+		p.next()
+		child, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{child: child}, nil
+	}
+	return p.parseSimple()
+}
+
+func (p *parser) parseSimple() (node, error) {
+	if p.peek().kind != tokenLParen {
+		return p.parseRestriction()
+	}
+	p.next()
+	expr, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.next(); tok.kind != tokenRParen {
+		return nil, errorf(tok, `expected ")" but found %s`, tok)
+	}
+	return expr, nil
+}
+
+func (p *parser) parseRestriction() (node, error) {
+	path, err := p.parseMember()
+	if err != nil {
+		return nil, err
+	}
+	comparator := p.next()
+	if comparator.kind != tokenComparator {
+		return nil, errorf(comparator, "expected a comparison operator after %q but found %s", fieldPathString(path), comparator)
+	}
+	arg := p.next()
+	switch arg.kind { //nolint:exhaustive
+	case tokenString, tokenNumber, tokenIdent, tokenStar:
+	default:
+		return nil, errorf(arg, "expected a value but found %s", arg)
+	}
+	return newRestriction(path, comparator, arg)
+}
+
+func (p *parser) parseMember() ([]protoreflect.FieldDescriptor, error) {
+	var path []protoreflect.FieldDescriptor
+	desc := p.desc
+	for {
+		tok := p.next()
+		if tok.kind != tokenIdent {
+			return nil, errorf(tok, "expected a field name but found %s", tok)
+		}
+		if desc == nil {
+			if path[len(path)-1].IsList() {
+				return nil, errorf(tok, "cannot refer to fields of repeated field %q", fieldPathString(path))
+			}
+			return nil, errorf(tok, "cannot refer to fields of %q because it is not a message", fieldPathString(path))
+		}
+		field := protopath.FieldByName(desc, tok.text)
+		if field == nil {
+			if len(path) == 0 {
+				return nil, errorf(tok, "unknown field %q", tok.text)
+			}
+			return nil, errorf(tok, "unknown field %q in %q", tok.text, fieldPathString(path))
+		}
+		path = append(path, field)
+		desc = nil
+		if !field.IsList() && !field.IsMap() {
+			desc = field.Message()
+		}
+		if p.peek().kind != tokenDot {
+			return path, nil
+		}
+		p.next()
+	}
+}
+
+func errorf(tok token, format string, args ...any) error {
+	return &Error{Pos: tok.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func fieldPathString(path []protoreflect.FieldDescriptor) string {
+	names := make([]string, len(path))
+	for i, field := range path {
+		names[i] = string(field.Name())
+	}
+	return strings.Join(names, ".")
+}
@@ -0,0 +1,270 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package orderby implements order_by expressions in the style of AIP-132
+// (https://google.aip.dev/132#ordering), which are used to sort proto
+// messages.
+//
+// An expression is a comma-separated list of field paths, each optionally
+// followed by "asc" or "desc", such as "population desc, name". Fields may
+// be named using either their proto names or their JSON names, and must be
+// scalar fields, enums, timestamps, or dates. Fields that track presence,
+// like proto3 optional fields, sort before all other values when absent.
+package orderby
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/bufbuild/knit-demo/go/internal/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Ordering is a parsed order_by expression. An empty Ordering considers all
+// messages to be equal.
+type Ordering []Key
+
+// Key is one of the sort keys of an Ordering.
+type Key struct {
+	// Path is the path to the field to sort by.
+	Path []protoreflect.FieldDescriptor
+	// Desc is true if the key sorts in descending order.
+	Desc bool
+}
+
+// Error describes a problem with an order_by expression.
+type Error struct {
+	// Pos is the 1-based position, in bytes, in the expression where the
+	// problem was found.
+	Pos int
+	// Msg describes the problem.
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Pos, e.Msg)
+}
+
+// Parse parses the given order_by expression and resolves its field paths
+// against the given message descriptor. If the expression is invalid, the
+// returned error is an *Error.
+func Parse(expr string, desc protoreflect.MessageDescriptor) (Ordering, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, nil
+	}
+	var ordering Ordering
+	var offset int
+	for _, part := range strings.Split(expr, ",") {
+		key, err := parseKey(part, offset, desc)
+		if err != nil {
+			return nil, err
+		}
+		ordering = append(ordering, key)
+		offset += len(part) + 1
+	}
+	return ordering, nil
+}
+
+func parseKey(part string, offset int, desc protoreflect.MessageDescriptor) (Key, error) {
+	words := fieldsWithPos(part, offset)
+	switch {
+	case len(words) == 0:
+		return Key{}, &Error{Pos: offset + 1, Msg: "expected a field name"}
+	case len(words) > 2:
+		return Key{}, &Error{Pos: words[2].pos, Msg: fmt.Sprintf("unexpected %q", words[2].text)}
+	}
+	var key Key
+	if len(words) == 2 {
+		switch words[1].text {
+		case "asc":
+		case "desc":
+			key.Desc = true
+		default:
+			return Key{}, &Error{Pos: words[1].pos, Msg: fmt.Sprintf(`expected "asc" or "desc" but found %q`, words[1].text)}
+		}
+	}
+	pos := words[0].pos
+	for _, name := range strings.Split(words[0].text, ".") {
+		if desc == nil {
+			return Key{}, &Error{Pos: pos, Msg: fmt.Sprintf("cannot refer to fields of %q because it is not a message", key.String())}
+		}
+		field := protopath.FieldByName(desc, name)
+		if field == nil {
+			return Key{}, &Error{Pos: pos, Msg: fmt.Sprintf("unknown field %q", name)}
+		}
+		if field.IsList() || field.IsMap() {
+			return Key{}, &Error{Pos: pos, Msg: fmt.Sprintf("cannot order by repeated field %q", name)}
+		}
+		key.Path = append(key.Path, field)
+		desc = field.Message()
+		pos += len(name) + 1
+	}
+	if field := key.Path[len(key.Path)-1]; !isOrderable(field) {
+		return Key{}, &Error{Pos: words[0].pos, Msg: fmt.Sprintf("cannot order by field %q of type %s", key.String(), kindName(field))}
+	}
+	return key, nil
+}
+
+// String returns the canonical form of the ordering, in which every key
+// uses proto field names and an explicit direction.
+func (o Ordering) String() string {
+	keys := make([]string, len(o))
+	for i, key := range o {
+		keys[i] = key.String()
+		if key.Desc {
+			keys[i] += " desc"
+		} else {
+			keys[i] += " asc"
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+// String returns the key's field path.
+func (k Key) String() string {
+	names := make([]string, len(k.Path))
+	for i, field := range k.Path {
+		names[i] = string(field.Name())
+	}
+	return strings.Join(names, ".")
+}
+
+// Compare compares two messages according to the ordering. It returns a
+// negative number if a sorts before b, a positive number if a sorts after
+// b, and zero if they are equal.
+func (o Ordering) Compare(a, b protoreflect.Message) int {
+	for _, key := range o {
+		result := key.compare(a, b)
+		if key.Desc {
+			result = -result
+		}
+		if result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+func (k Key) compare(a, b protoreflect.Message) int {
+	aVal, aOK := k.value(a)
+	bVal, bOK := k.value(b)
+	if !aOK || !bOK {
+		return compareBool(aOK, bOK)
+	}
+	field := k.Path[len(k.Path)-1]
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return strings.Compare(aVal.String(), bVal.String())
+	case protoreflect.BoolKind:
+		return compareBool(aVal.Bool(), bVal.Bool())
+	case protoreflect.EnumKind:
+		return cmp.Compare(aVal.Enum(), bVal.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return cmp.Compare(aVal.Int(), bVal.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return cmp.Compare(aVal.Uint(), bVal.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return cmp.Compare(aVal.Float(), bVal.Float())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		// Timestamps and dates both consist of integer fields, ordered from
+		// most to least significant.
+		aMsg, bMsg := aVal.Message(), bVal.Message()
+		fields := field.Message().Fields()
+		for i := range fields.Len() {
+			if result := cmp.Compare(aMsg.Get(fields.Get(i)).Int(), bMsg.Get(fields.Get(i)).Int()); result != 0 {
+				return result
+			}
+		}
+		return 0
+	case protoreflect.BytesKind:
+		fallthrough
+	default:
+		return 0
+	}
+}
+
+// value returns the value of the key's field in the given message, or false
+// if the field is absent.
+func (k Key) value(msg protoreflect.Message) (protoreflect.Value, bool) {
+	for _, field := range k.Path[:len(k.Path)-1] {
+		if !msg.Has(field) {
+			return protoreflect.Value{}, false
+		}
+		msg = msg.Get(field).Message()
+	}
+	field := k.Path[len(k.Path)-1]
+	if field.HasPresence() && !msg.Has(field) {
+		return protoreflect.Value{}, false
+	}
+	return msg.Get(field), true
+}
+
+func isOrderable(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		return false
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		name := field.Message().FullName()
+		return name == "google.protobuf.Timestamp" || name == "google.type.Date"
+	default:
+		return true
+	}
+}
+
+func kindName(field protoreflect.FieldDescriptor) string {
+	if field.Message() != nil {
+		return string(field.Message().FullName())
+	}
+	return field.Kind().String()
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+type word struct {
+	text string
+	pos  int
+}
+
+// fieldsWithPos splits s into words separated by whitespace, along with
+// their 1-based positions in the overall expression.
+func fieldsWithPos(s string, offset int) []word {
+	var words []word
+	start := -1
+	for i, char := range s {
+		if unicode.IsSpace(char) {
+			if start >= 0 {
+				words = append(words, word{text: s[start:i], pos: offset + start + 1})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		words = append(words, word{text: s[start:], pos: offset + start + 1})
+	}
+	return words
+}
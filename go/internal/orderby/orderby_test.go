@@ -0,0 +1,109 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orderby
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	small, large := 1000.0, 200000.0
+	// Planets in their default order. Hoth's population is absent.
+	planets := []*planetv1.Planet{
+		{Id: "1", Name: "Tatooine", Population: &large, GravityRange: &planetv1.GravityRange{Max: 1}},
+		{Id: "2", Name: "Alderaan", Population: &large},
+		{Id: "3", Name: "Yavin IV", Population: &small, GravityRange: &planetv1.GravityRange{Max: 1}},
+		{Id: "4", Name: "Hoth", GravityRange: &planetv1.GravityRange{Max: 1.1}},
+	}
+	testCases := []struct {
+		expr       string
+		wantIDs    []string
+		wantString string
+	}{
+		{expr: "", wantIDs: []string{"1", "2", "3", "4"}, wantString: ""},
+		{expr: "name", wantIDs: []string{"2", "4", "1", "3"}, wantString: "name asc"},
+		{expr: "name desc", wantIDs: []string{"3", "1", "4", "2"}, wantString: "name desc"},
+		// Absent values sort before all others, and so after all others in
+		// descending order. Equal values remain in their default order.
+		{expr: "population", wantIDs: []string{"4", "3", "1", "2"}, wantString: "population asc"},
+		{expr: "population desc", wantIDs: []string{"1", "2", "3", "4"}, wantString: "population desc"},
+		{expr: " population desc , name ", wantIDs: []string{"2", "1", "3", "4"}, wantString: "population desc,name asc"},
+		// Alderaan has no gravity range, so its nested field is absent.
+		{expr: "gravityRange.max desc, id desc", wantIDs: []string{"4", "3", "1", "2"}, wantString: "gravity_range.max desc,id desc"},
+	}
+	desc := (&planetv1.Planet{}).ProtoReflect().Descriptor()
+	for _, testCase := range testCases {
+		t.Run(testCase.expr, func(t *testing.T) {
+			t.Parallel()
+			ordering, err := Parse(testCase.expr, desc)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", testCase.expr, err)
+			}
+			if got := ordering.String(); got != testCase.wantString {
+				t.Errorf("String() = %q, want %q", got, testCase.wantString)
+			}
+			sorted := slices.Clone(planets)
+			slices.SortStableFunc(sorted, func(a, b *planetv1.Planet) int {
+				return ordering.Compare(a.ProtoReflect(), b.ProtoReflect())
+			})
+			ids := make([]string, len(sorted))
+			for i, planet := range sorted {
+				ids[i] = planet.GetId()
+			}
+			if !slices.Equal(ids, testCase.wantIDs) {
+				t.Errorf("sorted ids = %v, want %v", ids, testCase.wantIDs)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		expr    string
+		wantPos int
+		wantMsg string
+	}{
+		{expr: "bogus", wantPos: 1, wantMsg: `unknown field "bogus"`},
+		{expr: "name, bogus desc", wantPos: 7, wantMsg: `unknown field "bogus"`},
+		{expr: "name,,id", wantPos: 6, wantMsg: "expected a field name"},
+		{expr: "name up", wantPos: 6, wantMsg: `expected "asc" or "desc" but found "up"`},
+		{expr: "name asc id", wantPos: 10, wantMsg: `unexpected "id"`},
+		{expr: "gravity_range.bogus", wantPos: 15, wantMsg: `unknown field "bogus"`},
+		{expr: "name.length", wantPos: 6, wantMsg: `cannot refer to fields of "name" because it is not a message`},
+		{expr: "film_ids", wantPos: 1, wantMsg: `cannot order by repeated field "film_ids"`},
+		{expr: "gravity_range", wantPos: 1, wantMsg: `cannot order by field "gravity_range" of type buf.knit.demo.swapi.planet.v1.GravityRange`},
+	}
+	desc := (&planetv1.Planet{}).ProtoReflect().Descriptor()
+	for _, testCase := range testCases {
+		t.Run(testCase.expr, func(t *testing.T) {
+			t.Parallel()
+			_, err := Parse(testCase.expr, desc)
+			var orderByErr *Error
+			if !errors.As(err, &orderByErr) {
+				t.Fatalf("Parse(%q) returned %v, want *Error", testCase.expr, err)
+			}
+			if orderByErr.Pos != testCase.wantPos || orderByErr.Msg != testCase.wantMsg {
+				t.Errorf("Parse(%q) returned error at %d %q, want at %d %q",
+					testCase.expr, orderByErr.Pos, orderByErr.Msg, testCase.wantPos, testCase.wantMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package protopath resolves the names of fields in paths to fields of
+// proto messages, such as those in filter and order_by expressions.
+package protopath
+
+import "google.golang.org/protobuf/reflect/protoreflect"
+
+// FieldByName returns the field of the given message with the given name,
+// which may be either the field's proto name or its JSON name. It returns
+// nil if there is no such field.
+func FieldByName(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := desc.Fields()
+	if field := fields.ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+	return fields.ByJSONName(name)
+}
@@ -0,0 +1,49 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protopath
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestFieldByName(t *testing.T) {
+	t.Parallel()
+	desc := (&descriptorpb.FieldDescriptorProto{}).ProtoReflect().Descriptor()
+	testCases := []struct {
+		name string
+		want string
+	}{
+		{name: "type_name", want: "type_name"},
+		{name: "typeName", want: "type_name"},
+		{name: "json_name", want: "json_name"},
+		{name: "TypeName"},
+		{name: "typename"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			field := FieldByName(desc, testCase.name)
+			var got string
+			if field != nil {
+				got = string(field.Name())
+			}
+			if got != testCase.want {
+				t.Errorf("FieldByName(%q) = %q, want %q", testCase.name, got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+	commonv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultChangeLogRetention is the number of changes that are retained by
+// default for resuming Watch RPCs.
+const defaultChangeLogRetention = 1000
+
+// errChangesExpired is returned by changeLog.since when the requested
+// changes are no longer retained.
+var errChangesExpired = errors.New("changes are no longer retained")
+
+// change is one event in a changeLog.
+type change struct {
+	// sequence is the position of the change in the log. The first change
+	// has sequence 1.
+	sequence   uint64
+	kind       string
+	changeType commonv1.ChangeType
+	// entity is the entity after the change, or before it for deletes.
+	entity proto.Message
+}
+
+// changeLog records the changes to the entities served by a Handler, so
+// they can be streamed by Watch RPCs. Only the most recent changes are
+// retained.
+type changeLog struct {
+	// epoch identifies the log. Resume tokens from a different log, such as
+	// one in another server process, are rejected.
+	epoch     string
+	retention int
+
+	mu sync.Mutex
+	// changes are the retained changes, in order of sequence.
+	changes []change
+	// last is the sequence of the last change that was recorded.
+	last uint64
+	// appended is closed, and then replaced, when changes are recorded.
+	appended chan struct{}
+}
+
+func newChangeLog(epoch string, retention int) *changeLog {
+	return &changeLog{epoch: epoch, retention: retention, appended: make(chan struct{})}
+}
+
+// record appends the differences between two stores to the log. The
+// previous store may be nil.
+func (l *changeLog) record(prev, next *Store) {
+	if prev == nil {
+		return
+	}
+	var changes []change
+	for _, kind := range entityTypeNames {
+		changes = append(changes, diffEntities(kind, prev.indexByName(kind), next.indexByName(kind))...)
+	}
+	if len(changes) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for i := range changes {
+		l.last++
+		changes[i].sequence = l.last
+	}
+	l.changes = append(l.changes, changes...)
+	if excess := len(l.changes) - l.retention; excess > 0 {
+		l.changes = append([]change(nil), l.changes[excess:]...)
+	}
+	close(l.appended)
+	l.appended = make(chan struct{})
+}
+
+// position returns the sequence of the last change that was recorded.
+func (l *changeLog) position() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.last
+}
+
+// since returns the changes after the one with the given sequence, along
+// with a channel that is closed when more changes are recorded. It returns
+// errChangesExpired if any of those changes are no longer retained.
+func (l *changeLog) since(sequence uint64) ([]change, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if sequence > l.last {
+		return nil, nil, errChangesExpired
+	}
+	first := l.last - uint64(len(l.changes)) + 1
+	if sequence+1 < first {
+		return nil, nil, errChangesExpired
+	}
+	return l.changes[sequence+1-first:], l.appended, nil
+}
+
+// diffEntities returns the changes between two indexes of the same type of
+// entity. Since stores share unchanged messages, most entities can be
+// compared by identity.
+func diffEntities(kind string, prev, next messageIndex) []change {
+	var changes []change
+	for _, item := range next.messages() {
+		old, ok := prev.getMessage(entityID(item))
+		switch {
+		case !ok:
+			changes = append(changes, change{kind: kind, changeType: commonv1.ChangeType_CHANGE_TYPE_CREATED, entity: item})
+		case old != item && !proto.Equal(old, item):
+			changes = append(changes, change{kind: kind, changeType: commonv1.ChangeType_CHANGE_TYPE_UPDATED, entity: item})
+		}
+	}
+	for _, item := range prev.messages() {
+		if _, ok := next.getMessage(entityID(item)); !ok {
+			changes = append(changes, change{kind: kind, changeType: commonv1.ChangeType_CHANGE_TYPE_DELETED, entity: item})
+		}
+	}
+	return changes
+}
+
+func entityID(msg proto.Message) string {
+	return msg.(entity).GetId() //nolint:forcetypeassert,errcheck
+}
+
+// resumeToken is the content of the resume tokens returned by Watch RPCs.
+// Like a pageToken, it is serialized as JSON followed by an HMAC.
+type resumeToken struct {
+	// Epoch is the epoch of the changeLog that returned the token.
+	Epoch string `json:"e"`
+	// Sequence is the sequence of the last change that the client saw.
+	Sequence uint64 `json:"s"`
+}
+
+func (h *Handler) encodeResumeToken(sequence uint64) string {
+	data, _ := json.Marshal(resumeToken{Epoch: h.changes.epoch, Sequence: sequence}) //nolint:errchkjson // cannot fail
+	return base64.RawURLEncoding.EncodeToString(append(data, tokenMAC(h.pageTokenKey, data)...))
+}
+
+func (h *Handler) decodeResumeToken(token string) (resumeToken, error) {
+	var decoded resumeToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) < sha256.Size {
+		return decoded, errors.New("malformed token")
+	}
+	data, mac := data[:len(data)-sha256.Size], data[len(data)-sha256.Size:]
+	if !hmac.Equal(mac, tokenMAC(h.pageTokenKey, data)) {
+		return decoded, errors.New("malformed token")
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return decoded, errors.New("malformed token")
+	}
+	return decoded, nil
+}
+
+// watchEntities implements the Watch RPCs. It sends the changes to entities
+// of the given type to the stream until the context is done.
+func watchEntities[T entity, R any](
+	ctx context.Context,
+	h *Handler,
+	kind entityType[T],
+	resumeToken string,
+	initialSnapshot bool,
+	stream *connect.ServerStream[R],
+	newResponse func(commonv1.ChangeType, T, string) *R,
+) error {
+	var sequence uint64
+	switch {
+	case resumeToken != "" && initialSnapshot:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("cannot use both resume_token and initial_snapshot"))
+	case resumeToken != "":
+		decoded, err := h.decodeResumeToken(resumeToken)
+		if err != nil {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid resume_token: %w", err))
+		}
+		if decoded.Epoch != h.changes.epoch {
+			return connect.NewError(connect.CodeOutOfRange, errors.New("resume_token was returned by a different server process"))
+		}
+		sequence = decoded.Sequence
+	default:
+		// The writer holds writeMu while replacing the store and recording
+		// its changes, so this store and position are consistent.
+		h.writeMu.Lock()
+		store := h.store.Load()
+		sequence = h.changes.position()
+		h.writeMu.Unlock()
+		if initialSnapshot {
+			token := h.encodeResumeToken(sequence)
+			for _, item := range kind.index(store).all {
+				if err := stream.Send(newResponse(commonv1.ChangeType_CHANGE_TYPE_SNAPSHOT, item, token)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	// Send the response headers right away, so clients know that the watch
+	// has started even if nothing changes.
+	if err := stream.Send(nil); err != nil {
+		return err
+	}
+	// sent is the sequence of the last resume token that the client has.
+	sent := sequence
+	for {
+		changes, appended, err := h.changes.since(sequence)
+		if err != nil {
+			return connect.NewError(connect.CodeOutOfRange, errors.New("resume_token has expired; watch again with initial_snapshot"))
+		}
+		for _, change := range changes {
+			sequence = change.sequence
+			if change.kind != kind.name {
+				continue
+			}
+			item := change.entity.(T) //nolint:forcetypeassert,errcheck
+			if err := stream.Send(newResponse(change.changeType, item, h.encodeResumeToken(sequence))); err != nil {
+				return err
+			}
+			sent = sequence
+		}
+		if sent != sequence {
+			// Only other types of entities changed since the last event, so
+			// the client's token would eventually expire without this.
+			var none T
+			if err := stream.Send(newResponse(commonv1.ChangeType_CHANGE_TYPE_CHECKPOINT, none, h.encodeResumeToken(sequence))); err != nil {
+				return err
+			}
+			sent = sequence
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-appended:
+		}
+	}
+}
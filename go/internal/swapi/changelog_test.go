@@ -0,0 +1,174 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	commonv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1/filmv1connect"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+)
+
+func TestChangeLogSince(t *testing.T) {
+	t.Parallel()
+	// Five changes are recorded, but only the last three are retained.
+	log := newChangeLog("epoch", 3)
+	prev := &Store{}
+	for i := range 5 {
+		next := &Store{films: newEntityIndex([]*filmv1.Film{{Id: string(rune('1' + i))}})}
+		log.record(prev, next)
+		prev = next
+	}
+	// Each step deletes the previous film and creates a new one, other than
+	// the first, which only creates one.
+	if got := log.position(); got != 9 {
+		t.Fatalf("position = %d, want 9", got)
+	}
+	testCases := []struct {
+		name     string
+		sequence uint64
+		want     []uint64
+		wantErr  error
+	}{
+		{name: "latest", sequence: 9},
+		{name: "retained", sequence: 6, want: []uint64{7, 8, 9}},
+		{name: "partly retained", sequence: 7, want: []uint64{8, 9}},
+		{name: "expired", sequence: 5, wantErr: errChangesExpired},
+		{name: "from start", sequence: 0, wantErr: errChangesExpired},
+		{name: "future", sequence: 10, wantErr: errChangesExpired},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			changes, appended, err := log.since(testCase.sequence)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("since(%d) returned error %v, want %v", testCase.sequence, err, testCase.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if appended == nil {
+				t.Error("since returned a nil channel")
+			}
+			var got []uint64
+			for _, change := range changes {
+				got = append(got, change.sequence)
+			}
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("since(%d) returned sequences %v, want %v", testCase.sequence, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestDiffEntities(t *testing.T) {
+	t.Parallel()
+	kept := &filmv1.Film{Id: "1", Title: "A New Hope"}
+	prev := newEntityIndex([]*filmv1.Film{
+		kept,
+		{Id: "2", Title: "The Empire Strikes Back"},
+		{Id: "3", Title: "Return of the Jedi"},
+	})
+	next := newEntityIndex([]*filmv1.Film{
+		kept,
+		{Id: "2", Title: "The Empire Strikes Back"},
+		{Id: "3", Title: "Return of the Jedi", EpisodeNumber: 6},
+		{Id: "4", Title: "The Phantom Menace"},
+	})
+	changes := diffEntities("film", &prev, &next)
+	got := map[string]commonv1.ChangeType{}
+	for _, change := range changes {
+		if change.kind != "film" {
+			t.Errorf("change has kind %q, want film", change.kind)
+		}
+		got[entityID(change.entity)] = change.changeType
+	}
+	want := map[string]commonv1.ChangeType{
+		// Film 2 is a different but equal message, so it is unchanged.
+		"3": commonv1.ChangeType_CHANGE_TYPE_UPDATED,
+		"4": commonv1.ChangeType_CHANGE_TYPE_CREATED,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("diffEntities returned %v, want %v", got, want)
+	}
+	for id, changeType := range want {
+		if got[id] != changeType {
+			t.Errorf("film %s has change %v, want %v", id, got[id], changeType)
+		}
+	}
+	deleted := diffEntities("film", &next, &prev)
+	if len(deleted) != 2 || deleted[1].changeType != commonv1.ChangeType_CHANGE_TYPE_DELETED || entityID(deleted[1].entity) != "4" {
+		t.Errorf("diffEntities returned %v, want an update of film 3 and a delete of film 4", deleted)
+	}
+}
+
+func TestWatchFilmsCheckpoint(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(filmv1connect.NewFilmServiceHandler(handler))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := filmv1connect.NewFilmServiceClient(server.Client(), server.URL)
+	// Cancelling the context ends the streams, which must happen before the
+	// server is closed, so this cleanup runs first.
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	stream, err := client.WatchFilms(ctx, connect.NewRequest(&filmv1.WatchFilmsRequest{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A change to a person is not a change to a film, so the stream only
+	// sends a checkpoint.
+	if _, err := handler.CreatePerson(ctx, connect.NewRequest(&personv1.CreatePersonRequest{Person: &personv1.Person{Name: "Biggs Darklighter"}})); err != nil {
+		t.Fatal(err)
+	}
+	if !stream.Receive() {
+		t.Fatal(stream.Err())
+	}
+	checkpoint := stream.Msg()
+	if checkpoint.GetChangeType() != commonv1.ChangeType_CHANGE_TYPE_CHECKPOINT || checkpoint.GetFilm() != nil || checkpoint.GetResumeToken() == "" {
+		t.Fatalf("received %v, want a checkpoint", checkpoint)
+	}
+
+	// Resuming from the checkpoint skips the change to the person.
+	resumed, err := client.WatchFilms(ctx, connect.NewRequest(&filmv1.WatchFilmsRequest{ResumeToken: checkpoint.GetResumeToken()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := handler.CreateFilm(ctx, connect.NewRequest(&filmv1.CreateFilmRequest{Film: &filmv1.Film{Title: "The Holiday Special"}})); err != nil {
+		t.Fatal(err)
+	}
+	for _, stream := range []*connect.ServerStreamForClient[filmv1.WatchFilmsResponse]{stream, resumed} {
+		if !stream.Receive() {
+			t.Fatal(stream.Err())
+		}
+		if msg := stream.Msg(); msg.GetChangeType() != commonv1.ChangeType_CHANGE_TYPE_CREATED || msg.GetFilm().GetTitle() != "The Holiday Special" {
+			t.Errorf("received %v, want the created film", msg)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"sync"
+
+	"github.com/peterhellberg/swapi"
+)
+
+// Film is a film in the Star Wars API. It extends swapi.Film, which does not
+// have all of the fields that swapi.dev returns for films. The gendata
+// program also uses it to read films from swapi.dev.
+type Film struct {
+	swapi.Film
+	// ReleaseDate is the date the film was released, in the form
+	// "YYYY-MM-DD".
+	ReleaseDate string `json:"release_date"`
+}
+
+// Dataset is a complete set of Star Wars API data, in the same shape as the
+// data returned by swapi.dev.
+type Dataset struct {
+	Films     []*Film
+	People    []*swapi.Person
+	Planets   []*swapi.Planet
+	Species   []*swapi.Species
+	Starships []*swapi.Starship
+	Vehicles  []*swapi.Vehicle
+}
+
+// clone returns a copy of the dataset that also copies each entity, so
+// that the fields of the entities in one copy can be assigned without
+// affecting the other. The slices within the entities, like the URLs of
+// related entities, are still shared.
+func (d *Dataset) clone() *Dataset {
+	return &Dataset{
+		Films:     cloneEntities(d.Films),
+		People:    cloneEntities(d.People),
+		Planets:   cloneEntities(d.Planets),
+		Species:   cloneEntities(d.Species),
+		Starships: cloneEntities(d.Starships),
+		Vehicles:  cloneEntities(d.Vehicles),
+	}
+}
+
+func cloneEntities[T any](entities []*T) []*T {
+	results := make([]*T, len(entities))
+	for i, item := range entities {
+		if item == nil {
+			continue
+		}
+		clone := *item
+		results[i] = &clone
+	}
+	return results
+}
+
+// DataSource provides the data that is served by a Handler.
+type DataSource interface {
+	// Load returns the current dataset. The returned dataset must not be
+	// modified by the caller.
+	Load(ctx context.Context) (*Dataset, error)
+}
+
+// GeneratedDataSource returns a DataSource that provides the static snapshot
+// of swapi.dev data that is compiled into this package.
+func GeneratedDataSource() DataSource {
+	return generatedDataSource{}
+}
+
+type generatedDataSource struct{}
+
+func (generatedDataSource) Load(context.Context) (*Dataset, error) {
+	return &Dataset{
+		Films:     allFilms,
+		People:    allPeople,
+		Planets:   allPlanets,
+		Species:   allSpecies,
+		Starships: allStarships,
+		Vehicles:  allVehicles,
+	}, nil
+}
+
+// MemoryDataSource is a DataSource whose data is held in memory and can be
+// modified at runtime. It is safe to use from multiple goroutines.
+type MemoryDataSource struct {
+	mu      sync.RWMutex
+	dataset *Dataset
+}
+
+// NewMemoryDataSource returns a new MemoryDataSource whose initial contents
+// are a copy of the given dataset.
+func NewMemoryDataSource(dataset *Dataset) *MemoryDataSource {
+	return &MemoryDataSource{dataset: dataset.clone()}
+}
+
+// Load implements DataSource. It returns a copy of the current contents.
+func (m *MemoryDataSource) Load(context.Context) (*Dataset, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.dataset.clone(), nil
+}
+
+// Update modifies the contents of the data source. The given function is
+// called with exclusive access to the current dataset. It may add, remove,
+// or replace entities, and it may assign the fields of entities in place,
+// since Load returns copies of them. But it must not modify the contents of
+// slices within entities, such as a film's list of characters, since those
+// are shared with datasets that were previously loaded. Replace the slice
+// instead.
+//
+// A Handler does not see the changes until its Reload method is called.
+func (m *MemoryDataSource) Update(updateFn func(dataset *Dataset)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	updateFn(m.dataset)
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	"github.com/peterhellberg/swapi"
+)
+
+// dataSourceFunc is a DataSource that calls a function to load the data.
+type dataSourceFunc func(context.Context) (*Dataset, error)
+
+func (f dataSourceFunc) Load(ctx context.Context) (*Dataset, error) {
+	return f(ctx)
+}
+
+func TestReloadFailureKeepsData(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var loadErr error
+	source := dataSourceFunc(func(context.Context) (*Dataset, error) {
+		if loadErr != nil {
+			return nil, loadErr
+		}
+		return &Dataset{Films: []*Film{{Film: swapi.Film{Title: "A New Hope", URL: "https://swapi.dev/api/films/1/"}}}}, nil
+	})
+	handler, err := NewHandler(WithDataSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	version := handler.store.Load().version
+	loadErr = errors.New("films.json: unexpected end of JSON input")
+	if err := handler.Reload(ctx); !errors.Is(err, loadErr) {
+		t.Fatalf("Reload returned %v, want %v", err, loadErr)
+	}
+	if got := handler.store.Load().version; got != version {
+		t.Errorf("version = %d after a failed reload, want %d", got, version)
+	}
+	resp, err := handler.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: []string{"1"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.Msg.GetFilms()[0].GetTitle(); got != "A New Hope" {
+		t.Errorf("title = %q, want A New Hope", got)
+	}
+}
+
+func TestReloadInvalidatesPageTokens(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	source := NewMemoryDataSource(&Dataset{Films: []*Film{
+		{Film: swapi.Film{Title: "A New Hope", URL: "https://swapi.dev/api/films/1/"}},
+		{Film: swapi.Film{Title: "The Empire Strikes Back", URL: "https://swapi.dev/api/films/2/"}},
+	}})
+	handler, err := NewHandler(WithDataSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	version := handler.store.Load().version
+	first, err := handler.ListFilms(ctx, connect.NewRequest(&filmv1.ListFilmsRequest{PageSize: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Msg.GetNextPageToken() == "" {
+		t.Fatal("first page has no next_page_token")
+	}
+	source.Update(func(dataset *Dataset) {
+		dataset.Films[1].Title = "Episode V"
+	})
+	if err := handler.Reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := handler.store.Load().version; got != version+1 {
+		t.Errorf("version = %d after a reload, want %d", got, version+1)
+	}
+	_, err = handler.ListFilms(ctx, connect.NewRequest(&filmv1.ListFilmsRequest{PageToken: first.Msg.GetNextPageToken()}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ListFilms with a token from before the reload returned %v, want InvalidArgument", err)
+	}
+}
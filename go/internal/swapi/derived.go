@@ -0,0 +1,124 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"strings"
+	"unicode"
+
+	manufacturerv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// manufacturerID returns the ID of the manufacturer with the given name. It
+// is the name in lower case, with each run of other characters than letters
+// and digits replaced by a hyphen, so that variations in case and
+// punctuation, like "Incom corporation" and "Incom Corporation", refer to
+// the same manufacturer. It returns an empty string for names that are
+// unknown.
+func manufacturerID(name string) string {
+	if strings.EqualFold(strings.TrimSpace(name), "unknown") {
+		return ""
+	}
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, "-")
+}
+
+// manufacturerIDs returns the IDs of the manufacturers with the given names.
+func manufacturerIDs(names []string) []string {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id := manufacturerID(name); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return dedupe(ids)
+}
+
+// newManufacturerIndex derives the manufacturers from the starships and
+// vehicles in the given store. Each manufacturer is named by the first
+// spelling of its name that is found.
+func newManufacturerIndex(store *Store) entityIndex[*manufacturerv1.Manufacturer] {
+	var manufacturers []*manufacturerv1.Manufacturer
+	byID := map[string]*manufacturerv1.Manufacturer{}
+	add := func(names []string, created, edited *timestamppb.Timestamp) []*manufacturerv1.Manufacturer {
+		var results []*manufacturerv1.Manufacturer
+		for _, name := range names {
+			id := manufacturerID(name)
+			if id == "" {
+				continue
+			}
+			manufacturer, ok := byID[id]
+			if !ok {
+				manufacturer = &manufacturerv1.Manufacturer{Id: id, Name: strings.TrimSpace(name), Created: created, Edited: edited}
+				byID[id] = manufacturer
+				manufacturers = append(manufacturers, manufacturer)
+			}
+			if created.AsTime().Before(manufacturer.Created.AsTime()) {
+				manufacturer.Created = created
+			}
+			if edited.AsTime().After(manufacturer.Edited.AsTime()) {
+				manufacturer.Edited = edited
+			}
+			results = append(results, manufacturer)
+		}
+		return dedupe(results)
+	}
+	for _, starship := range store.starships.all {
+		for _, manufacturer := range add(starship.Manufacturers, starship.Created, starship.Edited) {
+			manufacturer.StarshipIds = append(manufacturer.StarshipIds, starship.Id)
+		}
+	}
+	for _, vehicle := range store.vehicles.all {
+		for _, manufacturer := range add(vehicle.Manufacturers, vehicle.Created, vehicle.Edited) {
+			manufacturer.VehicleIds = append(manufacturer.VehicleIds, vehicle.Id)
+		}
+	}
+	return newEntityIndex(manufacturers)
+}
+
+// coStarIDs returns the IDs of the other people who appear in any of the
+// given person's films, in the order of the films and of their characters.
+func coStarIDs(store *Store, person *personv1.Person) []string {
+	var ids []string
+	for _, filmID := range person.FilmIds {
+		film, ok := store.films.byID[filmID]
+		if !ok {
+			continue
+		}
+		for _, id := range film.CharacterIds {
+			if id != person.Id {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return dedupe(ids)
+}
+
+// nativeSpeciesIDs returns the IDs of the species whose homeworld is the
+// given planet.
+func nativeSpeciesIDs(store *Store, planet *planetv1.Planet) []string {
+	var ids []string
+	for _, species := range store.species.all {
+		if species.HomeworldId == planet.Id {
+			ids = append(ids, species.Id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,246 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	manufacturerv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	relationsv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1"
+	speciesv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	"github.com/peterhellberg/swapi"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//nolint:gochecknoglobals
+var derivedDataset = &Dataset{
+	Films: []*Film{
+		{Film: swapi.Film{Title: "A New Hope", URL: "https://swapi.dev/api/films/1/", CharacterURLs: []string{
+			"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/",
+		}}},
+		{Film: swapi.Film{Title: "The Empire Strikes Back", URL: "https://swapi.dev/api/films/2/", CharacterURLs: []string{
+			"https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/1/",
+		}}},
+	},
+	People: []*swapi.Person{
+		{Name: "Luke Skywalker", URL: "https://swapi.dev/api/people/1/", FilmURLs: []string{
+			"https://swapi.dev/api/films/1/", "https://swapi.dev/api/films/2/",
+		}},
+		{Name: "C-3PO", URL: "https://swapi.dev/api/people/2/", FilmURLs: []string{"https://swapi.dev/api/films/1/"}},
+		{Name: "R2-D2", URL: "https://swapi.dev/api/people/3/", FilmURLs: []string{
+			"https://swapi.dev/api/films/1/", "https://swapi.dev/api/films/2/",
+		}},
+		{Name: "Darth Vader", URL: "https://swapi.dev/api/people/4/", FilmURLs: []string{"https://swapi.dev/api/films/2/"}},
+		{Name: "Wedge Antilles", URL: "https://swapi.dev/api/people/18/"},
+	},
+	Planets: []*swapi.Planet{
+		{Name: "Tatooine", URL: "https://swapi.dev/api/planets/1/"},
+		{Name: "Alderaan", URL: "https://swapi.dev/api/planets/2/"},
+		{Name: "Yavin IV", URL: "https://swapi.dev/api/planets/3/"},
+	},
+	Species: []*swapi.Species{
+		{Name: "Human", URL: "https://swapi.dev/api/species/1/", Homeworld: "https://swapi.dev/api/planets/2/", PeopleURLs: []string{
+			"https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/1/",
+		}},
+		{Name: "Droid", URL: "https://swapi.dev/api/species/2/"},
+		{Name: "Tusken Raider", URL: "https://swapi.dev/api/species/3/", Homeworld: "https://swapi.dev/api/planets/1/"},
+		{Name: "Jawa", URL: "https://swapi.dev/api/species/4/", Homeworld: "https://swapi.dev/api/planets/1/"},
+	},
+	Starships: []*swapi.Starship{
+		{
+			Name: "Death Star", URL: "https://swapi.dev/api/starships/9/",
+			Manufacturer: "Imperial Department of Military Research, Sienar Fleet Systems",
+			Created:      "2014-12-10T16:36:50.509000Z", Edited: "2014-12-20T21:26:24.783000Z",
+		},
+		{
+			Name: "X-wing", URL: "https://swapi.dev/api/starships/12/",
+			Manufacturer: "Incom Corporation",
+			Created:      "2014-12-12T11:19:05.340000Z", Edited: "2014-12-20T21:23:49.886000Z",
+		},
+	},
+	Vehicles: []*swapi.Vehicle{
+		{
+			Name: "T-16 skyhopper", URL: "https://swapi.dev/api/vehicles/14/",
+			Manufacturer: "Incom corporation",
+			Created:      "2014-12-10T15:38:25.937000Z", Edited: "2014-12-20T21:30:21.668000Z",
+		},
+		{Name: "Sand Crawler", URL: "https://swapi.dev/api/vehicles/4/", Manufacturer: "unknown"},
+	},
+}
+
+func newDerivedTestStore(t *testing.T) *Store {
+	t.Helper()
+	handler, err := NewHandler(WithDataSource(NewMemoryDataSource(derivedDataset)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return handler.store.Load()
+}
+
+func TestManufacturerID(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name string
+		want string
+	}{
+		{name: "Incom Corporation", want: "incom-corporation"},
+		{name: "Incom corporation", want: "incom-corporation"},
+		{name: " Kuat Drive Yards ", want: "kuat-drive-yards"},
+		{name: "Hoersch-Kessel Drive, Inc.", want: "hoersch-kessel-drive-inc"},
+		{name: "Cygnus Spaceworks / Sienar", want: "cygnus-spaceworks-sienar"},
+		{name: "Theed Palace Space Vessel Engineering Corps/Nubia Star Drives", want: "theed-palace-space-vessel-engineering-corps-nubia-star-drives"},
+		{name: "Zaltin and Bakura", want: "zaltin-and-bakura"},
+		{name: "Unknown", want: ""},
+		{name: " unknown ", want: ""},
+		{name: "", want: ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			if got := manufacturerID(testCase.name); got != testCase.want {
+				t.Errorf("manufacturerID(%q) = %q, want %q", testCase.name, got, testCase.want)
+			}
+		})
+	}
+	got := manufacturerIDs([]string{"Incom Corporation", "unknown", "Incom corporation", "Sienar Fleet Systems"})
+	if want := []string{"incom-corporation", "sienar-fleet-systems"}; !slices.Equal(got, want) {
+		t.Errorf("manufacturerIDs returned %v, want %v", got, want)
+	}
+}
+
+func TestNewManufacturerIndex(t *testing.T) {
+	t.Parallel()
+	store := newDerivedTestStore(t)
+	timestamp := func(value string) *timestamppb.Timestamp {
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return timestamppb.New(parsed)
+	}
+	deathStar, xWing, skyhopper := store.starships.byID["9"], store.starships.byID["12"], store.vehicles.byID["14"]
+	// Manufacturers are ordered by where they are first found. Incom is
+	// named by the first spelling of its name, and it spans the earliest
+	// created and latest edited of its starships and vehicles.
+	want := []*manufacturerv1.Manufacturer{
+		{
+			Id: "imperial-department-of-military-research", Name: "Imperial Department of Military Research",
+			StarshipIds: []string{"9"}, Created: deathStar.Created, Edited: deathStar.Edited,
+		},
+		{
+			Id: "sienar-fleet-systems", Name: "Sienar Fleet Systems",
+			StarshipIds: []string{"9"}, Created: deathStar.Created, Edited: deathStar.Edited,
+		},
+		{
+			Id: "incom-corporation", Name: "Incom Corporation",
+			StarshipIds: []string{"12"}, VehicleIds: []string{"14"},
+			Created: timestamp("2014-12-10T15:38:25.937Z"), Edited: timestamp("2014-12-20T21:30:21.668Z"),
+		},
+	}
+	if !proto.Equal(skyhopper.Created, want[2].Created) || !proto.Equal(xWing.Edited, timestamp("2014-12-20T21:23:49.886Z")) {
+		t.Fatalf("unexpected timestamps for skyhopper %v and X-wing %v", skyhopper.Created, xWing.Edited)
+	}
+	got := store.manufacturerIndex().all
+	if !slices.EqualFunc(got, want, func(a, b *manufacturerv1.Manufacturer) bool { return proto.Equal(a, b) }) {
+		t.Errorf("manufacturers = %v, want %v", got, want)
+	}
+}
+
+func TestCoStarIDs(t *testing.T) {
+	t.Parallel()
+	store := newDerivedTestStore(t)
+	testCases := []struct {
+		id   string
+		want []string
+	}{
+		// In the order of the films and of their characters, without
+		// duplicates or the person themselves.
+		{id: "1", want: []string{"2", "3", "4"}},
+		{id: "2", want: []string{"1", "3"}},
+		{id: "4", want: []string{"3", "1"}},
+		{id: "18"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+			if got := coStarIDs(store, store.people.byID[testCase.id]); !slices.Equal(got, testCase.want) {
+				t.Errorf("coStarIDs(%s) = %v, want %v", testCase.id, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestNativeSpeciesIDs(t *testing.T) {
+	t.Parallel()
+	store := newDerivedTestStore(t)
+	testCases := []struct {
+		id   string
+		want []string
+	}{
+		{id: "1", want: []string{"3", "4"}},
+		{id: "2", want: []string{"1"}},
+		{id: "3"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.id, func(t *testing.T) {
+			t.Parallel()
+			if got := nativeSpeciesIDs(store, store.planets.byID[testCase.id]); !slices.Equal(got, testCase.want) {
+				t.Errorf("nativeSpeciesIDs(%s) = %v, want %v", testCase.id, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestGetSpeciesPeople(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler(WithDataSource(NewMemoryDataSource(derivedDataset)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	species, err := handler.GetSpecies(ctx, connect.NewRequest(&speciesv1.GetSpeciesRequest{Ids: []string{"1", "2"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &relationsv1.GetSpeciesRelationsRequest{Bases: species.Msg.GetSpecies(), Limit: 1}
+	people, err := handler.GetSpeciesPeople(ctx, connect.NewRequest(req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The deprecated characters relation returns the same people.
+	characters, err := handler.GetSpeciesCharacters(ctx, connect.NewRequest(req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, value := range people.Msg.GetValues() {
+		character := characters.Msg.GetValues()[i]
+		if !slices.EqualFunc(value.GetPeople(), character.GetCharacters(), func(a, b *personv1.Person) bool { return proto.Equal(a, b) }) ||
+			(value.GetNextPageToken() == "") != (character.GetNextPageToken() == "") {
+			t.Errorf("people of species %s = %v, but characters = %v", species.Msg.GetSpecies()[i].GetId(), value, character)
+		}
+	}
+	if got := people.Msg.GetValues()[0]; len(got.GetPeople()) != 1 || got.GetPeople()[0].GetId() != "4" || got.GetNextPageToken() == "" {
+		t.Errorf("people of species 1 = %v, want person 4 and a next page token", got)
+	}
+	if got := people.Msg.GetValues()[1]; len(got.GetPeople()) != 0 || got.GetNextPageToken() != "" {
+		t.Errorf("people of species 2 = %v, want none", got)
+	}
+}
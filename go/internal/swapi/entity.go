@@ -0,0 +1,77 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"fmt"
+
+	entityv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/entity/v1"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	speciesv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	starshipv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	vehiclev1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// entityTypeNames are the names of all of the types of entities, in the
+// order of their EntityType values.
+//
+//nolint:gochecknoglobals
+var entityTypeNames = []string{
+	filmType.name,
+	personType.name,
+	planetType.name,
+	speciesType.name,
+	starshipType.name,
+	vehicleType.name,
+}
+
+// entityTypeName returns the name of the given type of entity, or an
+// InvalidArgument error if it is not a valid type.
+func entityTypeName(entityType entityv1.EntityType) (string, error) {
+	if entityType <= entityv1.EntityType_ENTITY_TYPE_UNSPECIFIED || int(entityType) > len(entityTypeNames) {
+		return "", fmt.Errorf("invalid entity type: %v", entityType)
+	}
+	return entityTypeNames[entityType-1], nil
+}
+
+// entityDescriptor returns the message descriptor of the given type of
+// entity. The fields of the Entity oneof are named after the types.
+func entityDescriptor(kind string) protoreflect.MessageDescriptor {
+	return (&entityv1.Entity{}).ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(kind)).Message()
+}
+
+// wrapEntity returns an Entity whose oneof holds the given message.
+func wrapEntity(msg proto.Message) *entityv1.Entity {
+	switch msg := msg.(type) {
+	case *filmv1.Film:
+		return &entityv1.Entity{Entity: &entityv1.Entity_Film{Film: msg}}
+	case *personv1.Person:
+		return &entityv1.Entity{Entity: &entityv1.Entity_Person{Person: msg}}
+	case *planetv1.Planet:
+		return &entityv1.Entity{Entity: &entityv1.Entity_Planet{Planet: msg}}
+	case *speciesv1.Species:
+		return &entityv1.Entity{Entity: &entityv1.Entity_Species{Species: msg}}
+	case *starshipv1.Starship:
+		return &entityv1.Entity{Entity: &entityv1.Entity_Starship{Starship: msg}}
+	case *vehiclev1.Vehicle:
+		return &entityv1.Entity{Entity: &entityv1.Entity_Vehicle{Vehicle: msg}}
+	default:
+		panic(fmt.Sprintf("unknown entity message %T", msg)) //nolint:forbidigo // indicates a bug
+	}
+}
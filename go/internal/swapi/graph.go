@@ -0,0 +1,191 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	graphv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/graph/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// defaultPathDepth is the maximum length of the paths found by FindPath
+	// if the request does not specify one.
+	defaultPathDepth = 6
+	// maxPathDepth is the largest max_depth that FindPath accepts.
+	maxPathDepth = 20
+)
+
+// edgeKinds maps each relation, other than the inverse relations, to its
+// kind of edge in the entity graph.
+//
+//nolint:gochecknoglobals
+var edgeKinds = map[relationKey]graphv1.EdgeKind{
+	{"film", "character_ids"}:   graphv1.EdgeKind_EDGE_KIND_FILM_CHARACTERS,
+	{"film", "planet_ids"}:      graphv1.EdgeKind_EDGE_KIND_FILM_PLANETS,
+	{"film", "species_ids"}:     graphv1.EdgeKind_EDGE_KIND_FILM_SPECIES,
+	{"film", "starship_ids"}:    graphv1.EdgeKind_EDGE_KIND_FILM_STARSHIPS,
+	{"film", "vehicle_ids"}:     graphv1.EdgeKind_EDGE_KIND_FILM_VEHICLES,
+	{"person", "homeworld_id"}:  graphv1.EdgeKind_EDGE_KIND_PERSON_HOMEWORLD,
+	{"person", "species_ids"}:   graphv1.EdgeKind_EDGE_KIND_PERSON_SPECIES,
+	{"person", "starship_ids"}:  graphv1.EdgeKind_EDGE_KIND_PERSON_STARSHIPS,
+	{"person", "vehicle_ids"}:   graphv1.EdgeKind_EDGE_KIND_PERSON_VEHICLES,
+	{"species", "homeworld_id"}: graphv1.EdgeKind_EDGE_KIND_SPECIES_HOMEWORLD,
+}
+
+type relationKey struct {
+	from  string
+	field protoreflect.Name
+}
+
+// graphNode identifies an entity in the entity graph.
+type graphNode struct {
+	kind string
+	id   string
+}
+
+// graphEdge is an edge from one entity in the graph to another.
+type graphEdge struct {
+	to   graphNode
+	kind graphv1.EdgeKind
+	// field is the field of the entity that the edge is from that refers to
+	// the other entity, or empty if there is no such field.
+	field protoreflect.Name
+	// inverse is the field of the other entity that refers back, or empty
+	// if there is no such field.
+	inverse protoreflect.Name
+}
+
+// entityGraph is the undirected graph of entities that are connected by
+// their relations.
+type entityGraph struct {
+	edges map[graphNode][]graphEdge
+}
+
+func newEntityGraph(store *Store) *entityGraph {
+	graph := &entityGraph{edges: map[graphNode][]graphEdge{}}
+	for _, rel := range relations {
+		kind, ok := edgeKinds[relationKey{rel.from, rel.field}]
+		if !ok {
+			// This is an inverse relation, whose edges are added along
+			// with those of the relation that it is the inverse of.
+			continue
+		}
+		targets := store.indexByName(rel.to)
+		for _, msg := range store.indexByName(rel.from).messages() {
+			from := graphNode{kind: rel.from, id: msg.(entity).GetId()} //nolint:forcetypeassert,errcheck
+			for _, id := range refIDs(msg.ProtoReflect(), rel.field) {
+				if _, ok := targets.getMessage(id); !ok {
+					// Data that is not validated, like that of a
+					// MemoryDataSource, may refer to entities that do
+					// not exist. Paths must not go through them.
+					continue
+				}
+				to := graphNode{kind: rel.to, id: id}
+				graph.edges[from] = append(graph.edges[from], graphEdge{to: to, kind: kind, field: rel.field, inverse: rel.inverse})
+				graph.edges[to] = append(graph.edges[to], graphEdge{to: from, kind: kind, field: rel.inverse, inverse: rel.field})
+			}
+		}
+	}
+	return graph
+}
+
+// graphStep records how a node was reached during a search of the graph.
+type graphStep struct {
+	prev graphNode
+	edge graphEdge
+}
+
+// findPath finds a shortest path from one node to another that has at most
+// maxDepth edges, using only the edges whose kind is allowed. It runs a
+// breadth-first search from both ends, expanding the smaller frontier at
+// each step, until the searches meet. It returns the nodes on the path and
+// the edges between them, or nil if there is no such path.
+func (g *entityGraph) findPath(from, to graphNode, maxDepth int, allowed func(graphv1.EdgeKind) bool) ([]graphNode, []*graphv1.Edge) {
+	if from == to {
+		return []graphNode{from}, nil
+	}
+	forward := map[graphNode]graphStep{from: {}}
+	backward := map[graphNode]graphStep{to: {}}
+	forwardFrontier, backwardFrontier := []graphNode{from}, []graphNode{to}
+	for depth := 0; depth < maxDepth && len(forwardFrontier) > 0 && len(backwardFrontier) > 0; depth++ {
+		frontier, visited, other := &forwardFrontier, forward, backward
+		if len(backwardFrontier) < len(forwardFrontier) {
+			frontier, visited, other = &backwardFrontier, backward, forward
+		}
+		var next []graphNode
+		for _, node := range *frontier {
+			for _, edge := range g.edges[node] {
+				if _, ok := visited[edge.to]; ok || !allowed(edge.kind) {
+					continue
+				}
+				visited[edge.to] = graphStep{prev: node, edge: edge}
+				if _, ok := other[edge.to]; ok {
+					// Every meeting point found at this depth is on a path
+					// of the same length, so the first one is used.
+					return joinPaths(edge.to, from, to, forward, backward)
+				}
+				next = append(next, edge.to)
+			}
+		}
+		*frontier = next
+	}
+	return nil, nil
+}
+
+// joinPaths returns the path through the given meeting point of the forward
+// and backward searches of findPath.
+func joinPaths(meet, from, to graphNode, forward, backward map[graphNode]graphStep) ([]graphNode, []*graphv1.Edge) {
+	nodes := []graphNode{meet}
+	var edges []*graphv1.Edge
+	for node := meet; node != from; {
+		step := forward[node]
+		nodes = append(nodes, step.prev)
+		edges = append(edges, &graphv1.Edge{Kind: step.edge.kind, Field: string(step.edge.field)})
+		node = step.prev
+	}
+	slices.Reverse(nodes)
+	slices.Reverse(edges)
+	for node := meet; node != to; {
+		step := backward[node]
+		nodes = append(nodes, step.prev)
+		// The step's edge leads from step.prev to node, so its inverse is
+		// the edge from node to step.prev.
+		edges = append(edges, &graphv1.Edge{Kind: step.edge.kind, Field: string(step.edge.inverse)})
+		node = step.prev
+	}
+	return nodes, edges
+}
+
+// graphNodeFor returns the graph node for the given reference to an entity,
+// which is the named parameter of a request. It returns an error if the
+// reference is invalid or the entity does not exist.
+func graphNodeFor(store *Store, param string, ref *graphv1.EntityRef) (graphNode, error) {
+	if ref == nil {
+		return graphNode{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is required", param))
+	}
+	kind, err := entityTypeName(ref.Type)
+	if err != nil {
+		return graphNode{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s: %w", param, err))
+	}
+	if _, ok := store.indexByName(kind).getMessage(ref.Id); !ok {
+		return graphNode{}, connect.NewError(connect.CodeNotFound, fmt.Errorf("%s refers to unknown %s %q", param, kind, ref.Id))
+	}
+	return graphNode{kind: kind, id: ref.Id}, nil
+}
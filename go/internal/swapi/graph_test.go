@@ -0,0 +1,162 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	entityv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/entity/v1"
+	graphv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/graph/v1"
+	"github.com/peterhellberg/swapi"
+)
+
+func TestFindPath(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	person := func(id string) *graphv1.EntityRef {
+		return &graphv1.EntityRef{Type: entityv1.EntityType_ENTITY_TYPE_PERSON, Id: id}
+	}
+	planet := func(id string) *graphv1.EntityRef {
+		return &graphv1.EntityRef{Type: entityv1.EntityType_ENTITY_TYPE_PLANET, Id: id}
+	}
+	testCases := []struct {
+		name      string
+		req       *graphv1.FindPathRequest
+		wantNodes []string
+		wantEdges []string
+		wantCode  connect.Code
+	}{
+		{
+			name:      "same entity",
+			req:       &graphv1.FindPathRequest{From: person("1"), To: person("1")},
+			wantNodes: []string{"person/1"},
+		},
+		{
+			// Luke Skywalker and Owen Lars share Tatooine as their homeworld.
+			name: "shared homeworld",
+			req: &graphv1.FindPathRequest{
+				From: person("1"), To: person("6"),
+				AllowedEdgeKinds: []graphv1.EdgeKind{graphv1.EdgeKind_EDGE_KIND_PERSON_HOMEWORLD},
+			},
+			wantNodes: []string{"person/1", "planet/1", "person/6"},
+			wantEdges: []string{"homeworld_id", "resident_ids"},
+		},
+		{
+			// Tatooine and Alderaan are both in "A New Hope".
+			name:      "shared film",
+			req:       &graphv1.FindPathRequest{From: planet("1"), To: planet("2")},
+			wantNodes: []string{"planet/1", "film/1", "planet/2"},
+			wantEdges: []string{"film_ids", "planet_ids"},
+		},
+		{
+			name: "edge kinds",
+			req: &graphv1.FindPathRequest{
+				From: person("1"), To: person("6"),
+				AllowedEdgeKinds: []graphv1.EdgeKind{graphv1.EdgeKind_EDGE_KIND_FILM_CHARACTERS},
+			},
+			wantNodes: []string{"person/1", "film/1", "person/6"},
+			wantEdges: []string{"film_ids", "character_ids"},
+		},
+		{
+			name: "too shallow",
+			req: &graphv1.FindPathRequest{
+				From: person("1"), To: person("6"), MaxDepth: 1,
+			},
+		},
+		{
+			name:     "unknown entity",
+			req:      &graphv1.FindPathRequest{From: person("1"), To: person("1000")},
+			wantCode: connect.CodeNotFound,
+		},
+		{
+			name:     "depth too large",
+			req:      &graphv1.FindPathRequest{From: person("1"), To: person("6"), MaxDepth: maxPathDepth + 1},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "depth out of int32 range",
+			req:      &graphv1.FindPathRequest{From: person("1"), To: person("6"), MaxDepth: math.MaxUint32},
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			resp, err := handler.FindPath(context.Background(), connect.NewRequest(testCase.req))
+			if testCase.wantCode != 0 {
+				if connect.CodeOf(err) != testCase.wantCode {
+					t.Fatalf("FindPath returned %v, want code %v", err, testCase.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			nodes, edges := describePath(resp.Msg)
+			if !slices.Equal(nodes, testCase.wantNodes) || !slices.Equal(edges, testCase.wantEdges) {
+				t.Errorf("path = %v via %v, want %v via %v", nodes, edges, testCase.wantNodes, testCase.wantEdges)
+			}
+		})
+	}
+}
+
+func TestFindPathSkipsMissingEntities(t *testing.T) {
+	t.Parallel()
+	// Both people refer to a planet that does not exist. A MemoryDataSource
+	// does not validate references, so the graph must not connect them
+	// through it.
+	source := NewMemoryDataSource(&Dataset{
+		People: []*swapi.Person{
+			{Name: "Luke", URL: "https://swapi.dev/api/people/1/", Homeworld: "https://swapi.dev/api/planets/99/"},
+			{Name: "Leia", URL: "https://swapi.dev/api/people/2/", Homeworld: "https://swapi.dev/api/planets/99/"},
+		},
+	})
+	handler, err := NewHandler(WithDataSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := handler.FindPath(context.Background(), connect.NewRequest(&graphv1.FindPathRequest{
+		From: &graphv1.EntityRef{Type: entityv1.EntityType_ENTITY_TYPE_PERSON, Id: "1"},
+		To:   &graphv1.EntityRef{Type: entityv1.EntityType_ENTITY_TYPE_PERSON, Id: "2"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodes, _ := describePath(resp.Msg); len(nodes) != 0 {
+		t.Errorf("path = %v, want none", nodes)
+	}
+}
+
+// describePath returns the "kind/id" of each entity on the path, and the
+// field of each edge.
+func describePath(resp *graphv1.FindPathResponse) ([]string, []string) {
+	var nodes, edges []string
+	for _, item := range resp.GetEntities() {
+		msg := item.ProtoReflect()
+		field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("entity"))
+		nodes = append(nodes, string(field.Name())+"/"+msg.Get(field).Message().Interface().(entity).GetId()) //nolint:forcetypeassert,errcheck
+	}
+	for _, edge := range resp.GetEdges() {
+		edges = append(edges, edge.GetField())
+	}
+	return nodes, edges
+}
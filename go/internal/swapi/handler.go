@@ -20,36 +20,55 @@ package swapi
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"fmt"
-	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/film/v1/filmv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/person/v1/personv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/planet/v1/planetv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/relations/v1/relationsv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/species/v1/speciesv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/starship/v1/starshipv1connect"
-	"buf.build/gen/go/bufbuild/knit-demo/connectrpc/go/buf/knit/demo/swapi/vehicle/v1/vehiclev1connect"
-	filmv1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/film/v1"
-	personv1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/person/v1"
-	planetv1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/planet/v1"
-	relationsv1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/relations/v1"
-	speciesv1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/species/v1"
-	starshipv1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/starship/v1"
-	vehiclev1 "buf.build/gen/go/bufbuild/knit-demo/protocolbuffers/go/buf/knit/demo/swapi/vehicle/v1"
 	"connectrpc.com/connect"
+	commonv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	entityv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/entity/v1"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1/filmv1connect"
+	graphv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/graph/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/graph/v1/graphv1connect"
+	manufacturerv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1/manufacturerv1connect"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1/personv1connect"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1/planetv1connect"
+	relationsv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1/relationsv1connect"
+	searchv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/search/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/search/v1/searchv1connect"
+	speciesv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1/speciesv1connect"
+	starshipv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1/starshipv1connect"
+	statsv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/stats/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/stats/v1/statsv1connect"
+	vehiclev1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1/vehiclev1connect"
+	"github.com/bufbuild/knit-demo/go/internal/filter"
+	"github.com/bufbuild/knit-demo/go/internal/orderby"
 	"github.com/peterhellberg/swapi"
+	"google.golang.org/genproto/googleapis/type/date"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Handler implements the Star Wars API.
 //
 // For performance, it uses an in-memory snapshot of the data (e.g. instead
-// of sending queries to swapi.dev). Run "go generate" for this package to
-// re-generate the snapshot.
+// of sending queries to swapi.dev). By default, the snapshot is the one that
+// is compiled into this package. Run "go generate" for this package to
+// re-generate it. Use WithDataSource to serve other data.
 type Handler struct {
 	filmv1connect.UnimplementedFilmServiceHandler
 	personv1connect.UnimplementedPersonServiceHandler
@@ -63,158 +82,713 @@ type Handler struct {
 	relationsv1connect.UnimplementedSpeciesResolverServiceHandler
 	relationsv1connect.UnimplementedStarshipResolverServiceHandler
 	relationsv1connect.UnimplementedVehicleResolverServiceHandler
+	relationsv1connect.UnimplementedManufacturerResolverServiceHandler
+	manufacturerv1connect.UnimplementedManufacturerServiceHandler
+	searchv1connect.UnimplementedSearchServiceHandler
+	graphv1connect.UnimplementedGraphServiceHandler
+	statsv1connect.UnimplementedStatsServiceHandler
+
+	dataSource DataSource
+	// pageTokenKey is the secret key used to authenticate page tokens.
+	pageTokenKey []byte
+	// recordFanOut, if not nil, is called with the fan-out of every call
+	// to a relation resolver.
+	recordFanOut func(FanOutStats)
+	// changes records the changes to the store for Watch RPCs.
+	changes *changeLog
+	// writeMu serializes changes to the store, from both reloads and
+	// mutation RPCs.
+	writeMu sync.Mutex
+	store   atomic.Pointer[Store]
+}
+
+// HandlerOption is an option that configures a Handler.
+type HandlerOption func(*handlerOptions)
+
+type handlerOptions struct {
+	dataSource         DataSource
+	pageTokenKey       []byte
+	recordFanOut       func(FanOutStats)
+	changeLogRetention int
+}
+
+// WithDataSource configures the source of the data that the handler serves.
+// If not specified, the handler uses GeneratedDataSource.
+func WithDataSource(source DataSource) HandlerOption {
+	return func(opts *handlerOptions) {
+		opts.dataSource = source
+	}
+}
+
+// WithPageTokenKey configures the secret key that is used to authenticate
+// the page tokens returned by List RPCs. If not specified, a random key is
+// generated, so page tokens are only valid for the Handler that returned
+// them. Servers that share load should all use the same key.
+func WithPageTokenKey(key []byte) HandlerOption {
+	return func(opts *handlerOptions) {
+		opts.pageTokenKey = key
+	}
+}
+
+// WithFanOutRecorder configures a function that is called with the fan-out
+// of every call to a relation resolver RPC. This is meant for debugging and
+// for tests that verify how well related entities are batched.
+func WithFanOutRecorder(record func(FanOutStats)) HandlerOption {
+	return func(opts *handlerOptions) {
+		opts.recordFanOut = record
+	}
+}
+
+// WithChangeLogRetention configures the number of recent changes that are
+// retained so that Watch RPCs can resume after them. If not specified, the
+// most recent 1000 changes are retained.
+func WithChangeLogRetention(retention int) HandlerOption {
+	return func(opts *handlerOptions) {
+		opts.changeLogRetention = retention
+	}
+}
+
+// NewHandler returns a new handler that serves the Star Wars API. It returns
+// an error if the data cannot be loaded from the configured DataSource.
+func NewHandler(opts ...HandlerOption) (*Handler, error) {
+	options := handlerOptions{dataSource: GeneratedDataSource(), changeLogRetention: defaultChangeLogRetention}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if len(options.pageTokenKey) == 0 {
+		options.pageTokenKey = make([]byte, 32)
+		if _, err := rand.Read(options.pageTokenKey); err != nil {
+			return nil, fmt.Errorf("failed to generate page token key: %w", err)
+		}
+	}
+	if options.changeLogRetention <= 0 {
+		return nil, errors.New("change log retention must be positive")
+	}
+	epoch := make([]byte, 8)
+	if _, err := rand.Read(epoch); err != nil {
+		return nil, fmt.Errorf("failed to generate change log epoch: %w", err)
+	}
+	h := &Handler{
+		dataSource:   options.dataSource,
+		pageTokenKey: options.pageTokenKey,
+		recordFanOut: options.recordFanOut,
+		changes:      newChangeLog(base64.RawURLEncoding.EncodeToString(epoch), options.changeLogRetention),
+	}
+	if err := h.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// Reload loads the data from the handler's DataSource and atomically swaps
+// it in as the data that the handler serves. If the data cannot be loaded,
+// an error is returned and the handler continues to serve the previous data.
+// Any changes made via mutation RPCs are discarded by a successful reload.
+//
+// RPCs that are already in progress continue to see the data that was being
+// served when they started.
+func (h *Handler) Reload(ctx context.Context) error {
+	h.writeMu.Lock()
+	defer h.writeMu.Unlock()
+	dataset, err := h.dataSource.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load data: %w", err)
+	}
+	store := newStore(dataset)
+	prev := h.store.Load()
+	if prev != nil {
+		store.version = prev.version + 1
+	}
+	h.store.Store(store)
+	h.changes.record(prev, store)
+	return nil
+}
+
+// update applies a change to a copy of the current store. If the change
+// is successful, the copy atomically replaces the current store.
+func (h *Handler) update(changeFn func(store *Store) error) error {
+	h.writeMu.Lock()
+	defer h.writeMu.Unlock()
+	prev := h.store.Load()
+	store := prev.clone()
+	if err := changeFn(store); err != nil {
+		return err
+	}
+	store.version++
+	h.store.Store(store)
+	h.changes.record(prev, store)
+	return nil
 }
 
-// NewHandler returns a new handler that serves the Star Wars API.
-func NewHandler() *Handler {
-	return &Handler{}
+type storeContextKey struct{}
+
+// snapshot returns the store that should be used to handle an RPC, along
+// with a context that refers to it. When one RPC is invoked on behalf of
+// another, like when a resolver calls a Get method, it uses the same store as
+// the outer RPC. So all data used for a single call is consistent, even if it
+// is concurrently reloaded.
+func (h *Handler) snapshot(ctx context.Context) (context.Context, *Store) {
+	if store, ok := ctx.Value(storeContextKey{}).(*Store); ok {
+		return ctx, store
+	}
+	store := h.store.Load()
+	return context.WithValue(ctx, storeContextKey{}, store), store
 }
 
 // GetFilms implements the GetFilms RPC of the FilmService.
-func (h *Handler) GetFilms(_ context.Context, req *connect.Request[filmv1.GetFilmsRequest]) (*connect.Response[filmv1.GetFilmsResponse], error) {
-	films, err := getAll(req.Msg.Ids, allFilms)
+func (h *Handler) GetFilms(ctx context.Context, req *connect.Request[filmv1.GetFilmsRequest]) (*connect.Response[filmv1.GetFilmsResponse], error) {
+	_, store := h.snapshot(ctx)
+	films, errs, err := getAll(req.Msg.Ids, &store.films, req.Msg.AllowMissing)
 	if err != nil {
 		return nil, err
 	}
+	if films, err = applyReadMask(films, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&filmv1.GetFilmsResponse{
-		Films: transform(films, transformFilm),
+		Films:  films,
+		Errors: errs,
 	}), nil
 }
 
 // ListFilms implements the ListFilms RPC of the FilmService.
-func (h *Handler) ListFilms(_ context.Context, req *connect.Request[filmv1.ListFilmsRequest]) (*connect.Response[filmv1.ListFilmsResponse], error) {
-	films, nextPageToken := paginate(allFilms, int(req.Msg.PageSize), req.Msg.PageToken)
+func (h *Handler) ListFilms(ctx context.Context, req *connect.Request[filmv1.ListFilmsRequest]) (*connect.Response[filmv1.ListFilmsResponse], error) {
+	_, store := h.snapshot(ctx)
+	films, nextPageToken, err := listEntities(h, store, filmType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if films, err = applyReadMask(films, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(
 		&filmv1.ListFilmsResponse{
-			Films:         transform(films, transformFilm),
+			Films:         films,
 			NextPageToken: nextPageToken,
 		},
 	), nil
 }
 
+// StreamFilms implements the StreamFilms RPC of the FilmService.
+func (h *Handler) StreamFilms(ctx context.Context, req *connect.Request[filmv1.StreamFilmsRequest], stream *connect.ServerStream[filmv1.StreamFilmsResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, filmType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*filmv1.Film) *filmv1.StreamFilmsResponse {
+			return &filmv1.StreamFilmsResponse{Films: chunk}
+		})
+}
+
+// CreateFilm implements the CreateFilm RPC of the FilmService.
+func (h *Handler) CreateFilm(_ context.Context, req *connect.Request[filmv1.CreateFilmRequest]) (*connect.Response[filmv1.CreateFilmResponse], error) {
+	film, err := createEntity(h, filmType, req.Msg.Film)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&filmv1.CreateFilmResponse{Film: film}), nil
+}
+
+// UpdateFilm implements the UpdateFilm RPC of the FilmService.
+func (h *Handler) UpdateFilm(_ context.Context, req *connect.Request[filmv1.UpdateFilmRequest]) (*connect.Response[filmv1.UpdateFilmResponse], error) {
+	film, err := updateEntity(h, filmType, req.Msg.Film, req.Msg.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&filmv1.UpdateFilmResponse{Film: film}), nil
+}
+
+// DeleteFilm implements the DeleteFilm RPC of the FilmService.
+func (h *Handler) DeleteFilm(_ context.Context, req *connect.Request[filmv1.DeleteFilmRequest]) (*connect.Response[filmv1.DeleteFilmResponse], error) {
+	if err := deleteEntity(h, filmType, req.Msg.Id, req.Msg.Edited, req.Msg.Cascade); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&filmv1.DeleteFilmResponse{}), nil
+}
+
+// WatchFilms implements the WatchFilms RPC of the FilmService.
+func (h *Handler) WatchFilms(ctx context.Context, req *connect.Request[filmv1.WatchFilmsRequest], stream *connect.ServerStream[filmv1.WatchFilmsResponse]) error {
+	return watchEntities(ctx, h, filmType, req.Msg.ResumeToken, req.Msg.InitialSnapshot, stream,
+		func(changeType commonv1.ChangeType, item *filmv1.Film, resumeToken string) *filmv1.WatchFilmsResponse {
+			return &filmv1.WatchFilmsResponse{ChangeType: changeType, Film: item, ResumeToken: resumeToken}
+		})
+}
+
 // GetPeople implements the GetPeople RPC of the PersonService.
-func (h *Handler) GetPeople(_ context.Context, req *connect.Request[personv1.GetPeopleRequest]) (*connect.Response[personv1.GetPeopleResponse], error) {
-	people, err := getAll(req.Msg.Ids, allPeople)
+func (h *Handler) GetPeople(ctx context.Context, req *connect.Request[personv1.GetPeopleRequest]) (*connect.Response[personv1.GetPeopleResponse], error) {
+	_, store := h.snapshot(ctx)
+	people, errs, err := getAll(req.Msg.Ids, &store.people, req.Msg.AllowMissing)
 	if err != nil {
 		return nil, err
 	}
+	if people, err = applyReadMask(people, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&personv1.GetPeopleResponse{
-		People: transform(people, transformPerson),
+		People: people,
+		Errors: errs,
 	}), nil
 }
 
 // ListPeople implements the ListPeople RPC of the PersonService.
-func (h *Handler) ListPeople(_ context.Context, req *connect.Request[personv1.ListPeopleRequest]) (*connect.Response[personv1.ListPeopleResponse], error) {
-	people, nextPageToken := paginate(allPeople, int(req.Msg.PageSize), req.Msg.PageToken)
+func (h *Handler) ListPeople(ctx context.Context, req *connect.Request[personv1.ListPeopleRequest]) (*connect.Response[personv1.ListPeopleResponse], error) {
+	_, store := h.snapshot(ctx)
+	people, nextPageToken, err := listEntities(h, store, personType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if people, err = applyReadMask(people, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(
 		&personv1.ListPeopleResponse{
-			People:        transform(people, transformPerson),
+			People:        people,
 			NextPageToken: nextPageToken,
 		},
 	), nil
 }
 
+// StreamPeople implements the StreamPeople RPC of the PersonService.
+func (h *Handler) StreamPeople(ctx context.Context, req *connect.Request[personv1.StreamPeopleRequest], stream *connect.ServerStream[personv1.StreamPeopleResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, personType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*personv1.Person) *personv1.StreamPeopleResponse {
+			return &personv1.StreamPeopleResponse{People: chunk}
+		})
+}
+
+// CreatePerson implements the CreatePerson RPC of the PersonService.
+func (h *Handler) CreatePerson(_ context.Context, req *connect.Request[personv1.CreatePersonRequest]) (*connect.Response[personv1.CreatePersonResponse], error) {
+	person, err := createEntity(h, personType, req.Msg.Person)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&personv1.CreatePersonResponse{Person: person}), nil
+}
+
+// UpdatePerson implements the UpdatePerson RPC of the PersonService.
+func (h *Handler) UpdatePerson(_ context.Context, req *connect.Request[personv1.UpdatePersonRequest]) (*connect.Response[personv1.UpdatePersonResponse], error) {
+	person, err := updateEntity(h, personType, req.Msg.Person, req.Msg.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&personv1.UpdatePersonResponse{Person: person}), nil
+}
+
+// DeletePerson implements the DeletePerson RPC of the PersonService.
+func (h *Handler) DeletePerson(_ context.Context, req *connect.Request[personv1.DeletePersonRequest]) (*connect.Response[personv1.DeletePersonResponse], error) {
+	if err := deleteEntity(h, personType, req.Msg.Id, req.Msg.Edited, req.Msg.Cascade); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&personv1.DeletePersonResponse{}), nil
+}
+
+// WatchPeople implements the WatchPeople RPC of the PersonService.
+func (h *Handler) WatchPeople(ctx context.Context, req *connect.Request[personv1.WatchPeopleRequest], stream *connect.ServerStream[personv1.WatchPeopleResponse]) error {
+	return watchEntities(ctx, h, personType, req.Msg.ResumeToken, req.Msg.InitialSnapshot, stream,
+		func(changeType commonv1.ChangeType, item *personv1.Person, resumeToken string) *personv1.WatchPeopleResponse {
+			return &personv1.WatchPeopleResponse{ChangeType: changeType, Person: item, ResumeToken: resumeToken}
+		})
+}
+
 // GetStarships implements the GetStarships RPC of the StarshipService.
-func (h *Handler) GetStarships(_ context.Context, req *connect.Request[starshipv1.GetStarshipsRequest]) (*connect.Response[starshipv1.GetStarshipsResponse], error) {
-	starships, err := getAll(req.Msg.Ids, allStarships)
+func (h *Handler) GetStarships(ctx context.Context, req *connect.Request[starshipv1.GetStarshipsRequest]) (*connect.Response[starshipv1.GetStarshipsResponse], error) {
+	_, store := h.snapshot(ctx)
+	starships, errs, err := getAll(req.Msg.Ids, &store.starships, req.Msg.AllowMissing)
 	if err != nil {
 		return nil, err
 	}
+	if starships, err = applyReadMask(starships, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&starshipv1.GetStarshipsResponse{
-		Starships: transform(starships, transformStarship),
+		Starships: starships,
+		Errors:    errs,
 	}), nil
 }
 
 // ListStarships implements the ListStarships RPC of the StarshipService.
-func (h *Handler) ListStarships(_ context.Context, req *connect.Request[starshipv1.ListStarshipsRequest]) (*connect.Response[starshipv1.ListStarshipsResponse], error) {
-	starships, nextPageToken := paginate(allStarships, int(req.Msg.PageSize), req.Msg.PageToken)
+func (h *Handler) ListStarships(ctx context.Context, req *connect.Request[starshipv1.ListStarshipsRequest]) (*connect.Response[starshipv1.ListStarshipsResponse], error) {
+	_, store := h.snapshot(ctx)
+	starships, nextPageToken, err := listEntities(h, store, starshipType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if starships, err = applyReadMask(starships, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(
 		&starshipv1.ListStarshipsResponse{
-			Starships:     transform(starships, transformStarship),
+			Starships:     starships,
 			NextPageToken: nextPageToken,
 		},
 	), nil
 }
 
+// StreamStarships implements the StreamStarships RPC of the StarshipService.
+func (h *Handler) StreamStarships(ctx context.Context, req *connect.Request[starshipv1.StreamStarshipsRequest], stream *connect.ServerStream[starshipv1.StreamStarshipsResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, starshipType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*starshipv1.Starship) *starshipv1.StreamStarshipsResponse {
+			return &starshipv1.StreamStarshipsResponse{Starships: chunk}
+		})
+}
+
+// CreateStarship implements the CreateStarship RPC of the StarshipService.
+func (h *Handler) CreateStarship(_ context.Context, req *connect.Request[starshipv1.CreateStarshipRequest]) (*connect.Response[starshipv1.CreateStarshipResponse], error) {
+	starship, err := createEntity(h, starshipType, req.Msg.Starship)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&starshipv1.CreateStarshipResponse{Starship: starship}), nil
+}
+
+// UpdateStarship implements the UpdateStarship RPC of the StarshipService.
+func (h *Handler) UpdateStarship(_ context.Context, req *connect.Request[starshipv1.UpdateStarshipRequest]) (*connect.Response[starshipv1.UpdateStarshipResponse], error) {
+	starship, err := updateEntity(h, starshipType, req.Msg.Starship, req.Msg.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&starshipv1.UpdateStarshipResponse{Starship: starship}), nil
+}
+
+// DeleteStarship implements the DeleteStarship RPC of the StarshipService.
+func (h *Handler) DeleteStarship(_ context.Context, req *connect.Request[starshipv1.DeleteStarshipRequest]) (*connect.Response[starshipv1.DeleteStarshipResponse], error) {
+	if err := deleteEntity(h, starshipType, req.Msg.Id, req.Msg.Edited, req.Msg.Cascade); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&starshipv1.DeleteStarshipResponse{}), nil
+}
+
+// WatchStarships implements the WatchStarships RPC of the StarshipService.
+func (h *Handler) WatchStarships(ctx context.Context, req *connect.Request[starshipv1.WatchStarshipsRequest], stream *connect.ServerStream[starshipv1.WatchStarshipsResponse]) error {
+	return watchEntities(ctx, h, starshipType, req.Msg.ResumeToken, req.Msg.InitialSnapshot, stream,
+		func(changeType commonv1.ChangeType, item *starshipv1.Starship, resumeToken string) *starshipv1.WatchStarshipsResponse {
+			return &starshipv1.WatchStarshipsResponse{ChangeType: changeType, Starship: item, ResumeToken: resumeToken}
+		})
+}
+
 // GetVehicles implements the GetVehicles RPC of the VehicleService.
-func (h *Handler) GetVehicles(_ context.Context, req *connect.Request[vehiclev1.GetVehiclesRequest]) (*connect.Response[vehiclev1.GetVehiclesResponse], error) {
-	vehicles, err := getAll(req.Msg.Ids, allVehicles)
+func (h *Handler) GetVehicles(ctx context.Context, req *connect.Request[vehiclev1.GetVehiclesRequest]) (*connect.Response[vehiclev1.GetVehiclesResponse], error) {
+	_, store := h.snapshot(ctx)
+	vehicles, errs, err := getAll(req.Msg.Ids, &store.vehicles, req.Msg.AllowMissing)
 	if err != nil {
 		return nil, err
 	}
+	if vehicles, err = applyReadMask(vehicles, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&vehiclev1.GetVehiclesResponse{
-		Vehicles: transform(vehicles, transformVehicle),
+		Vehicles: vehicles,
+		Errors:   errs,
 	}), nil
 }
 
 // ListVehicles implements the ListVehicles RPC of the VehicleService.
-func (h *Handler) ListVehicles(_ context.Context, req *connect.Request[vehiclev1.ListVehiclesRequest]) (*connect.Response[vehiclev1.ListVehiclesResponse], error) {
-	vehicles, nextPageToken := paginate(allVehicles, int(req.Msg.PageSize), req.Msg.PageToken)
+func (h *Handler) ListVehicles(ctx context.Context, req *connect.Request[vehiclev1.ListVehiclesRequest]) (*connect.Response[vehiclev1.ListVehiclesResponse], error) {
+	_, store := h.snapshot(ctx)
+	vehicles, nextPageToken, err := listEntities(h, store, vehicleType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if vehicles, err = applyReadMask(vehicles, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(
 		&vehiclev1.ListVehiclesResponse{
-			Vehicles:      transform(vehicles, transformVehicle),
+			Vehicles:      vehicles,
 			NextPageToken: nextPageToken,
 		},
 	), nil
 }
 
+// StreamVehicles implements the StreamVehicles RPC of the VehicleService.
+func (h *Handler) StreamVehicles(ctx context.Context, req *connect.Request[vehiclev1.StreamVehiclesRequest], stream *connect.ServerStream[vehiclev1.StreamVehiclesResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, vehicleType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*vehiclev1.Vehicle) *vehiclev1.StreamVehiclesResponse {
+			return &vehiclev1.StreamVehiclesResponse{Vehicles: chunk}
+		})
+}
+
+// CreateVehicle implements the CreateVehicle RPC of the VehicleService.
+func (h *Handler) CreateVehicle(_ context.Context, req *connect.Request[vehiclev1.CreateVehicleRequest]) (*connect.Response[vehiclev1.CreateVehicleResponse], error) {
+	vehicle, err := createEntity(h, vehicleType, req.Msg.Vehicle)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vehiclev1.CreateVehicleResponse{Vehicle: vehicle}), nil
+}
+
+// UpdateVehicle implements the UpdateVehicle RPC of the VehicleService.
+func (h *Handler) UpdateVehicle(_ context.Context, req *connect.Request[vehiclev1.UpdateVehicleRequest]) (*connect.Response[vehiclev1.UpdateVehicleResponse], error) {
+	vehicle, err := updateEntity(h, vehicleType, req.Msg.Vehicle, req.Msg.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vehiclev1.UpdateVehicleResponse{Vehicle: vehicle}), nil
+}
+
+// DeleteVehicle implements the DeleteVehicle RPC of the VehicleService.
+func (h *Handler) DeleteVehicle(_ context.Context, req *connect.Request[vehiclev1.DeleteVehicleRequest]) (*connect.Response[vehiclev1.DeleteVehicleResponse], error) {
+	if err := deleteEntity(h, vehicleType, req.Msg.Id, req.Msg.Edited, req.Msg.Cascade); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&vehiclev1.DeleteVehicleResponse{}), nil
+}
+
+// WatchVehicles implements the WatchVehicles RPC of the VehicleService.
+func (h *Handler) WatchVehicles(ctx context.Context, req *connect.Request[vehiclev1.WatchVehiclesRequest], stream *connect.ServerStream[vehiclev1.WatchVehiclesResponse]) error {
+	return watchEntities(ctx, h, vehicleType, req.Msg.ResumeToken, req.Msg.InitialSnapshot, stream,
+		func(changeType commonv1.ChangeType, item *vehiclev1.Vehicle, resumeToken string) *vehiclev1.WatchVehiclesResponse {
+			return &vehiclev1.WatchVehiclesResponse{ChangeType: changeType, Vehicle: item, ResumeToken: resumeToken}
+		})
+}
+
 // GetSpecies implements the GetSpecies RPC of the SpeciesService.
-func (h *Handler) GetSpecies(_ context.Context, req *connect.Request[speciesv1.GetSpeciesRequest]) (*connect.Response[speciesv1.GetSpeciesResponse], error) {
-	species, err := getAll(req.Msg.Ids, allSpecies)
+func (h *Handler) GetSpecies(ctx context.Context, req *connect.Request[speciesv1.GetSpeciesRequest]) (*connect.Response[speciesv1.GetSpeciesResponse], error) {
+	_, store := h.snapshot(ctx)
+	species, errs, err := getAll(req.Msg.Ids, &store.species, req.Msg.AllowMissing)
 	if err != nil {
 		return nil, err
 	}
+	if species, err = applyReadMask(species, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&speciesv1.GetSpeciesResponse{
-		Species: transform(species, transformSpecies),
+		Species: species,
+		Errors:  errs,
 	}), nil
 }
 
 // ListSpecies implements the ListSpecies RPC of the SpeciesService.
-func (h *Handler) ListSpecies(_ context.Context, req *connect.Request[speciesv1.ListSpeciesRequest]) (*connect.Response[speciesv1.ListSpeciesResponse], error) {
-	species, nextPageToken := paginate(allSpecies, int(req.Msg.PageSize), req.Msg.PageToken)
+func (h *Handler) ListSpecies(ctx context.Context, req *connect.Request[speciesv1.ListSpeciesRequest]) (*connect.Response[speciesv1.ListSpeciesResponse], error) {
+	_, store := h.snapshot(ctx)
+	species, nextPageToken, err := listEntities(h, store, speciesType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if species, err = applyReadMask(species, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(
 		&speciesv1.ListSpeciesResponse{
-			Species:       transform(species, transformSpecies),
+			Species:       species,
 			NextPageToken: nextPageToken,
 		},
 	), nil
 }
 
+// StreamSpecies implements the StreamSpecies RPC of the SpeciesService.
+func (h *Handler) StreamSpecies(ctx context.Context, req *connect.Request[speciesv1.StreamSpeciesRequest], stream *connect.ServerStream[speciesv1.StreamSpeciesResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, speciesType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*speciesv1.Species) *speciesv1.StreamSpeciesResponse {
+			return &speciesv1.StreamSpeciesResponse{Species: chunk}
+		})
+}
+
+// CreateSpecies implements the CreateSpecies RPC of the SpeciesService.
+func (h *Handler) CreateSpecies(_ context.Context, req *connect.Request[speciesv1.CreateSpeciesRequest]) (*connect.Response[speciesv1.CreateSpeciesResponse], error) {
+	species, err := createEntity(h, speciesType, req.Msg.Species)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&speciesv1.CreateSpeciesResponse{Species: species}), nil
+}
+
+// UpdateSpecies implements the UpdateSpecies RPC of the SpeciesService.
+func (h *Handler) UpdateSpecies(_ context.Context, req *connect.Request[speciesv1.UpdateSpeciesRequest]) (*connect.Response[speciesv1.UpdateSpeciesResponse], error) {
+	species, err := updateEntity(h, speciesType, req.Msg.Species, req.Msg.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&speciesv1.UpdateSpeciesResponse{Species: species}), nil
+}
+
+// DeleteSpecies implements the DeleteSpecies RPC of the SpeciesService.
+func (h *Handler) DeleteSpecies(_ context.Context, req *connect.Request[speciesv1.DeleteSpeciesRequest]) (*connect.Response[speciesv1.DeleteSpeciesResponse], error) {
+	if err := deleteEntity(h, speciesType, req.Msg.Id, req.Msg.Edited, req.Msg.Cascade); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&speciesv1.DeleteSpeciesResponse{}), nil
+}
+
+// WatchSpecies implements the WatchSpecies RPC of the SpeciesService.
+func (h *Handler) WatchSpecies(ctx context.Context, req *connect.Request[speciesv1.WatchSpeciesRequest], stream *connect.ServerStream[speciesv1.WatchSpeciesResponse]) error {
+	return watchEntities(ctx, h, speciesType, req.Msg.ResumeToken, req.Msg.InitialSnapshot, stream,
+		func(changeType commonv1.ChangeType, item *speciesv1.Species, resumeToken string) *speciesv1.WatchSpeciesResponse {
+			return &speciesv1.WatchSpeciesResponse{ChangeType: changeType, Species: item, ResumeToken: resumeToken}
+		})
+}
+
 // GetPlanets implements the GetPlanets RPC of the PlanetService.
-func (h *Handler) GetPlanets(_ context.Context, req *connect.Request[planetv1.GetPlanetsRequest]) (*connect.Response[planetv1.GetPlanetsResponse], error) {
-	planets, err := getAll(req.Msg.Ids, allPlanets)
+func (h *Handler) GetPlanets(ctx context.Context, req *connect.Request[planetv1.GetPlanetsRequest]) (*connect.Response[planetv1.GetPlanetsResponse], error) {
+	_, store := h.snapshot(ctx)
+	planets, errs, err := getAll(req.Msg.Ids, &store.planets, req.Msg.AllowMissing)
 	if err != nil {
 		return nil, err
 	}
+	if planets, err = applyReadMask(planets, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&planetv1.GetPlanetsResponse{
-		Planets: transform(planets, transformPlanet),
+		Planets: planets,
+		Errors:  errs,
 	}), nil
 }
 
 // ListPlanets implements the ListPlanets RPC of the PlanetService.
-func (h *Handler) ListPlanets(_ context.Context, req *connect.Request[planetv1.ListPlanetsRequest]) (*connect.Response[planetv1.ListPlanetsResponse], error) {
-	planets, nextPageToken := paginate(allPlanets, int(req.Msg.PageSize), req.Msg.PageToken)
+func (h *Handler) ListPlanets(ctx context.Context, req *connect.Request[planetv1.ListPlanetsRequest]) (*connect.Response[planetv1.ListPlanetsResponse], error) {
+	_, store := h.snapshot(ctx)
+	planets, nextPageToken, err := listEntities(h, store, planetType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if planets, err = applyReadMask(planets, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(
 		&planetv1.ListPlanetsResponse{
-			Planets:       transform(planets, transformPlanet),
+			Planets:       planets,
 			NextPageToken: nextPageToken,
 		},
 	), nil
 }
 
+// StreamPlanets implements the StreamPlanets RPC of the PlanetService.
+func (h *Handler) StreamPlanets(ctx context.Context, req *connect.Request[planetv1.StreamPlanetsRequest], stream *connect.ServerStream[planetv1.StreamPlanetsResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, planetType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*planetv1.Planet) *planetv1.StreamPlanetsResponse {
+			return &planetv1.StreamPlanetsResponse{Planets: chunk}
+		})
+}
+
+// CreatePlanet implements the CreatePlanet RPC of the PlanetService.
+func (h *Handler) CreatePlanet(_ context.Context, req *connect.Request[planetv1.CreatePlanetRequest]) (*connect.Response[planetv1.CreatePlanetResponse], error) {
+	planet, err := createEntity(h, planetType, req.Msg.Planet)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&planetv1.CreatePlanetResponse{Planet: planet}), nil
+}
+
+// UpdatePlanet implements the UpdatePlanet RPC of the PlanetService.
+func (h *Handler) UpdatePlanet(_ context.Context, req *connect.Request[planetv1.UpdatePlanetRequest]) (*connect.Response[planetv1.UpdatePlanetResponse], error) {
+	planet, err := updateEntity(h, planetType, req.Msg.Planet, req.Msg.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&planetv1.UpdatePlanetResponse{Planet: planet}), nil
+}
+
+// DeletePlanet implements the DeletePlanet RPC of the PlanetService.
+func (h *Handler) DeletePlanet(_ context.Context, req *connect.Request[planetv1.DeletePlanetRequest]) (*connect.Response[planetv1.DeletePlanetResponse], error) {
+	if err := deleteEntity(h, planetType, req.Msg.Id, req.Msg.Edited, req.Msg.Cascade); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&planetv1.DeletePlanetResponse{}), nil
+}
+
+// WatchPlanets implements the WatchPlanets RPC of the PlanetService.
+func (h *Handler) WatchPlanets(ctx context.Context, req *connect.Request[planetv1.WatchPlanetsRequest], stream *connect.ServerStream[planetv1.WatchPlanetsResponse]) error {
+	return watchEntities(ctx, h, planetType, req.Msg.ResumeToken, req.Msg.InitialSnapshot, stream,
+		func(changeType commonv1.ChangeType, item *planetv1.Planet, resumeToken string) *planetv1.WatchPlanetsResponse {
+			return &planetv1.WatchPlanetsResponse{ChangeType: changeType, Planet: item, ResumeToken: resumeToken}
+		})
+}
+
+// GetManufacturers implements the GetManufacturers RPC of the ManufacturerService.
+func (h *Handler) GetManufacturers(ctx context.Context, req *connect.Request[manufacturerv1.GetManufacturersRequest]) (*connect.Response[manufacturerv1.GetManufacturersResponse], error) {
+	_, store := h.snapshot(ctx)
+	manufacturers, errs, err := getAll(req.Msg.Ids, store.manufacturerIndex(), req.Msg.AllowMissing)
+	if err != nil {
+		return nil, err
+	}
+	if manufacturers, err = applyReadMask(manufacturers, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&manufacturerv1.GetManufacturersResponse{
+		Manufacturers: manufacturers,
+		Errors:        errs,
+	}), nil
+}
+
+// ListManufacturers implements the ListManufacturers RPC of the ManufacturerService.
+func (h *Handler) ListManufacturers(ctx context.Context, req *connect.Request[manufacturerv1.ListManufacturersRequest]) (*connect.Response[manufacturerv1.ListManufacturersResponse], error) {
+	_, store := h.snapshot(ctx)
+	manufacturers, nextPageToken, err := listEntities(h, store, manufacturerType, listOptions{
+		pageSize:  int(req.Msg.PageSize),
+		pageToken: req.Msg.PageToken,
+		filter:    req.Msg.Filter,
+		orderBy:   req.Msg.OrderBy,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if manufacturers, err = applyReadMask(manufacturers, req.Msg.ReadMask); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&manufacturerv1.ListManufacturersResponse{
+		Manufacturers: manufacturers,
+		NextPageToken: nextPageToken,
+	}), nil
+}
+
+// StreamManufacturers implements the StreamManufacturers RPC of the ManufacturerService.
+func (h *Handler) StreamManufacturers(ctx context.Context, req *connect.Request[manufacturerv1.StreamManufacturersRequest], stream *connect.ServerStream[manufacturerv1.StreamManufacturersResponse]) error {
+	_, store := h.snapshot(ctx)
+	return streamEntities(ctx, store, manufacturerType, req.Msg.Filter, int(req.Msg.ChunkSize), stream,
+		func(chunk []*manufacturerv1.Manufacturer) *manufacturerv1.StreamManufacturersResponse {
+			return &manufacturerv1.StreamManufacturersResponse{Manufacturers: chunk}
+		})
+}
+
 // GetFilmCharacters implements the GetFilmCharacters RPC of the PersonResolverService.
 func (h *Handler) GetFilmCharacters(ctx context.Context, req *connect.Request[relationsv1.GetFilmRelationsRequest]) (*connect.Response[relationsv1.GetCharactersResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(film *filmv1.Film) []string { return film.CharacterIds },
 		func(ctx context.Context, ids []string) (*connect.Response[personv1.GetPeopleResponse], error) {
-			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids}))
+			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *personv1.GetPeopleResponse) []*personv1.Person { return msg.People },
-		func(values []*personv1.Person, result *relationsv1.GetCharactersResponse_Result) {
+		func(values []*personv1.Person, nextPageToken string, result *relationsv1.GetCharactersResponse_Result) {
 			result.Characters = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -225,17 +799,20 @@ func (h *Handler) GetFilmCharacters(ctx context.Context, req *connect.Request[re
 
 // GetFilmPlanets implements the GetFilmPlanets RPC of the PlanetResolverService.
 func (h *Handler) GetFilmPlanets(ctx context.Context, req *connect.Request[relationsv1.GetFilmRelationsRequest]) (*connect.Response[relationsv1.GetPlanetsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(film *filmv1.Film) []string { return film.PlanetIds },
 		func(ctx context.Context, ids []string) (*connect.Response[planetv1.GetPlanetsResponse], error) {
-			return h.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: ids}))
+			return h.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *planetv1.GetPlanetsResponse) []*planetv1.Planet { return msg.Planets },
-		func(values []*planetv1.Planet, result *relationsv1.GetPlanetsResponse_Result) {
+		func(values []*planetv1.Planet, nextPageToken string, result *relationsv1.GetPlanetsResponse_Result) {
 			result.Planets = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -246,17 +823,20 @@ func (h *Handler) GetFilmPlanets(ctx context.Context, req *connect.Request[relat
 
 // GetFilmSpecies implements the GetFilmSpecies RPC of the SpeciesResolverService.
 func (h *Handler) GetFilmSpecies(ctx context.Context, req *connect.Request[relationsv1.GetFilmRelationsRequest]) (*connect.Response[relationsv1.GetSpeciesResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(film *filmv1.Film) []string { return film.SpeciesIds },
 		func(ctx context.Context, ids []string) (*connect.Response[speciesv1.GetSpeciesResponse], error) {
-			return h.GetSpecies(ctx, connect.NewRequest(&speciesv1.GetSpeciesRequest{Ids: ids}))
+			return h.GetSpecies(ctx, connect.NewRequest(&speciesv1.GetSpeciesRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *speciesv1.GetSpeciesResponse) []*speciesv1.Species { return msg.Species },
-		func(values []*speciesv1.Species, result *relationsv1.GetSpeciesResponse_Result) {
+		func(values []*speciesv1.Species, nextPageToken string, result *relationsv1.GetSpeciesResponse_Result) {
 			result.Species = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -267,17 +847,20 @@ func (h *Handler) GetFilmSpecies(ctx context.Context, req *connect.Request[relat
 
 // GetFilmStarships implements the GetFilmStarships RPC of the StarshipResolverService.
 func (h *Handler) GetFilmStarships(ctx context.Context, req *connect.Request[relationsv1.GetFilmRelationsRequest]) (*connect.Response[relationsv1.GetStarshipsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(film *filmv1.Film) []string { return film.StarshipIds },
 		func(ctx context.Context, ids []string) (*connect.Response[starshipv1.GetStarshipsResponse], error) {
-			return h.GetStarships(ctx, connect.NewRequest(&starshipv1.GetStarshipsRequest{Ids: ids}))
+			return h.GetStarships(ctx, connect.NewRequest(&starshipv1.GetStarshipsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *starshipv1.GetStarshipsResponse) []*starshipv1.Starship { return msg.Starships },
-		func(values []*starshipv1.Starship, result *relationsv1.GetStarshipsResponse_Result) {
+		func(values []*starshipv1.Starship, nextPageToken string, result *relationsv1.GetStarshipsResponse_Result) {
 			result.Starships = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -288,17 +871,20 @@ func (h *Handler) GetFilmStarships(ctx context.Context, req *connect.Request[rel
 
 // GetFilmVehicles implements the GetFilmVehicles RPC of the VehicleResolverService.
 func (h *Handler) GetFilmVehicles(ctx context.Context, req *connect.Request[relationsv1.GetFilmRelationsRequest]) (*connect.Response[relationsv1.GetVehiclesResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(film *filmv1.Film) []string { return film.VehicleIds },
 		func(ctx context.Context, ids []string) (*connect.Response[vehiclev1.GetVehiclesResponse], error) {
-			return h.GetVehicles(ctx, connect.NewRequest(&vehiclev1.GetVehiclesRequest{Ids: ids}))
+			return h.GetVehicles(ctx, connect.NewRequest(&vehiclev1.GetVehiclesRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *vehiclev1.GetVehiclesResponse) []*vehiclev1.Vehicle { return msg.Vehicles },
-		func(values []*vehiclev1.Vehicle, result *relationsv1.GetVehiclesResponse_Result) {
+		func(values []*vehiclev1.Vehicle, nextPageToken string, result *relationsv1.GetVehiclesResponse_Result) {
 			result.Vehicles = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -309,12 +895,14 @@ func (h *Handler) GetFilmVehicles(ctx context.Context, req *connect.Request[rela
 
 // GetPersonHomeworld implements the GetPersonHomeworld RPC of the PlanetResolverService.
 func (h *Handler) GetPersonHomeworld(ctx context.Context, req *connect.Request[relationsv1.GetPersonRelationRequest]) (*connect.Response[relationsv1.GetHomeworldResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolve1to1Batch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
 		func(person *personv1.Person) string { return person.HomeworldId },
 		func(ctx context.Context, ids []string) (*connect.Response[planetv1.GetPlanetsResponse], error) {
-			return h.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: ids}))
+			return h.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *planetv1.GetPlanetsResponse) []*planetv1.Planet { return msg.Planets },
 		func(value *planetv1.Planet, result *relationsv1.GetHomeworldResponse_Result) {
@@ -329,16 +917,21 @@ func (h *Handler) GetPersonHomeworld(ctx context.Context, req *connect.Request[r
 
 // GetPersonFilms implements the GetPersonFilms RPC of the FilmResolverService.
 func (h *Handler) GetPersonFilms(ctx context.Context, req *connect.Request[relationsv1.GetPersonRelationsRequest]) (*connect.Response[relationsv1.GetFilmsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(person *personv1.Person) []string { return person.FilmIds },
 		func(ctx context.Context, ids []string) (*connect.Response[filmv1.GetFilmsResponse], error) {
-			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids}))
+			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *filmv1.GetFilmsResponse) []*filmv1.Film { return msg.Films },
-		func(values []*filmv1.Film, result *relationsv1.GetFilmsResponse_Result) { result.Films = values },
+		func(values []*filmv1.Film, nextPageToken string, result *relationsv1.GetFilmsResponse_Result) {
+			result.Films = values
+			result.NextPageToken = nextPageToken
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -348,17 +941,20 @@ func (h *Handler) GetPersonFilms(ctx context.Context, req *connect.Request[relat
 
 // GetPersonSpecies implements the GetPersonSpecies RPC of the SpeciesResolverService.
 func (h *Handler) GetPersonSpecies(ctx context.Context, req *connect.Request[relationsv1.GetPersonRelationsRequest]) (*connect.Response[relationsv1.GetSpeciesResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(person *personv1.Person) []string { return person.SpeciesIds },
 		func(ctx context.Context, ids []string) (*connect.Response[speciesv1.GetSpeciesResponse], error) {
-			return h.GetSpecies(ctx, connect.NewRequest(&speciesv1.GetSpeciesRequest{Ids: ids}))
+			return h.GetSpecies(ctx, connect.NewRequest(&speciesv1.GetSpeciesRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *speciesv1.GetSpeciesResponse) []*speciesv1.Species { return msg.Species },
-		func(values []*speciesv1.Species, result *relationsv1.GetSpeciesResponse_Result) {
+		func(values []*speciesv1.Species, nextPageToken string, result *relationsv1.GetSpeciesResponse_Result) {
 			result.Species = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -369,17 +965,20 @@ func (h *Handler) GetPersonSpecies(ctx context.Context, req *connect.Request[rel
 
 // GetPersonStarships implements the GetPersonStarships RPC of the StarshipResolverService.
 func (h *Handler) GetPersonStarships(ctx context.Context, req *connect.Request[relationsv1.GetPersonRelationsRequest]) (*connect.Response[relationsv1.GetStarshipsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(person *personv1.Person) []string { return person.StarshipIds },
 		func(ctx context.Context, ids []string) (*connect.Response[starshipv1.GetStarshipsResponse], error) {
-			return h.GetStarships(ctx, connect.NewRequest(&starshipv1.GetStarshipsRequest{Ids: ids}))
+			return h.GetStarships(ctx, connect.NewRequest(&starshipv1.GetStarshipsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *starshipv1.GetStarshipsResponse) []*starshipv1.Starship { return msg.Starships },
-		func(values []*starshipv1.Starship, result *relationsv1.GetStarshipsResponse_Result) {
+		func(values []*starshipv1.Starship, nextPageToken string, result *relationsv1.GetStarshipsResponse_Result) {
 			result.Starships = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -390,17 +989,20 @@ func (h *Handler) GetPersonStarships(ctx context.Context, req *connect.Request[r
 
 // GetPersonVehicles implements the GetPersonVehicles RPC of the VehicleResolverService.
 func (h *Handler) GetPersonVehicles(ctx context.Context, req *connect.Request[relationsv1.GetPersonRelationsRequest]) (*connect.Response[relationsv1.GetVehiclesResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(person *personv1.Person) []string { return person.VehicleIds },
 		func(ctx context.Context, ids []string) (*connect.Response[vehiclev1.GetVehiclesResponse], error) {
-			return h.GetVehicles(ctx, connect.NewRequest(&vehiclev1.GetVehiclesRequest{Ids: ids}))
+			return h.GetVehicles(ctx, connect.NewRequest(&vehiclev1.GetVehiclesRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *vehiclev1.GetVehiclesResponse) []*vehiclev1.Vehicle { return msg.Vehicles },
-		func(values []*vehiclev1.Vehicle, result *relationsv1.GetVehiclesResponse_Result) {
+		func(values []*vehiclev1.Vehicle, nextPageToken string, result *relationsv1.GetVehiclesResponse_Result) {
 			result.Vehicles = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -411,16 +1013,21 @@ func (h *Handler) GetPersonVehicles(ctx context.Context, req *connect.Request[re
 
 // GetPlanetFilms implements the GetPlanetFilms RPC of the FilmResolverService.
 func (h *Handler) GetPlanetFilms(ctx context.Context, req *connect.Request[relationsv1.GetPlanetRelationsRequest]) (*connect.Response[relationsv1.GetFilmsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(planet *planetv1.Planet) []string { return planet.FilmIds },
 		func(ctx context.Context, ids []string) (*connect.Response[filmv1.GetFilmsResponse], error) {
-			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids}))
+			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *filmv1.GetFilmsResponse) []*filmv1.Film { return msg.Films },
-		func(values []*filmv1.Film, result *relationsv1.GetFilmsResponse_Result) { result.Films = values },
+		func(values []*filmv1.Film, nextPageToken string, result *relationsv1.GetFilmsResponse_Result) {
+			result.Films = values
+			result.NextPageToken = nextPageToken
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -430,17 +1037,20 @@ func (h *Handler) GetPlanetFilms(ctx context.Context, req *connect.Request[relat
 
 // GetPlanetResidents implements the GetPlanetResidents RPC of the PersonResolverService.
 func (h *Handler) GetPlanetResidents(ctx context.Context, req *connect.Request[relationsv1.GetPlanetRelationsRequest]) (*connect.Response[relationsv1.GetResidentsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(planet *planetv1.Planet) []string { return planet.ResidentIds },
 		func(ctx context.Context, ids []string) (*connect.Response[personv1.GetPeopleResponse], error) {
-			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids}))
+			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *personv1.GetPeopleResponse) []*personv1.Person { return msg.People },
-		func(values []*personv1.Person, result *relationsv1.GetResidentsResponse_Result) {
+		func(values []*personv1.Person, nextPageToken string, result *relationsv1.GetResidentsResponse_Result) {
 			result.Residents = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -451,12 +1061,14 @@ func (h *Handler) GetPlanetResidents(ctx context.Context, req *connect.Request[r
 
 // GetSpeciesHomeworld implements the GetSpeciesHomeworld RPC of the PlanetResolverService.
 func (h *Handler) GetSpeciesHomeworld(ctx context.Context, req *connect.Request[relationsv1.GetSpeciesRelationRequest]) (*connect.Response[relationsv1.GetHomeworldResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolve1to1Batch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
 		func(species *speciesv1.Species) string { return species.HomeworldId },
 		func(ctx context.Context, ids []string) (*connect.Response[planetv1.GetPlanetsResponse], error) {
-			return h.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: ids}))
+			return h.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *planetv1.GetPlanetsResponse) []*planetv1.Planet { return msg.Planets },
 		func(value *planetv1.Planet, result *relationsv1.GetHomeworldResponse_Result) {
@@ -471,16 +1083,21 @@ func (h *Handler) GetSpeciesHomeworld(ctx context.Context, req *connect.Request[
 
 // GetSpeciesFilms implements the GetSpeciesFilms RPC of the FilmResolverService.
 func (h *Handler) GetSpeciesFilms(ctx context.Context, req *connect.Request[relationsv1.GetSpeciesRelationsRequest]) (*connect.Response[relationsv1.GetFilmsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(species *speciesv1.Species) []string { return species.FilmIds },
 		func(ctx context.Context, ids []string) (*connect.Response[filmv1.GetFilmsResponse], error) {
-			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids}))
+			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *filmv1.GetFilmsResponse) []*filmv1.Film { return msg.Films },
-		func(values []*filmv1.Film, result *relationsv1.GetFilmsResponse_Result) { result.Films = values },
+		func(values []*filmv1.Film, nextPageToken string, result *relationsv1.GetFilmsResponse_Result) {
+			result.Films = values
+			result.NextPageToken = nextPageToken
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -488,39 +1105,61 @@ func (h *Handler) GetSpeciesFilms(ctx context.Context, req *connect.Request[rela
 	return connect.NewResponse(&relationsv1.GetFilmsResponse{Values: wrappers}), nil
 }
 
-// GetSpeciesCharacters implements the GetSpeciesCharacters RPC of the PersonResolverService.
+// GetSpeciesCharacters implements the deprecated GetSpeciesCharacters RPC of
+// the PersonResolverService. It returns the same results as GetSpeciesPeople.
 func (h *Handler) GetSpeciesCharacters(ctx context.Context, req *connect.Request[relationsv1.GetSpeciesRelationsRequest]) (*connect.Response[relationsv1.GetCharactersResponse], error) {
+	resp, err := h.GetSpeciesPeople(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	wrappers := make([]*relationsv1.GetCharactersResponse_Result, len(resp.Msg.Values))
+	for i, value := range resp.Msg.Values {
+		wrappers[i] = &relationsv1.GetCharactersResponse_Result{Characters: value.People, NextPageToken: value.NextPageToken}
+	}
+	return connect.NewResponse(&relationsv1.GetCharactersResponse{Values: wrappers}), nil
+}
+
+// GetSpeciesPeople implements the GetSpeciesPeople RPC of the PersonResolverService.
+func (h *Handler) GetSpeciesPeople(ctx context.Context, req *connect.Request[relationsv1.GetSpeciesRelationsRequest]) (*connect.Response[relationsv1.GetPeopleResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(species *speciesv1.Species) []string { return species.PeopleIds },
 		func(ctx context.Context, ids []string) (*connect.Response[personv1.GetPeopleResponse], error) {
-			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids}))
+			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *personv1.GetPeopleResponse) []*personv1.Person { return msg.People },
-		func(values []*personv1.Person, result *relationsv1.GetCharactersResponse_Result) {
-			result.Characters = values
+		func(values []*personv1.Person, nextPageToken string, result *relationsv1.GetPeopleResponse_Result) {
+			result.People = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	return connect.NewResponse(&relationsv1.GetCharactersResponse{Values: wrappers}), nil
+	return connect.NewResponse(&relationsv1.GetPeopleResponse{Values: wrappers}), nil
 }
 
 // GetStarshipFilms implements the GetStarshipFilms RPC of the FilmResolverService.
 func (h *Handler) GetStarshipFilms(ctx context.Context, req *connect.Request[relationsv1.GetStarshipRelationsRequest]) (*connect.Response[relationsv1.GetFilmsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(starship *starshipv1.Starship) []string { return starship.FilmIds },
 		func(ctx context.Context, ids []string) (*connect.Response[filmv1.GetFilmsResponse], error) {
-			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids}))
+			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *filmv1.GetFilmsResponse) []*filmv1.Film { return msg.Films },
-		func(values []*filmv1.Film, result *relationsv1.GetFilmsResponse_Result) { result.Films = values },
+		func(values []*filmv1.Film, nextPageToken string, result *relationsv1.GetFilmsResponse_Result) {
+			result.Films = values
+			result.NextPageToken = nextPageToken
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -530,17 +1169,20 @@ func (h *Handler) GetStarshipFilms(ctx context.Context, req *connect.Request[rel
 
 // GetStarshipPilots implements the GetStarshipPilots RPC of the PersonResolverService.
 func (h *Handler) GetStarshipPilots(ctx context.Context, req *connect.Request[relationsv1.GetStarshipRelationsRequest]) (*connect.Response[relationsv1.GetPilotsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(starship *starshipv1.Starship) []string { return starship.PilotIds },
 		func(ctx context.Context, ids []string) (*connect.Response[personv1.GetPeopleResponse], error) {
-			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids}))
+			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *personv1.GetPeopleResponse) []*personv1.Person { return msg.People },
-		func(values []*personv1.Person, result *relationsv1.GetPilotsResponse_Result) {
+		func(values []*personv1.Person, nextPageToken string, result *relationsv1.GetPilotsResponse_Result) {
 			result.Pilots = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -551,16 +1193,21 @@ func (h *Handler) GetStarshipPilots(ctx context.Context, req *connect.Request[re
 
 // GetVehicleFilms implements the GetVehicleFilms RPC of the FilmResolverService.
 func (h *Handler) GetVehicleFilms(ctx context.Context, req *connect.Request[relationsv1.GetVehicleRelationsRequest]) (*connect.Response[relationsv1.GetFilmsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(vehicle *vehiclev1.Vehicle) []string { return vehicle.FilmIds },
 		func(ctx context.Context, ids []string) (*connect.Response[filmv1.GetFilmsResponse], error) {
-			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids}))
+			return h.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *filmv1.GetFilmsResponse) []*filmv1.Film { return msg.Films },
-		func(values []*filmv1.Film, result *relationsv1.GetFilmsResponse_Result) { result.Films = values },
+		func(values []*filmv1.Film, nextPageToken string, result *relationsv1.GetFilmsResponse_Result) {
+			result.Films = values
+			result.NextPageToken = nextPageToken
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -570,17 +1217,20 @@ func (h *Handler) GetVehicleFilms(ctx context.Context, req *connect.Request[rela
 
 // GetVehiclePilots implements the GetVehiclePilots RPC of the PersonResolverService.
 func (h *Handler) GetVehiclePilots(ctx context.Context, req *connect.Request[relationsv1.GetVehicleRelationsRequest]) (*connect.Response[relationsv1.GetPilotsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
 	wrappers, err := resolveBatch(
 		ctx,
+		h.fanOutRecorder(req.Spec()),
 		req.Msg.Bases,
-		int(req.Msg.Limit),
+		h.relationOptions(ctx, req.Spec(), req.Msg),
 		func(vehicle *vehiclev1.Vehicle) []string { return vehicle.PilotIds },
 		func(ctx context.Context, ids []string) (*connect.Response[personv1.GetPeopleResponse], error) {
-			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids}))
+			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids, AllowMissing: true}))
 		},
 		func(msg *personv1.GetPeopleResponse) []*personv1.Person { return msg.People },
-		func(values []*personv1.Person, result *relationsv1.GetPilotsResponse_Result) {
+		func(values []*personv1.Person, nextPageToken string, result *relationsv1.GetPilotsResponse_Result) {
 			result.Pilots = values
+			result.NextPageToken = nextPageToken
 		},
 	)
 	if err != nil {
@@ -589,50 +1239,297 @@ func (h *Handler) GetVehiclePilots(ctx context.Context, req *connect.Request[rel
 	return connect.NewResponse(&relationsv1.GetPilotsResponse{Values: wrappers}), nil
 }
 
-func getAll[T any](ids []string, items []*T) ([]*T, error) {
-	idIndex := make(map[string]int, len(ids))
-	for index, id := range ids {
-		idIndex[id] = index
+// GetPersonCoStars implements the GetPersonCoStars RPC of the PersonResolverService.
+func (h *Handler) GetPersonCoStars(ctx context.Context, req *connect.Request[relationsv1.GetPersonRelationsRequest]) (*connect.Response[relationsv1.GetCoStarsResponse], error) {
+	ctx, store := h.snapshot(ctx)
+	wrappers, err := resolveBatch(
+		ctx,
+		h.fanOutRecorder(req.Spec()),
+		req.Msg.Bases,
+		h.relationOptions(ctx, req.Spec(), req.Msg),
+		func(person *personv1.Person) []string { return coStarIDs(store, person) },
+		func(ctx context.Context, ids []string) (*connect.Response[personv1.GetPeopleResponse], error) {
+			return h.GetPeople(ctx, connect.NewRequest(&personv1.GetPeopleRequest{Ids: ids, AllowMissing: true}))
+		},
+		func(msg *personv1.GetPeopleResponse) []*personv1.Person { return msg.People },
+		func(values []*personv1.Person, nextPageToken string, result *relationsv1.GetCoStarsResponse_Result) {
+			result.CoStars = values
+			result.NextPageToken = nextPageToken
+		},
+	)
+	if err != nil {
+		return nil, err
 	}
-	results := make([]*T, len(ids))
-	for _, item := range items {
-		url := reflect.ValueOf(item).Elem().FieldByName("URL").String()
-		id := urlToID(url)
-		index, ok := idIndex[id]
-		if !ok {
-			continue
+	return connect.NewResponse(&relationsv1.GetCoStarsResponse{Values: wrappers}), nil
+}
+
+// GetPlanetNativeSpecies implements the GetPlanetNativeSpecies RPC of the SpeciesResolverService.
+func (h *Handler) GetPlanetNativeSpecies(ctx context.Context, req *connect.Request[relationsv1.GetPlanetRelationsRequest]) (*connect.Response[relationsv1.GetNativeSpeciesResponse], error) {
+	ctx, store := h.snapshot(ctx)
+	wrappers, err := resolveBatch(
+		ctx,
+		h.fanOutRecorder(req.Spec()),
+		req.Msg.Bases,
+		h.relationOptions(ctx, req.Spec(), req.Msg),
+		func(planet *planetv1.Planet) []string { return nativeSpeciesIDs(store, planet) },
+		func(ctx context.Context, ids []string) (*connect.Response[speciesv1.GetSpeciesResponse], error) {
+			return h.GetSpecies(ctx, connect.NewRequest(&speciesv1.GetSpeciesRequest{Ids: ids, AllowMissing: true}))
+		},
+		func(msg *speciesv1.GetSpeciesResponse) []*speciesv1.Species { return msg.Species },
+		func(values []*speciesv1.Species, nextPageToken string, result *relationsv1.GetNativeSpeciesResponse_Result) {
+			result.NativeSpecies = values
+			result.NextPageToken = nextPageToken
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&relationsv1.GetNativeSpeciesResponse{Values: wrappers}), nil
+}
+
+// GetManufacturerStarships implements the GetManufacturerStarships RPC of the StarshipResolverService.
+func (h *Handler) GetManufacturerStarships(ctx context.Context, req *connect.Request[relationsv1.GetManufacturerRelationsRequest]) (*connect.Response[relationsv1.GetStarshipsResponse], error) {
+	ctx, _ = h.snapshot(ctx)
+	wrappers, err := resolveBatch(
+		ctx,
+		h.fanOutRecorder(req.Spec()),
+		req.Msg.Bases,
+		h.relationOptions(ctx, req.Spec(), req.Msg),
+		func(manufacturer *manufacturerv1.Manufacturer) []string { return manufacturer.StarshipIds },
+		func(ctx context.Context, ids []string) (*connect.Response[starshipv1.GetStarshipsResponse], error) {
+			return h.GetStarships(ctx, connect.NewRequest(&starshipv1.GetStarshipsRequest{Ids: ids, AllowMissing: true}))
+		},
+		func(msg *starshipv1.GetStarshipsResponse) []*starshipv1.Starship { return msg.Starships },
+		func(values []*starshipv1.Starship, nextPageToken string, result *relationsv1.GetStarshipsResponse_Result) {
+			result.Starships = values
+			result.NextPageToken = nextPageToken
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&relationsv1.GetStarshipsResponse{Values: wrappers}), nil
+}
+
+// GetManufacturerVehicles implements the GetManufacturerVehicles RPC of the VehicleResolverService.
+func (h *Handler) GetManufacturerVehicles(ctx context.Context, req *connect.Request[relationsv1.GetManufacturerRelationsRequest]) (*connect.Response[relationsv1.GetVehiclesResponse], error) {
+	ctx, _ = h.snapshot(ctx)
+	wrappers, err := resolveBatch(
+		ctx,
+		h.fanOutRecorder(req.Spec()),
+		req.Msg.Bases,
+		h.relationOptions(ctx, req.Spec(), req.Msg),
+		func(manufacturer *manufacturerv1.Manufacturer) []string { return manufacturer.VehicleIds },
+		func(ctx context.Context, ids []string) (*connect.Response[vehiclev1.GetVehiclesResponse], error) {
+			return h.GetVehicles(ctx, connect.NewRequest(&vehiclev1.GetVehiclesRequest{Ids: ids, AllowMissing: true}))
+		},
+		func(msg *vehiclev1.GetVehiclesResponse) []*vehiclev1.Vehicle { return msg.Vehicles },
+		func(values []*vehiclev1.Vehicle, nextPageToken string, result *relationsv1.GetVehiclesResponse_Result) {
+			result.Vehicles = values
+			result.NextPageToken = nextPageToken
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&relationsv1.GetVehiclesResponse{Values: wrappers}), nil
+}
+
+// GetStarshipManufacturers implements the GetStarshipManufacturers RPC of the ManufacturerResolverService.
+func (h *Handler) GetStarshipManufacturers(ctx context.Context, req *connect.Request[relationsv1.GetStarshipRelationsRequest]) (*connect.Response[relationsv1.GetManufacturersResponse], error) {
+	ctx, _ = h.snapshot(ctx)
+	wrappers, err := resolveBatch(
+		ctx,
+		h.fanOutRecorder(req.Spec()),
+		req.Msg.Bases,
+		h.relationOptions(ctx, req.Spec(), req.Msg),
+		func(starship *starshipv1.Starship) []string { return manufacturerIDs(starship.Manufacturers) },
+		func(ctx context.Context, ids []string) (*connect.Response[manufacturerv1.GetManufacturersResponse], error) {
+			return h.GetManufacturers(ctx, connect.NewRequest(&manufacturerv1.GetManufacturersRequest{Ids: ids, AllowMissing: true}))
+		},
+		func(msg *manufacturerv1.GetManufacturersResponse) []*manufacturerv1.Manufacturer {
+			return msg.Manufacturers
+		},
+		func(values []*manufacturerv1.Manufacturer, nextPageToken string, result *relationsv1.GetManufacturersResponse_Result) {
+			result.ManufacturerEntities = values
+			result.NextPageToken = nextPageToken
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&relationsv1.GetManufacturersResponse{Values: wrappers}), nil
+}
+
+// GetVehicleManufacturers implements the GetVehicleManufacturers RPC of the ManufacturerResolverService.
+func (h *Handler) GetVehicleManufacturers(ctx context.Context, req *connect.Request[relationsv1.GetVehicleRelationsRequest]) (*connect.Response[relationsv1.GetManufacturersResponse], error) {
+	ctx, _ = h.snapshot(ctx)
+	wrappers, err := resolveBatch(
+		ctx,
+		h.fanOutRecorder(req.Spec()),
+		req.Msg.Bases,
+		h.relationOptions(ctx, req.Spec(), req.Msg),
+		func(vehicle *vehiclev1.Vehicle) []string { return manufacturerIDs(vehicle.Manufacturers) },
+		func(ctx context.Context, ids []string) (*connect.Response[manufacturerv1.GetManufacturersResponse], error) {
+			return h.GetManufacturers(ctx, connect.NewRequest(&manufacturerv1.GetManufacturersRequest{Ids: ids, AllowMissing: true}))
+		},
+		func(msg *manufacturerv1.GetManufacturersResponse) []*manufacturerv1.Manufacturer {
+			return msg.Manufacturers
+		},
+		func(values []*manufacturerv1.Manufacturer, nextPageToken string, result *relationsv1.GetManufacturersResponse_Result) {
+			result.ManufacturerEntities = values
+			result.NextPageToken = nextPageToken
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&relationsv1.GetManufacturersResponse{Values: wrappers}), nil
+}
+
+// Search implements the Search RPC of the SearchService.
+func (h *Handler) Search(ctx context.Context, req *connect.Request[searchv1.SearchRequest]) (*connect.Response[searchv1.SearchResponse], error) {
+	_, store := h.snapshot(ctx)
+	if len(tokenize(req.Msg.Query)) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query must contain at least one word"))
+	}
+	kinds := make([]string, 0, len(req.Msg.Types))
+	for _, entityType := range req.Msg.Types {
+		kind, err := entityTypeName(entityType)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
-		results[index] = item
+		kinds = append(kinds, kind)
 	}
-	var missingIDs []string
-	for i, item := range results {
-		if item == nil {
-			missingIDs = append(missingIDs, ids[i])
+	slices.Sort(kinds)
+	kinds = slices.Compact(kinds)
+	results := store.searchIndex().search(req.Msg.Query, kinds)
+	codec := pageTokenCodec{
+		key:         h.pageTokenKey,
+		fingerprint: listFingerprint("search", strings.Join(append(kinds, req.Msg.Query), "\x00"), nil),
+		version:     store.version,
+	}
+	results, nextPageToken, err := paginate(results, int(req.Msg.PageSize), req.Msg.PageToken, codec)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([]*searchv1.SearchHit, len(results))
+	for i, result := range results {
+		hits[i] = &searchv1.SearchHit{
+			Entity:        wrapEntity(result.msg),
+			Score:         result.score,
+			MatchedFields: result.matchedFields,
+		}
+	}
+	return connect.NewResponse(
+		&searchv1.SearchResponse{
+			Hits:          hits,
+			NextPageToken: nextPageToken,
+		},
+	), nil
+}
+
+// FindPath implements the FindPath RPC of the GraphService.
+func (h *Handler) FindPath(ctx context.Context, req *connect.Request[graphv1.FindPathRequest]) (*connect.Response[graphv1.FindPathResponse], error) {
+	_, store := h.snapshot(ctx)
+	from, err := graphNodeFor(store, "from", req.Msg.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := graphNodeFor(store, "to", req.Msg.To)
+	if err != nil {
+		return nil, err
+	}
+	// The range is checked before converting to an int, which cannot hold
+	// every uint32 on 32-bit platforms.
+	if req.Msg.MaxDepth > maxPathDepth {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max_depth cannot be greater than %d", maxPathDepth))
+	}
+	maxDepth := int(req.Msg.MaxDepth)
+	if maxDepth == 0 {
+		maxDepth = defaultPathDepth
+	}
+	allowed := func(graphv1.EdgeKind) bool { return true }
+	if len(req.Msg.AllowedEdgeKinds) > 0 {
+		for _, kind := range req.Msg.AllowedEdgeKinds {
+			if _, ok := graphv1.EdgeKind_name[int32(kind)]; !ok || kind == graphv1.EdgeKind_EDGE_KIND_UNSPECIFIED {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid edge kind: %v", kind))
+			}
 		}
+		allowed = func(kind graphv1.EdgeKind) bool { return slices.Contains(req.Msg.AllowedEdgeKinds, kind) }
 	}
-	if len(missingIDs) > 0 {
-		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown IDs: %v", missingIDs))
+	nodes, edges := store.entityGraph().findPath(from, to, maxDepth, allowed)
+	entities := make([]*entityv1.Entity, len(nodes))
+	for i, node := range nodes {
+		msg, ok := store.indexByName(node.kind).getMessage(node.id)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("path refers to unknown %s %q", node.kind, node.id))
+		}
+		entities[i] = wrapEntity(msg)
 	}
-	return results, nil
+	return connect.NewResponse(&graphv1.FindPathResponse{
+		Entities: entities,
+		Edges:    edges,
+	}), nil
 }
 
-func paginate[T any](entities []T, pageSize int, pageToken string) ([]T, string) {
-	start := 0
-	if pageToken != "" {
-		token, err := strconv.Atoi(pageToken)
-		if err == nil {
-			start = token
+// Aggregate implements the Aggregate RPC of the StatsService.
+func (h *Handler) Aggregate(ctx context.Context, req *connect.Request[statsv1.AggregateRequest]) (*connect.Response[statsv1.AggregateResponse], error) {
+	_, store := h.snapshot(ctx)
+	kind, err := entityTypeName(req.Msg.Type)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	desc := entityDescriptor(kind)
+	compiled, err := filter.Compile(req.Msg.Filter, desc)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid filter: %w", err))
+	}
+	groupBy, err := newGroupBy(desc, req.Msg.GroupBy)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid group_by: %w", err))
+	}
+	aggs := make([]aggregation, len(req.Msg.Aggregations))
+	for i, agg := range req.Msg.Aggregations {
+		aggs[i], err = newAggregation(desc, agg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid aggregation #%d: %w", i+1, err))
 		}
 	}
-	var nextPageToken string
-	if pageSize <= 0 || pageSize > len(entities) {
-		pageSize = len(entities)
+	return connect.NewResponse(&statsv1.AggregateResponse{
+		Groups: aggregate(store.indexByName(kind).messages(), compiled, groupBy, aggs),
+	}), nil
+}
+
+// getAll returns the entities with the given ids. If any id does not refer
+// to an existing entity, it returns a NotFound error, unless allowMissing is
+// true, in which case it returns an EntityError for each such id instead.
+func getAll[T entity](ids []string, index *entityIndex[T], allowMissing bool) ([]T, []*commonv1.EntityError, error) {
+	results := make([]T, 0, len(ids))
+	var missingIDs []string
+	for _, id := range ids {
+		item, ok := index.byID[id]
+		if !ok {
+			missingIDs = append(missingIDs, id)
+			continue
+		}
+		results = append(results, item)
+	}
+	if len(missingIDs) == 0 {
+		return results, nil, nil
 	}
-	if len(entities[start:]) != pageSize {
-		nextPageToken = strconv.Itoa(len(entities[:start+pageSize]))
+	if !allowMissing {
+		return nil, nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown IDs: %v", missingIDs))
 	}
-	entities = entities[start : start+pageSize]
-	return entities, nextPageToken
+	errs := make([]*commonv1.EntityError, len(missingIDs))
+	for i, id := range missingIDs {
+		errs[i] = &commonv1.EntityError{
+			Id:      id,
+			Code:    connect.CodeNotFound.String(),
+			Message: "unknown ID",
+		}
+	}
+	return results, errs, nil
 }
 
 func transform[T, V any](entities []T, transformFn func(T) V) []V {
@@ -648,7 +1545,7 @@ func urlToID(url string) string {
 	return splits[len(splits)-1]
 }
 
-func transformFilm(film *swapi.Film) *filmv1.Film {
+func transformFilm(film *Film) *filmv1.Film {
 	return &filmv1.Film{
 		Id:            urlToID(film.URL),
 		Title:         film.Title,
@@ -656,6 +1553,7 @@ func transformFilm(film *swapi.Film) *filmv1.Film {
 		OpeningCrawl:  film.OpeningCrawl,
 		Director:      film.Director,
 		Producer:      film.Producer,
+		ReleaseDate:   maybeDate(film.ReleaseDate),
 		CharacterIds:  transform(film.CharacterURLs, urlToID),
 		PlanetIds:     transform(film.PlanetURLs, urlToID),
 		SpeciesIds:    transform(film.SpeciesURLs, urlToID),
@@ -668,21 +1566,24 @@ func transformFilm(film *swapi.Film) *filmv1.Film {
 
 func transformPerson(person *swapi.Person) *personv1.Person {
 	return &personv1.Person{
-		Id:          urlToID(person.URL),
-		Name:        person.Name,
-		Mass:        person.Mass,
-		HairColor:   person.HairColor,
-		SkinColor:   person.SkinColor,
-		EyeColor:    person.EyeColor,
-		BirthYear:   person.BirthYear,
-		Gender:      person.Gender,
-		HomeworldId: urlToID(person.Homeworld),
-		FilmIds:     transform(person.FilmURLs, urlToID),
-		SpeciesIds:  transform(person.SpeciesURLs, urlToID),
-		StarshipIds: transform(person.StarshipURLs, urlToID),
-		VehicleIds:  transform(person.VehicleURLs, urlToID),
-		Created:     mustTimestamp(person.Created),
-		Edited:      mustTimestamp(person.Edited),
+		Id:             urlToID(person.URL),
+		Name:           person.Name,
+		Mass:           person.Mass,
+		MassKg:         maybeFloat(person.Mass),
+		HeightCm:       maybeFloat(person.Height),
+		HairColor:      person.HairColor,
+		SkinColor:      person.SkinColor,
+		EyeColor:       person.EyeColor,
+		BirthYear:      person.BirthYear,
+		BirthYearValue: parseBirthYear(person.BirthYear),
+		Gender:         person.Gender,
+		HomeworldId:    urlToID(person.Homeworld),
+		FilmIds:        transform(person.FilmURLs, urlToID),
+		SpeciesIds:     transform(person.SpeciesURLs, urlToID),
+		StarshipIds:    transform(person.StarshipURLs, urlToID),
+		VehicleIds:     transform(person.VehicleURLs, urlToID),
+		Created:        mustTimestamp(person.Created),
+		Edited:         mustTimestamp(person.Edited),
 	}
 }
 
@@ -690,17 +1591,17 @@ func transformStarship(starship *swapi.Starship) *starshipv1.Starship {
 	return &starshipv1.Starship{
 		Id:                   urlToID(starship.URL),
 		Name:                 starship.Name,
-		Mglt:                 mustInt(starship.MGLT),
-		CargoCapacity:        mustFloat(starship.CargoCapacity),
+		Mglt:                 maybeInt[int64](starship.MGLT),
+		CargoCapacity:        maybeFloat(starship.CargoCapacity),
 		Consumable:           starship.Consumables,
-		CostInCredits:        maybeInt(starship.CostInCredits),
-		Crew:                 mustInt(starship.Crew),
-		HyperDriveRating:     mustFloat(starship.HyperdriveRating),
-		Length:               mustFloat(starship.Length),
-		Manufacturers:        transform(strings.Split(starship.Manufacturer, ","), strings.TrimSpace),
-		MaxAtmospheringSpeed: maybeInt(starship.MaxAtmospheringSpeed),
+		CostInCredits:        maybeInt[int64](starship.CostInCredits),
+		Crew:                 maybeInt[int64](starship.Crew),
+		HyperDriveRating:     maybeFloat(starship.HyperdriveRating),
+		Length:               maybeFloat(starship.Length),
+		Manufacturers:        splitManufacturers(starship.Manufacturer),
+		MaxAtmospheringSpeed: maybeInt[int64](starship.MaxAtmospheringSpeed),
 		Model:                starship.Model,
-		Passengers:           mustInt(starship.Passengers),
+		Passengers:           maybeInt[int64](starship.Passengers),
 		Class:                starship.StarshipClass,
 		PilotIds:             transform(starship.PilotURLs, urlToID),
 		FilmIds:              transform(starship.FilmURLs, urlToID),
@@ -713,13 +1614,13 @@ func transformVehicle(vehicle *swapi.Vehicle) *vehiclev1.Vehicle {
 	return &vehiclev1.Vehicle{
 		Id:            urlToID(vehicle.URL),
 		Name:          vehicle.Name,
-		CargoCapacity: mustFloat(vehicle.CargoCapacity),
-		CostInCredits: maybeInt(vehicle.CostInCredits),
-		Crew:          mustInt(vehicle.Crew),
-		Length:        mustFloat(vehicle.Length),
-		Manufacturers: transform(strings.Split(vehicle.Manufacturer, ","), strings.TrimSpace),
+		CargoCapacity: maybeFloat(vehicle.CargoCapacity),
+		CostInCredits: maybeInt[int64](vehicle.CostInCredits),
+		Crew:          maybeInt[int64](vehicle.Crew),
+		Length:        maybeFloat(vehicle.Length),
+		Manufacturers: splitManufacturers(vehicle.Manufacturer),
 		Model:         vehicle.Model,
-		Passengers:    mustInt(vehicle.Passengers),
+		Passengers:    maybeInt[int64](vehicle.Passengers),
 		Class:         vehicle.VehicleClass,
 		PilotIds:      transform(vehicle.PilotURLs, urlToID),
 		FilmIds:       transform(vehicle.FilmURLs, urlToID),
@@ -731,16 +1632,16 @@ func transformVehicle(vehicle *swapi.Vehicle) *vehiclev1.Vehicle {
 func transformSpecies(species *swapi.Species) *speciesv1.Species {
 	return &speciesv1.Species{
 		Id:              urlToID(species.URL),
-		AverageHeight:   mustFloat(species.AverageHeight),
-		AverageLifespan: mustInt(species.AverageLifespan),
+		AverageHeight:   maybeFloat(species.AverageHeight),
+		AverageLifespan: maybeInt[int64](species.AverageLifespan),
 		Classification:  species.Classification,
 		Designation:     species.Designation,
-		EyeColors:       transform(strings.Split(species.EyeColors, ","), strings.TrimSpace),
-		HairColors:      transform(strings.Split(species.HairColors, ","), strings.TrimSpace),
+		EyeColors:       splitList(species.EyeColors),
+		HairColors:      splitList(species.HairColors),
 		Language:        species.Language,
 		Name:            species.Name,
 		HomeworldId:     urlToID(species.Homeworld),
-		SkinColors:      transform(strings.Split(species.SkinColors, ","), strings.TrimSpace),
+		SkinColors:      splitList(species.SkinColors),
 		PeopleIds:       transform(species.PeopleURLs, urlToID),
 		FilmIds:         transform(species.FilmURLs, urlToID),
 		Created:         mustTimestamp(species.Created),
@@ -749,17 +1650,20 @@ func transformSpecies(species *swapi.Species) *speciesv1.Species {
 }
 
 func transformPlanet(planet *swapi.Planet) *planetv1.Planet {
+	climates, climateTypes := normalizeClimates(splitList(planet.Climate))
 	return &planetv1.Planet{
 		Id:             urlToID(planet.URL),
-		Climates:       []string{planet.Climate},
-		Diameter:       int32(mustInt(planet.Diameter)),
+		Climates:       climates,
+		ClimateTypes:   climateTypes,
+		Diameter:       maybeInt[int32](planet.Diameter),
 		Gravity:        planet.Gravity,
+		GravityRange:   parseGravity(planet.Gravity),
 		Name:           planet.Name,
-		OrbitalPeriod:  int32(mustInt(planet.OrbitalPeriod)),
-		Population:     mustFloat(planet.Population),
-		RotationPeriod: int32(mustInt(planet.RotationPeriod)),
-		SurfaceWater:   mustFloat(planet.SurfaceWater),
-		Terrains:       []string{planet.Terrain},
+		OrbitalPeriod:  maybeInt[int32](planet.OrbitalPeriod),
+		Population:     maybeFloat(planet.Population),
+		RotationPeriod: maybeInt[int32](planet.RotationPeriod),
+		SurfaceWater:   maybeFloat(planet.SurfaceWater),
+		Terrains:       normalizeVocabulary(splitList(planet.Terrain)),
 		ResidentIds:    transform(planet.ResidentURLs, urlToID),
 		FilmIds:        transform(planet.FilmURLs, urlToID),
 		Created:        mustTimestamp(planet.Created),
@@ -767,28 +1671,36 @@ func transformPlanet(planet *swapi.Planet) *planetv1.Planet {
 	}
 }
 
-func mustInt(s string) int64 {
-	v, err := strconv.ParseInt(s, 10, 64)
-	if err != nil {
-		return 0
+// maybeInt parses a numeric value from the SWAPI dataset. It returns nil for
+// values that are not a single number, like "unknown", "n/a", and ranges
+// like "30-165", so that clients can distinguish them from zero.
+func maybeInt[T ~int32 | ~int64](s string) *T {
+	v, err := strconv.ParseInt(cleanNumber(s), 10, 64)
+	if err != nil || int64(T(v)) != v {
+		return nil
 	}
-	return v
+	result := T(v)
+	return &result
 }
 
-func maybeInt(s string) *int64 {
-	v, err := strconv.ParseInt(s, 10, 64)
+// maybeFloat is like maybeInt, but for floating point values.
+func maybeFloat(s string) *float64 {
+	v, err := strconv.ParseFloat(cleanNumber(s), 64)
 	if err != nil {
 		return nil
 	}
 	return &v
 }
 
-func mustFloat(s string) float64 {
-	v, err := strconv.ParseFloat(s, 64)
-	if err != nil {
-		return 0
+// cleanNumber removes thousands separators and units, like "1,600" and
+// "1000km", from a numeric value. The value "none" means zero.
+func cleanNumber(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "none" {
+		return "0"
 	}
-	return v
+	s = strings.ReplaceAll(s, ",", "")
+	return strings.TrimSuffix(s, "km")
 }
 
 func mustTimestamp(s string) *timestamppb.Timestamp {
@@ -799,58 +1711,179 @@ func mustTimestamp(s string) *timestamppb.Timestamp {
 	return timestamppb.New(v)
 }
 
-func resolveBatch[E, R, M, W any](
+func maybeDate(s string) *date.Date {
+	v, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil
+	}
+	return &date.Date{Year: int32(v.Year()), Month: int32(v.Month()), Day: int32(v.Day())}
+}
+
+// relationOptions are the parameters of a relation resolver RPC.
+type relationOptions struct {
+	limit     int
+	pageToken string
+	orderBy   string
+	filter    string
+	// relation identifies the resolver RPC, so that its page tokens cannot
+	// be used with other relations.
+	relation     string
+	pageTokenKey []byte
+	version      uint64
+}
+
+// relationsRequest is implemented by the requests of the relation resolver
+// RPCs that return lists.
+type relationsRequest interface {
+	GetLimit() int32
+	GetPageToken() string
+	GetOrderBy() string
+	GetFilter() string
+}
+
+// relationOptions returns the options of the given request to the given
+// resolver RPC, using the store of the given context.
+func (h *Handler) relationOptions(ctx context.Context, spec connect.Spec, req relationsRequest) relationOptions {
+	_, store := h.snapshot(ctx)
+	return relationOptions{
+		limit:        int(req.GetLimit()),
+		pageToken:    req.GetPageToken(),
+		orderBy:      req.GetOrderBy(),
+		filter:       req.GetFilter(),
+		relation:     spec.Procedure,
+		pageTokenKey: h.pageTokenKey,
+		version:      store.version,
+	}
+}
+
+func resolveBatch[E entity, R entity, M, W any](
 	ctx context.Context,
+	recordFanOut func(FanOutStats),
 	entities []E,
-	limit int,
+	opts relationOptions,
 	idExtractor func(E) []string,
 	invoker func(context.Context, []string) (*connect.Response[M], error),
 	resultExtractor func(*M) []R,
-	resultStorer func([]R, *W),
+	resultStorer func(values []R, nextPageToken string, result *W),
 ) ([]*W, error) {
+	var zero R
+	ordering, err := orderby.Parse(opts.orderBy, zero.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid order_by: %w", err))
+	}
+	compiled, err := filter.Compile(opts.filter, zero.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid filter: %w", err))
+	}
+	// The ids are fetched in the order in which they are first seen, so
+	// that the downstream request is deterministic.
 	idSet := map[string]struct{}{}
+	var idSlice []string
+	var idCount int
 	idBatches := make([][]string, len(entities))
 	for i, entity := range entities {
 		ids := idExtractor(entity)
-		if limit > 0 && len(ids) > limit {
-			ids = ids[:limit]
-		}
 		for _, item := range ids {
-			idSet[item] = struct{}{}
+			if _, ok := idSet[item]; !ok {
+				idSet[item] = struct{}{}
+				idSlice = append(idSlice, item)
+			}
 		}
+		idCount += len(ids)
 		idBatches[i] = ids
 	}
-	idSlice := make([]string, 0, len(idSet))
-	for item := range idSet {
-		idSlice = append(idSlice, item)
+	if recordFanOut != nil {
+		recordFanOut(FanOutStats{Bases: len(entities), IDs: idCount, UniqueIDs: len(idSlice)})
 	}
 
 	resp, err := invoker(ctx, idSlice)
 	if err != nil {
 		return nil, err
 	}
-	results := resultExtractor(resp.Msg)
-
-	indices := map[string]int{}
-	for i, item := range idSlice {
-		indices[item] = i
+	// The invoker allows missing entities, so that a reference to an entity
+	// that does not exist only omits that entity from the results of the
+	// entities that refer to it, instead of failing the whole batch. They
+	// are omitted before filtering and paging, so they do not count toward
+	// the limit.
+	results := map[string]R{}
+	for _, item := range resultExtractor(resp.Msg) {
+		results[item.GetId()] = item
 	}
 	batchedResults := make([]*W, len(entities))
-	for i := range entities {
-		ids := idBatches[i]
+	for i, entity := range entities {
+		ids := slices.DeleteFunc(slices.Clone(idBatches[i]), func(id string) bool {
+			result, ok := results[id]
+			return !ok || !compiled.Match(result.ProtoReflect())
+		})
+		if len(ordering) > 0 {
+			slices.SortStableFunc(ids, func(a, b string) int {
+				return ordering.Compare(results[a].ProtoReflect(), results[b].ProtoReflect())
+			})
+		}
+		// Page tokens are only valid for the base entity whose result they
+		// were returned in.
+		codec := pageTokenCodec{
+			key:         opts.pageTokenKey,
+			fingerprint: listFingerprint(opts.relation+"\x00"+entity.GetId(), opts.filter, ordering),
+			version:     opts.version,
+		}
+		ids, nextPageToken, err := paginate(ids, opts.limit, opts.pageToken, codec)
+		if err != nil {
+			return nil, err
+		}
 		batch := make([]R, len(ids))
 		for j, item := range ids {
-			batch[j] = results[indices[item]]
+			batch[j] = results[item]
 		}
 		var w W
-		resultStorer(batch, &w)
+		resultStorer(batch, nextPageToken, &w)
 		batchedResults[i] = &w
 	}
 	return batchedResults, nil
 }
 
-func resolve1to1Batch[E, R, M, W any](
+// FanOutStats describes how the related entities of one call to a relation
+// resolver RPC were batched into a single request for the entities.
+type FanOutStats struct {
+	// Procedure is the name of the resolver RPC, such as
+	// "/buf.knit.demo.swapi.relations.v1.PersonResolverService/GetFilmCharacters".
+	Procedure string
+	// Bases is the number of base entities in the request.
+	Bases int
+	// IDs is the total number of references from the base entities to
+	// related entities.
+	IDs int
+	// UniqueIDs is the number of distinct related entities, which were
+	// fetched in a single request.
+	UniqueIDs int
+}
+
+// DedupeRatio returns the number of references per distinct related entity.
+// A ratio greater than one means that batching avoided fetching the same
+// entity more than once. It returns zero if there are no references.
+func (s FanOutStats) DedupeRatio() float64 {
+	if s.UniqueIDs == 0 {
+		return 0
+	}
+	return float64(s.IDs) / float64(s.UniqueIDs)
+}
+
+// fanOutRecorder returns the function that resolveBatch uses to report the
+// fan-out of a call to the given resolver RPC, or nil if fan-out is not
+// being recorded.
+func (h *Handler) fanOutRecorder(spec connect.Spec) func(FanOutStats) {
+	if h.recordFanOut == nil {
+		return nil
+	}
+	return func(stats FanOutStats) {
+		stats.Procedure = spec.Procedure
+		h.recordFanOut(stats)
+	}
+}
+
+func resolve1to1Batch[E entity, R entity, M, W any](
 	ctx context.Context,
+	recordFanOut func(FanOutStats),
 	entities []E,
 	idExtractor func(E) string,
 	invoker func(context.Context, []string) (*connect.Response[M], error),
@@ -859,8 +1892,9 @@ func resolve1to1Batch[E, R, M, W any](
 ) ([]*W, error) {
 	return resolveBatch(
 		ctx,
+		recordFanOut,
 		entities,
-		0,
+		relationOptions{},
 		func(e E) []string {
 			id := idExtractor(e)
 			if id == "" {
@@ -870,7 +1904,7 @@ func resolve1to1Batch[E, R, M, W any](
 		},
 		invoker,
 		resultExtractor,
-		func(r []R, w *W) {
+		func(r []R, _ string, w *W) {
 			if len(r) > 0 {
 				resultStorer(r[0], w)
 			}
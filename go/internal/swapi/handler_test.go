@@ -0,0 +1,421 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	commonv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/common/v1"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	relationsv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/relations/v1/relationsv1connect"
+	"github.com/peterhellberg/swapi"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTransformFilmReleaseDate(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		releaseDate string
+		want        *date.Date
+	}{
+		{releaseDate: "1977-05-25", want: &date.Date{Year: 1977, Month: 5, Day: 25}},
+		{releaseDate: "2005-05-19", want: &date.Date{Year: 2005, Month: 5, Day: 19}},
+		{releaseDate: "", want: nil},
+		{releaseDate: "May 25, 1977", want: nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.releaseDate, func(t *testing.T) {
+			t.Parallel()
+			film := transformFilm(&Film{Film: swapi.Film{Title: "A New Hope"}, ReleaseDate: testCase.releaseDate})
+			if !proto.Equal(film.GetReleaseDate(), testCase.want) {
+				t.Errorf("release date = %v, want %v", film.GetReleaseDate(), testCase.want)
+			}
+		})
+	}
+}
+
+func TestTransformNumbers(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		value     string
+		wantInt   *int64
+		wantInt32 *int32
+		wantFloat *float64
+	}{
+		{value: "1,600", wantInt: proto.Int64(1600), wantInt32: proto.Int32(1600), wantFloat: proto.Float64(1600)},
+		{value: "1000km", wantInt: proto.Int64(1000), wantInt32: proto.Int32(1000), wantFloat: proto.Float64(1000)},
+		{value: " 4 ", wantInt: proto.Int64(4), wantInt32: proto.Int32(4), wantFloat: proto.Float64(4)},
+		{value: "0.5", wantFloat: proto.Float64(0.5)},
+		// "none" is zero, but unknown values are absent rather than zero.
+		{value: "none", wantInt: proto.Int64(0), wantInt32: proto.Int32(0), wantFloat: proto.Float64(0)},
+		{value: "unknown"},
+		{value: "n/a"},
+		{value: ""},
+		{value: "30-165"},
+		{value: "3000000000", wantInt: proto.Int64(3000000000), wantFloat: proto.Float64(3000000000)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.value, func(t *testing.T) {
+			t.Parallel()
+			starship := transformStarship(&swapi.Starship{CostInCredits: testCase.value, CargoCapacity: testCase.value})
+			planet := transformPlanet(&swapi.Planet{Diameter: testCase.value})
+			person := transformPerson(&swapi.Person{Height: testCase.value})
+			if !equalPtr(starship.CostInCredits, testCase.wantInt) {
+				t.Errorf("cost_in_credits = %v, want %v", starship.CostInCredits, testCase.wantInt)
+			}
+			if !equalPtr(planet.Diameter, testCase.wantInt32) {
+				t.Errorf("diameter = %v, want %v", planet.Diameter, testCase.wantInt32)
+			}
+			if !equalPtr(starship.CargoCapacity, testCase.wantFloat) {
+				t.Errorf("cargo_capacity = %v, want %v", starship.CargoCapacity, testCase.wantFloat)
+			}
+			if !equalPtr(person.HeightCm, testCase.wantFloat) {
+				t.Errorf("height_cm = %v, want %v", person.HeightCm, testCase.wantFloat)
+			}
+		})
+	}
+}
+
+// equalPtr reports whether two pointers are both nil or point to equal
+// values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func TestGetFilmsReleaseDate(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := handler.GetFilms(context.Background(), connect.NewRequest(&filmv1.GetFilmsRequest{Ids: []string{"1"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &date.Date{Year: 1977, Month: 5, Day: 25}
+	if films := resp.Msg.GetFilms(); len(films) != 1 || !proto.Equal(films[0].GetReleaseDate(), want) {
+		t.Errorf("GetFilms returned %v, want film 1 with release date %v", films, want)
+	}
+}
+
+func TestGetFilmsAllowMissing(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name         string
+		ids          []string
+		allowMissing bool
+		wantIDs      []string
+		wantErrors   []string
+		wantCode     connect.Code
+	}{
+		{name: "all found", ids: []string{"2", "1"}, wantIDs: []string{"2", "1"}},
+		{name: "missing", ids: []string{"1", "99", "2"}, wantCode: connect.CodeNotFound},
+		{name: "allow missing", ids: []string{"1", "99", "2", "100"}, allowMissing: true, wantIDs: []string{"1", "2"}, wantErrors: []string{"99", "100"}},
+		{name: "allow missing with none found", ids: []string{"99"}, allowMissing: true, wantErrors: []string{"99"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			resp, err := handler.GetFilms(context.Background(), connect.NewRequest(&filmv1.GetFilmsRequest{
+				Ids:          testCase.ids,
+				AllowMissing: testCase.allowMissing,
+			}))
+			if testCase.wantCode != 0 {
+				if connect.CodeOf(err) != testCase.wantCode {
+					t.Errorf("GetFilms returned %v, want %v", err, testCase.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, film := range resp.Msg.GetFilms() {
+				ids = append(ids, film.GetId())
+			}
+			if !slices.Equal(ids, testCase.wantIDs) {
+				t.Errorf("films = %v, want %v", ids, testCase.wantIDs)
+			}
+			var errorIDs []string
+			for _, entityErr := range resp.Msg.GetErrors() {
+				errorIDs = append(errorIDs, entityErr.GetId())
+				want := &commonv1.EntityError{Id: entityErr.GetId(), Code: "not_found", Message: "unknown ID"}
+				if !proto.Equal(entityErr, want) {
+					t.Errorf("error = %v, want %v", entityErr, want)
+				}
+			}
+			if !slices.Equal(errorIDs, testCase.wantErrors) {
+				t.Errorf("errors for %v, want %v", errorIDs, testCase.wantErrors)
+			}
+		})
+	}
+}
+
+func TestResolverFanOut(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	var recorded []FanOutStats
+	handler, err := NewHandler(WithFanOutRecorder(func(stats FanOutStats) {
+		recorded = append(recorded, stats)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	films, err := handler.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: []string{"1", "2"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bases := films.Msg.GetFilms()
+	var wantIDs int
+	unique := map[string]struct{}{}
+	for _, film := range bases {
+		wantIDs += len(film.GetCharacterIds())
+		for _, id := range film.GetCharacterIds() {
+			unique[id] = struct{}{}
+		}
+	}
+	resp, err := handler.GetFilmCharacters(ctx, connect.NewRequest(&relationsv1.GetFilmRelationsRequest{Bases: bases}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded) != 1 {
+		t.Fatalf("recorded %d fan-outs, want 1", len(recorded))
+	}
+	stats := recorded[0]
+	if stats.Bases != 2 || stats.IDs != wantIDs || stats.UniqueIDs != len(unique) {
+		t.Errorf("fan-out = %+v, want 2 bases, %d ids, and %d unique ids", stats, wantIDs, len(unique))
+	}
+	// Many characters appear in both films, so batching must have avoided
+	// fetching some of them twice.
+	if stats.DedupeRatio() <= 1 {
+		t.Errorf("dedupe ratio = %v, want more than 1", stats.DedupeRatio())
+	}
+	values := resp.Msg.GetValues()
+	if len(values) != 2 {
+		t.Fatalf("got %d results, want 2", len(values))
+	}
+	for i, film := range bases {
+		characters := values[i].GetCharacters()
+		if len(characters) != len(film.GetCharacterIds()) {
+			t.Errorf("film %s has %d characters, want %d", film.GetId(), len(characters), len(film.GetCharacterIds()))
+			continue
+		}
+		for j, character := range characters {
+			if character.GetId() != film.GetCharacterIds()[j] {
+				t.Errorf("character %d of film %s is %s, want %s", j, film.GetId(), character.GetId(), film.GetCharacterIds()[j])
+			}
+		}
+	}
+}
+
+func TestResolverPagination(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Person 99 does not exist, so it is omitted without counting toward
+	// the limit.
+	tatooine := &planetv1.Planet{Id: "1", ResidentIds: []string{"1", "99", "2", "4", "5"}}
+	alderaan := &planetv1.Planet{Id: "2", ResidentIds: []string{"5", "99"}}
+	testCases := []struct {
+		name    string
+		limit   int32
+		orderBy string
+		filter  string
+		want    [][]string
+	}{
+		{name: "no limit", want: [][]string{{"1", "2", "4", "5"}}},
+		{name: "limit", limit: 2, want: [][]string{{"1", "2"}, {"4", "5"}}},
+		{name: "limit with dangling id on last page", limit: 3, want: [][]string{{"1", "2", "4"}, {"5"}}},
+		{name: "filter", limit: 1, filter: "gender = 'male'", want: [][]string{{"1"}, {"4"}}},
+		{name: "order", limit: 3, orderBy: "name", want: [][]string{{"2", "4", "5"}, {"1"}}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			var pages [][]string
+			var pageToken string
+			for {
+				resp, err := handler.GetPlanetResidents(ctx, connect.NewRequest(&relationsv1.GetPlanetRelationsRequest{
+					Bases:     []*planetv1.Planet{tatooine},
+					Limit:     testCase.limit,
+					OrderBy:   testCase.orderBy,
+					Filter:    testCase.filter,
+					PageToken: pageToken,
+				}))
+				if err != nil {
+					t.Fatal(err)
+				}
+				var ids []string
+				for _, person := range resp.Msg.GetValues()[0].GetResidents() {
+					ids = append(ids, person.GetId())
+				}
+				pages = append(pages, ids)
+				pageToken = resp.Msg.GetValues()[0].GetNextPageToken()
+				if pageToken == "" {
+					break
+				}
+			}
+			if !slices.EqualFunc(pages, testCase.want, slices.Equal) {
+				t.Errorf("pages = %v, want %v", pages, testCase.want)
+			}
+		})
+	}
+
+	first, err := handler.GetPlanetResidents(ctx, connect.NewRequest(&relationsv1.GetPlanetRelationsRequest{
+		Bases: []*planetv1.Planet{tatooine, alderaan},
+		Limit: 1,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := first.Msg.GetValues()[1].GetNextPageToken(); token != "" {
+		t.Errorf("alderaan has next page token %q, want none", token)
+	}
+	pageToken := first.Msg.GetValues()[0].GetNextPageToken()
+	invalidRequests := []*relationsv1.GetPlanetRelationsRequest{
+		{Bases: []*planetv1.Planet{alderaan}, PageToken: pageToken},
+		{Bases: []*planetv1.Planet{tatooine}, PageToken: pageToken, Filter: "gender = 'male'"},
+		{Bases: []*planetv1.Planet{tatooine}, PageToken: "bogus"},
+	}
+	for _, req := range invalidRequests {
+		_, err := handler.GetPlanetResidents(ctx, connect.NewRequest(req))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("GetPlanetResidents(%v) returned %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestResolverPageTokenRelation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Page tokens are tied to the resolver RPC, which is only known when
+	// the RPC is called through a server.
+	mux := http.NewServeMux()
+	mux.Handle(relationsv1connect.NewPersonResolverServiceHandler(handler))
+	mux.Handle(relationsv1connect.NewFilmResolverServiceHandler(handler))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	people := relationsv1connect.NewPersonResolverServiceClient(server.Client(), server.URL)
+	films := relationsv1connect.NewFilmResolverServiceClient(server.Client(), server.URL)
+
+	planets, err := handler.GetPlanets(ctx, connect.NewRequest(&planetv1.GetPlanetsRequest{Ids: []string{"1"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bases := planets.Msg.GetPlanets()
+	if len(bases[0].GetResidentIds()) < 2 || len(bases[0].GetFilmIds()) < 2 {
+		t.Fatalf("planet 1 has %d residents and %d films, want at least 2 of each", len(bases[0].GetResidentIds()), len(bases[0].GetFilmIds()))
+	}
+	first, err := people.GetPlanetResidents(ctx, connect.NewRequest(&relationsv1.GetPlanetRelationsRequest{Bases: bases, Limit: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pageToken := first.Msg.GetValues()[0].GetNextPageToken()
+	second, err := people.GetPlanetResidents(ctx, connect.NewRequest(&relationsv1.GetPlanetRelationsRequest{Bases: bases, PageToken: pageToken}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if residents := second.Msg.GetValues()[0].GetResidents(); len(residents) != 1 || residents[0].GetId() != bases[0].GetResidentIds()[1] {
+		t.Errorf("second page of residents = %v, want resident %s", residents, bases[0].GetResidentIds()[1])
+	}
+	_, err = films.GetPlanetFilms(ctx, connect.NewRequest(&relationsv1.GetPlanetRelationsRequest{Bases: bases, PageToken: pageToken}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("GetPlanetFilms with residents token returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestResolverFilterAndOrder(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	films, err := handler.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: []string{"1", "3"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bases := films.Msg.GetFilms()
+	resp, err := handler.GetFilmCharacters(ctx, connect.NewRequest(&relationsv1.GetFilmRelationsRequest{
+		Bases:   bases,
+		Filter:  "gender = 'male'",
+		OrderBy: "name desc",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := resp.Msg.GetValues()
+	if len(values) != len(bases) {
+		t.Fatalf("got %d results, want %d", len(values), len(bases))
+	}
+	store := handler.store.Load()
+	for i, film := range bases {
+		var want []string
+		for _, id := range film.GetCharacterIds() {
+			if store.people.byID[id].GetGender() == "male" {
+				want = append(want, id)
+			}
+		}
+		slices.SortStableFunc(want, func(a, b string) int {
+			return cmp.Compare(store.people.byID[b].GetName(), store.people.byID[a].GetName())
+		})
+		if len(want) == 0 || len(want) == len(film.GetCharacterIds()) {
+			t.Fatalf("film %s has %d matching characters, want some but not all", film.GetId(), len(want))
+		}
+		var got []string
+		for _, character := range values[i].GetCharacters() {
+			got = append(got, character.GetId())
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("characters of film %s = %v, want %v", film.GetId(), got, want)
+		}
+	}
+
+	invalidRequests := []*relationsv1.GetFilmRelationsRequest{
+		{Bases: bases, Filter: "gender ="},
+		{Bases: bases, Filter: "no_such_field = 1"},
+		{Bases: bases, OrderBy: "name sideways"},
+		{Bases: bases, OrderBy: "no_such_field"},
+	}
+	for _, req := range invalidRequests {
+		_, err := handler.GetFilmCharacters(ctx, connect.NewRequest(req))
+		if connect.CodeOf(err) != connect.CodeInvalidArgument {
+			t.Errorf("GetFilmCharacters(%v) returned %v, want InvalidArgument", req, err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// relation describes a field of one type of entity that refers to other
+// entities by ID.
+type relation struct {
+	// from is the name of the entity type that has the field.
+	from string
+	// field is the name of the field, which is either a string or a
+	// repeated string.
+	field protoreflect.Name
+	// to is the name of the entity type that the field refers to.
+	to string
+	// inverse is the name of the field of the referred-to entity that
+	// refers back to from, or empty if there is no such field.
+	inverse protoreflect.Name
+}
+
+// relations are all of the relations between entities, including the
+// inverse of each relation that has an inverse field.
+//
+//nolint:gochecknoglobals
+var relations = withInverses([]relation{
+	{from: "film", field: "character_ids", to: "person", inverse: "film_ids"},
+	{from: "film", field: "planet_ids", to: "planet", inverse: "film_ids"},
+	{from: "film", field: "species_ids", to: "species", inverse: "film_ids"},
+	{from: "film", field: "starship_ids", to: "starship", inverse: "film_ids"},
+	{from: "film", field: "vehicle_ids", to: "vehicle", inverse: "film_ids"},
+	{from: "person", field: "homeworld_id", to: "planet", inverse: "resident_ids"},
+	{from: "person", field: "species_ids", to: "species", inverse: "people_ids"},
+	{from: "person", field: "starship_ids", to: "starship", inverse: "pilot_ids"},
+	{from: "person", field: "vehicle_ids", to: "vehicle", inverse: "pilot_ids"},
+	{from: "species", field: "homeworld_id", to: "planet"},
+})
+
+func withInverses(rels []relation) []relation {
+	results := slices.Clone(rels)
+	for _, rel := range rels {
+		if rel.inverse != "" {
+			results = append(results, relation{from: rel.to, field: rel.inverse, to: rel.from, inverse: rel.field})
+		}
+	}
+	return results
+}
+
+// checkReferences verifies that all of the IDs that the given entity
+// refers to exist in the store.
+func checkReferences(store *Store, kind string, item proto.Message) error {
+	for _, rel := range relations {
+		if rel.from != kind {
+			continue
+		}
+		target := store.indexByName(rel.to)
+		for _, id := range refIDs(item.ProtoReflect(), rel.field) {
+			if _, ok := target.getMessage(id); !ok {
+				return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s refers to unknown %s %q", rel.field, rel.to, id))
+			}
+		}
+	}
+	return nil
+}
+
+// syncInverses updates the inverse relations of the entities that are
+// referred to by an entity that is being changed from oldItem to newItem.
+// Entities that are newly referred to are changed to refer back to the
+// entity, and entities that are no longer referred to are changed to no
+// longer refer back to it. The oldItem is nil when the entity is being
+// created.
+func syncInverses(store *Store, kind string, oldItem, newItem proto.Message, now *timestamppb.Timestamp) {
+	id := newItem.(entity).GetId() //nolint:forcetypeassert,errcheck
+	for _, rel := range relations {
+		if rel.from != kind || rel.inverse == "" {
+			continue
+		}
+		var oldRefs []string
+		if oldItem != nil {
+			oldRefs = refIDs(oldItem.ProtoReflect(), rel.field)
+		}
+		newRefs := refIDs(newItem.ProtoReflect(), rel.field)
+		target := store.indexByName(rel.to)
+		for _, ref := range oldRefs {
+			if slices.Contains(newRefs, ref) {
+				continue
+			}
+			changeEntity(target, ref, now, func(msg protoreflect.Message) {
+				removeRef(msg, rel.inverse, id)
+			})
+		}
+		for _, ref := range newRefs {
+			if slices.Contains(oldRefs, ref) {
+				continue
+			}
+			changeEntity(target, ref, now, func(msg protoreflect.Message) {
+				if displaced := addRef(msg, rel.inverse, id); displaced != "" {
+					// The inverse field can only refer to one entity, and
+					// it previously referred to another. So that other
+					// entity must no longer refer to this target.
+					changeEntity(store.indexByName(kind), displaced, now, func(msg protoreflect.Message) {
+						removeRef(msg, rel.field, ref)
+					})
+				}
+			})
+		}
+	}
+}
+
+// removeReferences handles references to an entity that is being deleted.
+// If cascade is false, it returns an error if any other entity refers to
+// it. Otherwise, it removes all such references.
+func removeReferences(store *Store, kind string, id string, cascade bool, now *timestamppb.Timestamp) error {
+	for _, rel := range relations {
+		if rel.to != kind {
+			continue
+		}
+		source := store.indexByName(rel.from)
+		var referrers []string
+		for _, msg := range source.messages() {
+			if slices.Contains(refIDs(msg.ProtoReflect(), rel.field), id) {
+				referrers = append(referrers, msg.(entity).GetId()) //nolint:forcetypeassert,errcheck
+			}
+		}
+		if len(referrers) == 0 {
+			continue
+		}
+		if !cascade {
+			return connect.NewError(
+				connect.CodeFailedPrecondition,
+				fmt.Errorf("%s %q is referred to by the %s field of %s %s; use cascade to remove these references",
+					kind, id, rel.field, rel.from, strings.Join(referrers, ", ")),
+			)
+		}
+		for _, referrer := range referrers {
+			changeEntity(source, referrer, now, func(msg protoreflect.Message) {
+				removeRef(msg, rel.field, id)
+			})
+		}
+	}
+	return nil
+}
+
+// changeEntity replaces the entity with the given ID in the given index with
+// a modified copy. The copy's edited timestamp is set to now.
+func changeEntity(index messageIndex, id string, now *timestamppb.Timestamp, changeFn func(msg protoreflect.Message)) {
+	existing, ok := index.getMessage(id)
+	if !ok {
+		return
+	}
+	updated := proto.Clone(existing)
+	changeFn(updated.ProtoReflect())
+	setEntityField(updated, "edited", protoreflect.ValueOfMessage(now.ProtoReflect()))
+	index.putMessage(updated)
+}
+
+// refIDs returns the IDs in the given field, which is either a string or a
+// repeated string.
+func refIDs(msg protoreflect.Message, field protoreflect.Name) []string {
+	fieldDesc := msg.Descriptor().Fields().ByName(field)
+	if !fieldDesc.IsList() {
+		if id := msg.Get(fieldDesc).String(); id != "" {
+			return []string{id}
+		}
+		return nil
+	}
+	list := msg.Get(fieldDesc).List()
+	ids := make([]string, list.Len())
+	for i := range list.Len() {
+		ids[i] = list.Get(i).String()
+	}
+	return ids
+}
+
+// addRef adds the given ID to the given field. If the field is a single
+// string that previously held a different ID, that ID is returned.
+func addRef(msg protoreflect.Message, field protoreflect.Name, id string) (displaced string) {
+	fieldDesc := msg.Descriptor().Fields().ByName(field)
+	if !fieldDesc.IsList() {
+		if prev := msg.Get(fieldDesc).String(); prev != id {
+			displaced = prev
+		}
+		msg.Set(fieldDesc, protoreflect.ValueOfString(id))
+		return displaced
+	}
+	if !slices.Contains(refIDs(msg, field), id) {
+		msg.Mutable(fieldDesc).List().Append(protoreflect.ValueOfString(id))
+	}
+	return ""
+}
+
+// removeRef removes the given ID from the given field.
+func removeRef(msg protoreflect.Message, field protoreflect.Name, id string) {
+	fieldDesc := msg.Descriptor().Fields().ByName(field)
+	if !fieldDesc.IsList() {
+		if msg.Get(fieldDesc).String() == id {
+			msg.Clear(fieldDesc)
+		}
+		return
+	}
+	ids := slices.DeleteFunc(refIDs(msg, field), func(ref string) bool { return ref == id })
+	list := msg.NewField(fieldDesc).List()
+	for _, ref := range ids {
+		list.Append(protoreflect.ValueOfString(ref))
+	}
+	msg.Set(fieldDesc, protoreflect.ValueOfList(list))
+}
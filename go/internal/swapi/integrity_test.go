@@ -0,0 +1,203 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	"github.com/peterhellberg/swapi"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestReferentialIntegrity(t *testing.T) {
+	t.Parallel()
+	// Luke lives on Tatooine and Leia on Alderaan, and both are in film 1.
+	dataset := &Dataset{
+		Films: []*Film{{Film: swapi.Film{
+			Title:         "A New Hope",
+			URL:           "https://swapi.dev/api/films/1/",
+			CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/5/"},
+		}}},
+		People: []*swapi.Person{
+			{Name: "Luke Skywalker", URL: "https://swapi.dev/api/people/1/", Homeworld: "https://swapi.dev/api/planets/1/", FilmURLs: []string{"https://swapi.dev/api/films/1/"}},
+			{Name: "Leia Organa", URL: "https://swapi.dev/api/people/5/", Homeworld: "https://swapi.dev/api/planets/2/", FilmURLs: []string{"https://swapi.dev/api/films/1/"}},
+		},
+		Planets: []*swapi.Planet{
+			{Name: "Tatooine", URL: "https://swapi.dev/api/planets/1/", ResidentURLs: []string{"https://swapi.dev/api/people/1/"}},
+			{Name: "Alderaan", URL: "https://swapi.dev/api/planets/2/", ResidentURLs: []string{"https://swapi.dev/api/people/5/"}},
+		},
+	}
+	testCases := []struct {
+		name     string
+		mutate   func(context.Context, *Handler) error
+		wantCode connect.Code
+		// check verifies the store after a successful mutation.
+		check func(t *testing.T, store *Store)
+	}{
+		{
+			name: "unknown reference",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.CreatePerson(ctx, connect.NewRequest(&personv1.CreatePersonRequest{Person: &personv1.Person{
+					Name: "Biggs Darklighter", HomeworldId: "99",
+				}}))
+				return err
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "create adds inverse",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.CreatePerson(ctx, connect.NewRequest(&personv1.CreatePersonRequest{Person: &personv1.Person{
+					Id: "9", Name: "Biggs Darklighter", HomeworldId: "1", FilmIds: []string{"1"},
+				}}))
+				return err
+			},
+			check: func(t *testing.T, store *Store) {
+				t.Helper()
+				checkIDs(t, "planet 1 resident_ids", store.planets.byID["1"].GetResidentIds(), "1", "9")
+				checkIDs(t, "film 1 character_ids", store.films.byID["1"].GetCharacterIds(), "1", "5", "9")
+			},
+		},
+		{
+			name: "update removes inverse",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdateFilm(ctx, connect.NewRequest(&filmv1.UpdateFilmRequest{
+					Film:       &filmv1.Film{Id: "1", CharacterIds: []string{"1"}},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"character_ids"}},
+				}))
+				return err
+			},
+			check: func(t *testing.T, store *Store) {
+				t.Helper()
+				checkIDs(t, "person 1 film_ids", store.people.byID["1"].GetFilmIds(), "1")
+				checkIDs(t, "person 5 film_ids", store.people.byID["5"].GetFilmIds())
+			},
+		},
+		{
+			name: "update of inverse side",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdatePlanet(ctx, connect.NewRequest(&planetv1.UpdatePlanetRequest{
+					Planet:     &planetv1.Planet{Id: "1", ResidentIds: []string{"1", "5"}},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"resident_ids"}},
+				}))
+				return err
+			},
+			check: func(t *testing.T, store *Store) {
+				t.Helper()
+				// Leia can only have one homeworld, so Alderaan loses her.
+				if got := store.people.byID["5"].GetHomeworldId(); got != "1" {
+					t.Errorf("person 5 homeworld_id = %q, want 1", got)
+				}
+				checkIDs(t, "planet 2 resident_ids", store.planets.byID["2"].GetResidentIds())
+			},
+		},
+		{
+			name: "change homeworld",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.UpdatePerson(ctx, connect.NewRequest(&personv1.UpdatePersonRequest{
+					Person:     &personv1.Person{Id: "1", HomeworldId: "2"},
+					UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"homeworld_id"}},
+				}))
+				return err
+			},
+			check: func(t *testing.T, store *Store) {
+				t.Helper()
+				checkIDs(t, "planet 1 resident_ids", store.planets.byID["1"].GetResidentIds())
+				checkIDs(t, "planet 2 resident_ids", store.planets.byID["2"].GetResidentIds(), "5", "1")
+			},
+		},
+		{
+			name: "restrict delete",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.DeletePlanet(ctx, connect.NewRequest(&planetv1.DeletePlanetRequest{Id: "2"}))
+				return err
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name: "cascade delete",
+			mutate: func(ctx context.Context, h *Handler) error {
+				_, err := h.DeletePerson(ctx, connect.NewRequest(&personv1.DeletePersonRequest{Id: "5", Cascade: true}))
+				return err
+			},
+			check: func(t *testing.T, store *Store) {
+				t.Helper()
+				if _, ok := store.people.byID["5"]; ok {
+					t.Error("person 5 was not deleted")
+				}
+				checkIDs(t, "film 1 character_ids", store.films.byID["1"].GetCharacterIds(), "1")
+				checkIDs(t, "planet 2 resident_ids", store.planets.byID["2"].GetResidentIds())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			handler, err := NewHandler(WithDataSource(NewMemoryDataSource(dataset)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Mutations replace the store with a modified copy, so the
+			// previous store must not change.
+			prev := handler.store.Load()
+			want := cloneStoreEntities(prev)
+			err = testCase.mutate(context.Background(), handler)
+			if got := cloneStoreEntities(prev); !slices.EqualFunc(got, want, proto.Equal) {
+				t.Error("mutation modified the previous store")
+			}
+			if testCase.wantCode != 0 {
+				if connect.CodeOf(err) != testCase.wantCode {
+					t.Fatalf("mutation returned %v, want code %v", err, testCase.wantCode)
+				}
+				if handler.store.Load() != prev {
+					t.Error("failed mutation replaced the store")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			testCase.check(t, handler.store.Load())
+		})
+	}
+}
+
+// checkIDs reports an error if the given IDs are not the wanted ones, in
+// the same order.
+func checkIDs(t *testing.T, name string, got []string, want ...string) {
+	t.Helper()
+	if !slices.Equal(got, want) && (len(got) != 0 || len(want) != 0) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+// cloneStoreEntities returns copies of all of the entities in the store, in
+// a consistent order.
+func cloneStoreEntities(store *Store) []proto.Message {
+	var results []proto.Message
+	for _, kind := range entityTypeNames {
+		for _, msg := range store.indexByName(kind).messages() {
+			results = append(results, proto.Clone(msg))
+		}
+	}
+	return results
+}
@@ -0,0 +1,379 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/peterhellberg/swapi"
+)
+
+// JSONDirDataSource is a DataSource that reads the data from JSON files in a
+// directory. The directory must contain the files "films.json",
+// "people.json", "planets.json", "species.json", "starships.json", and
+// "vehicles.json". Each file may contain either a JSON array of entities or
+// a page of results in the same format that is returned by swapi.dev.
+//
+// The files are read and validated each time the data source is loaded.
+// Every entity must have a URL that identifies it, and every reference to
+// another entity must refer to one that is defined in the dataset. Problems
+// are reported with the file and line of the offending entity.
+type JSONDirDataSource struct {
+	dir string
+}
+
+// NewJSONDirDataSource returns a DataSource that reads JSON files in the
+// given directory.
+func NewJSONDirDataSource(dir string) *JSONDirDataSource {
+	return &JSONDirDataSource{dir: dir}
+}
+
+// ModTime returns the most recent modification time of the data files in
+// the directory. This can be polled to detect when the data has changed and
+// should be reloaded.
+func (j *JSONDirDataSource) ModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{"films.json", "people.json", "planets.json", "species.json", "starships.json", "vehicles.json"} {
+		info, err := os.Stat(filepath.Join(j.dir, name))
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// Load implements DataSource.
+func (j *JSONDirDataSource) Load(context.Context) (*Dataset, error) {
+	films, err := readJSONFile[Film](filepath.Join(j.dir, "films.json"))
+	if err != nil {
+		return nil, err
+	}
+	people, err := readJSONFile[swapi.Person](filepath.Join(j.dir, "people.json"))
+	if err != nil {
+		return nil, err
+	}
+	planets, err := readJSONFile[swapi.Planet](filepath.Join(j.dir, "planets.json"))
+	if err != nil {
+		return nil, err
+	}
+	species, err := readJSONFile[swapi.Species](filepath.Join(j.dir, "species.json"))
+	if err != nil {
+		return nil, err
+	}
+	starships, err := readJSONFile[swapi.Starship](filepath.Join(j.dir, "starships.json"))
+	if err != nil {
+		return nil, err
+	}
+	vehicles, err := readJSONFile[swapi.Vehicle](filepath.Join(j.dir, "vehicles.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var v datasetValidator
+	filmIDs := checkEntities(&v, films, func(f *Film) entityFields {
+		return entityFields{url: f.URL, name: f.Title, created: f.Created, edited: f.Edited}
+	})
+	peopleIDs := checkEntities(&v, people, func(p *swapi.Person) entityFields {
+		return entityFields{url: p.URL, name: p.Name, created: p.Created, edited: p.Edited}
+	})
+	planetIDs := checkEntities(&v, planets, func(p *swapi.Planet) entityFields {
+		return entityFields{url: p.URL, name: p.Name, created: p.Created, edited: p.Edited}
+	})
+	speciesIDs := checkEntities(&v, species, func(s *swapi.Species) entityFields {
+		return entityFields{url: s.URL, name: s.Name, created: s.Created, edited: s.Edited}
+	})
+	starshipIDs := checkEntities(&v, starships, func(s *swapi.Starship) entityFields {
+		return entityFields{url: s.URL, name: s.Name, created: s.Created, edited: s.Edited}
+	})
+	vehicleIDs := checkEntities(&v, vehicles, func(s *swapi.Vehicle) entityFields {
+		return entityFields{url: s.URL, name: s.Name, created: s.Created, edited: s.Edited}
+	})
+
+	for _, film := range films {
+		if film.value.ReleaseDate != "" {
+			if _, err := time.Parse(time.DateOnly, film.value.ReleaseDate); err != nil {
+				v.addf(film.pos, "invalid release_date %q", film.value.ReleaseDate)
+			}
+		}
+		v.checkRefs(film.pos, "characters", film.value.CharacterURLs, peopleIDs)
+		v.checkRefs(film.pos, "planets", film.value.PlanetURLs, planetIDs)
+		v.checkRefs(film.pos, "species", film.value.SpeciesURLs, speciesIDs)
+		v.checkRefs(film.pos, "starships", film.value.StarshipURLs, starshipIDs)
+		v.checkRefs(film.pos, "vehicles", film.value.VehicleURLs, vehicleIDs)
+	}
+	for _, person := range people {
+		v.checkRef(person.pos, "homeworld", person.value.Homeworld, planetIDs)
+		v.checkRefs(person.pos, "films", person.value.FilmURLs, filmIDs)
+		v.checkRefs(person.pos, "species", person.value.SpeciesURLs, speciesIDs)
+		v.checkRefs(person.pos, "starships", person.value.StarshipURLs, starshipIDs)
+		v.checkRefs(person.pos, "vehicles", person.value.VehicleURLs, vehicleIDs)
+	}
+	for _, planet := range planets {
+		v.checkRefs(planet.pos, "residents", planet.value.ResidentURLs, peopleIDs)
+		v.checkRefs(planet.pos, "films", planet.value.FilmURLs, filmIDs)
+	}
+	for _, s := range species {
+		v.checkRef(s.pos, "homeworld", s.value.Homeworld, planetIDs)
+		v.checkRefs(s.pos, "people", s.value.PeopleURLs, peopleIDs)
+		v.checkRefs(s.pos, "films", s.value.FilmURLs, filmIDs)
+	}
+	for _, starship := range starships {
+		v.checkRefs(starship.pos, "pilots", starship.value.PilotURLs, peopleIDs)
+		v.checkRefs(starship.pos, "films", starship.value.FilmURLs, filmIDs)
+	}
+	for _, vehicle := range vehicles {
+		v.checkRefs(vehicle.pos, "pilots", vehicle.value.PilotURLs, peopleIDs)
+		v.checkRefs(vehicle.pos, "films", vehicle.value.FilmURLs, filmIDs)
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	return &Dataset{
+		Films:     values(films),
+		People:    values(people),
+		Planets:   values(planets),
+		Species:   values(species),
+		Starships: values(starships),
+		Vehicles:  values(vehicles),
+	}, nil
+}
+
+// maxValidationProblems is the maximum number of problems that are reported
+// when a dataset fails validation.
+const maxValidationProblems = 20
+
+// position is a location in a JSON file.
+type position struct {
+	file string
+	line int
+	col  int
+}
+
+func (p position) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.file, p.line, p.col)
+}
+
+func positionAt(file string, data []byte, offset int64) position {
+	offset = min(max(offset, 0), int64(len(data)))
+	before := data[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	col := len(before) - bytes.LastIndexByte(before, '\n')
+	return position{file: file, line: line, col: col}
+}
+
+// jsonEntity is an entity that was read from a JSON file, along with the
+// position in the file where it is defined.
+type jsonEntity[T any] struct {
+	value *T
+	pos   position
+}
+
+func values[T any](entities []jsonEntity[T]) []*T {
+	results := make([]*T, len(entities))
+	for i, entity := range entities {
+		results[i] = entity.value
+	}
+	return results
+}
+
+// readJSONFile reads the entities in the given file, which must contain
+// either a JSON array of entities or a swapi.dev page of results.
+func readJSONFile[T any](path string) ([]jsonEntity[T], error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(data) {
+		// Unmarshal again to get an error with the offset of the problem.
+		var syntaxErr *json.SyntaxError
+		if err := json.Unmarshal(data, new(any)); errors.As(err, &syntaxErr) {
+			return nil, fmt.Errorf("%v: %w", positionAt(path, data, syntaxErr.Offset-1), err)
+		}
+		return nil, fmt.Errorf("%s: invalid JSON", path)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	switch tok {
+	case json.Delim('['):
+		return readJSONArray[T](path, data, dec)
+	case json.Delim('{'):
+		// This is a page of results: look for the "results" array.
+		var results []jsonEntity[T]
+		var found bool
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			if key != "results" {
+				if err := dec.Decode(new(json.RawMessage)); err != nil {
+					return nil, fmt.Errorf("%s: %w", path, err)
+				}
+				continue
+			}
+			offset := dec.InputOffset()
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			if tok != json.Delim('[') {
+				return nil, fmt.Errorf("%v: \"results\" must be an array", positionAt(path, data, skipSeparators(data, offset)))
+			}
+			if results, err = readJSONArray[T](path, data, dec); err != nil {
+				return nil, err
+			}
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("%s: expecting an array of entities or an object with a \"results\" array", path)
+		}
+		return results, nil
+	default:
+		return nil, fmt.Errorf("%s: expecting an array of entities or an object with a \"results\" array", path)
+	}
+}
+
+// readJSONArray reads the elements of an array whose opening bracket has
+// already been consumed from dec, through the closing bracket.
+func readJSONArray[T any](path string, data []byte, dec *json.Decoder) ([]jsonEntity[T], error) {
+	var results []jsonEntity[T]
+	for dec.More() {
+		start := skipSeparators(data, dec.InputOffset())
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("%v: %w", positionAt(path, data, start), err)
+		}
+		value := new(T)
+		if err := json.Unmarshal(raw, value); err != nil {
+			offset := start
+			var typeErr *json.UnmarshalTypeError
+			if errors.As(err, &typeErr) {
+				offset += typeErr.Offset
+			}
+			return nil, fmt.Errorf("%v: %w", positionAt(path, data, offset), err)
+		}
+		results = append(results, jsonEntity[T]{value: value, pos: positionAt(path, data, start)})
+	}
+	// consume closing bracket
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return results, nil
+}
+
+// skipSeparators returns the offset of the first byte at or after the given
+// offset that is not whitespace or a separator between JSON values.
+func skipSeparators(data []byte, offset int64) int64 {
+	for offset < int64(len(data)) {
+		switch data[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+// entityFields are the fields common to all entities that are checked by
+// datasetValidator.
+type entityFields struct {
+	url     string
+	name    string
+	created string
+	edited  string
+}
+
+// datasetValidator accumulates problems found while validating a dataset.
+type datasetValidator struct {
+	problems []error
+}
+
+func (v *datasetValidator) addf(pos position, format string, args ...any) {
+	v.problems = append(v.problems, fmt.Errorf("%v: %s", pos, fmt.Sprintf(format, args...)))
+}
+
+func (v *datasetValidator) err() error {
+	if len(v.problems) > maxValidationProblems {
+		remaining := len(v.problems) - maxValidationProblems
+		v.problems = append(v.problems[:maxValidationProblems], fmt.Errorf("... and %d more problems", remaining))
+	}
+	return errors.Join(v.problems...)
+}
+
+// checkEntities validates the fields common to all entities and returns the
+// set of IDs of the given entities.
+func checkEntities[T any](v *datasetValidator, entities []jsonEntity[T], fieldsFn func(*T) entityFields) map[string]struct{} {
+	ids := make(map[string]position, len(entities))
+	for _, entity := range entities {
+		fields := fieldsFn(entity.value)
+		if fields.name == "" {
+			v.addf(entity.pos, "missing name")
+		}
+		for _, timestamp := range []struct{ name, value string }{{"created", fields.created}, {"edited", fields.edited}} {
+			if timestamp.value == "" {
+				continue
+			}
+			if _, err := time.Parse(time.RFC3339, timestamp.value); err != nil {
+				v.addf(entity.pos, "invalid %s timestamp %q", timestamp.name, timestamp.value)
+			}
+		}
+		id := urlToID(fields.url)
+		if id == "" {
+			v.addf(entity.pos, "missing url")
+			continue
+		}
+		if prev, ok := ids[id]; ok {
+			v.addf(entity.pos, "duplicate id %q (first defined at %v)", id, prev)
+			continue
+		}
+		ids[id] = entity.pos
+	}
+	idSet := make(map[string]struct{}, len(ids))
+	for id := range ids {
+		idSet[id] = struct{}{}
+	}
+	return idSet
+}
+
+func (v *datasetValidator) checkRefs(pos position, fieldName string, urls []string, ids map[string]struct{}) {
+	for _, url := range urls {
+		v.checkRef(pos, fieldName, url, ids)
+	}
+}
+
+func (v *datasetValidator) checkRef(pos position, fieldName string, url string, ids map[string]struct{}) {
+	if url == "" {
+		return
+	}
+	if _, ok := ids[urlToID(url)]; !ok {
+		v.addf(pos, "%s refers to unknown entity %q", fieldName, url)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestJSONDirDataSourceLoad(t *testing.T) {
+	t.Parallel()
+	// The people and starships are pages of results, like those returned by
+	// swapi.dev, and the other files are plain arrays.
+	dataset, err := NewJSONDirDataSource(filepath.Join("testdata", "jsondir", "valid")).Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := []int{len(dataset.Films), len(dataset.People), len(dataset.Planets), len(dataset.Species), len(dataset.Starships), len(dataset.Vehicles)}
+	if want := []int{1, 1, 1, 0, 0, 0}; !slices.Equal(counts, want) {
+		t.Fatalf("loaded %v entities of each type, want %v", counts, want)
+	}
+	if got := dataset.Films[0].ReleaseDate; got != "1977-05-25" {
+		t.Errorf("release_date = %q, want 1977-05-25", got)
+	}
+	if got := dataset.People[0].Name; got != "Luke Skywalker" {
+		t.Errorf("name = %q, want Luke Skywalker", got)
+	}
+}
+
+func TestJSONDirDataSourceErrors(t *testing.T) {
+	t.Parallel()
+	dir := filepath.Join("testdata", "jsondir", "invalid")
+	_, err := NewJSONDirDataSource(dir).Load(context.Background())
+	if err == nil {
+		t.Fatal("Load succeeded, want an error")
+	}
+	films, people := filepath.Join(dir, "films.json"), filepath.Join(dir, "people.json")
+	want := []string{
+		films + `:3:3: duplicate id "1" (first defined at ` + films + `:2:3)`,
+		people + `:3:5: invalid edited timestamp "yesterday"`,
+		films + `:2:3: invalid release_date "May 25, 1977"`,
+		people + `:4:5: homeworld refers to unknown entity "https://swapi.dev/api/planets/2/"`,
+	}
+	if got := strings.Split(err.Error(), "\n"); !slices.Equal(got, want) {
+		t.Errorf("Load returned:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestJSONDirDataSourceErrorLimit(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	var films []string
+	for i := range maxValidationProblems + 5 {
+		films = append(films, fmt.Sprintf(`{"url": "https://swapi.dev/api/films/%d/"}`, i+1))
+	}
+	files := map[string]string{
+		"films.json":     "[" + strings.Join(films, ",\n") + "]",
+		"people.json":    "[]",
+		"planets.json":   "[]",
+		"species.json":   "[]",
+		"starships.json": "[]",
+		"vehicles.json":  "[]",
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := NewJSONDirDataSource(dir).Load(context.Background())
+	if err == nil {
+		t.Fatal("Load succeeded, want an error")
+	}
+	lines := strings.Split(err.Error(), "\n")
+	if len(lines) != maxValidationProblems+1 {
+		t.Fatalf("Load reported %d lines, want %d", len(lines), maxValidationProblems+1)
+	}
+	if want := filepath.Join(dir, "films.json") + ":1:2: missing name"; lines[0] != want {
+		t.Errorf("first problem = %q, want %q", lines[0], want)
+	}
+	if want := "... and 5 more problems"; lines[maxValidationProblems] != want {
+		t.Errorf("last line = %q, want %q", lines[maxValidationProblems], want)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/knit-demo/go/internal/filter"
+	"github.com/bufbuild/knit-demo/go/internal/orderby"
+)
+
+// listOptions are the parameters of a List RPC.
+type listOptions struct {
+	pageSize  int
+	pageToken string
+	filter    string
+	orderBy   string
+}
+
+const (
+	// defaultChunkSize is the number of entities in each response of a
+	// Stream RPC if the request does not specify a chunk size.
+	defaultChunkSize = 100
+	// maxChunkSize is the maximum number of entities in each response of a
+	// Stream RPC.
+	maxChunkSize = 1000
+)
+
+// pageTokenTTL is how long a page token remains valid after it is issued.
+const pageTokenTTL = time.Hour
+
+// pageToken is the content of the page tokens returned by List RPCs. It is
+// serialized as JSON, followed by an HMAC of the JSON that is computed with
+// the Handler's page token key, and then base64-encoded. Since clients
+// cannot forge tokens, the offset in a valid token is always in range for the
+// request that it is used with.
+type pageToken struct {
+	// Offset is the index of the first entity in the next page.
+	Offset int `json:"o"`
+	// PageSize is the page size of the request that returned the token. It
+	// is used if the next request does not specify a page size.
+	PageSize int `json:"n,omitempty"`
+	// Fingerprint identifies the type of entity, filter, and ordering of
+	// the request that returned the token. Subsequent requests must have
+	// the same fingerprint.
+	Fingerprint string `json:"f"`
+	// Version is the version of the store that was used by the request
+	// that returned the token. Subsequent requests must see the same
+	// version, so that pages are consistent with each other.
+	Version uint64 `json:"v"`
+	// Expiry is the time, in Unix seconds, after which the token is no
+	// longer valid.
+	Expiry int64 `json:"e"`
+}
+
+// listEntities returns the page of entities of the given type that is
+// indicated by the given options, along with the token for the next page.
+func listEntities[T entity](h *Handler, store *Store, kind entityType[T], opts listOptions) ([]T, string, error) {
+	entities, err := filterEntities(kind.index(store).all, opts.filter)
+	if err != nil {
+		return nil, "", err
+	}
+	var zero T
+	ordering, err := orderby.Parse(opts.orderBy, zero.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid order_by: %w", err))
+	}
+	if len(ordering) > 0 {
+		entities = slices.Clone(entities)
+		slices.SortStableFunc(entities, func(a, b T) int {
+			return ordering.Compare(a.ProtoReflect(), b.ProtoReflect())
+		})
+	}
+	codec := pageTokenCodec{
+		key:         h.pageTokenKey,
+		fingerprint: listFingerprint(kind.name, opts.filter, ordering),
+		version:     store.version,
+	}
+	return paginate(entities, opts.pageSize, opts.pageToken, codec)
+}
+
+// streamEntities sends the entities of the given type that match the given
+// filter expression to the stream, in chunks of the given size. Sending a
+// chunk blocks until the client is ready to receive it, so the context is
+// checked before each chunk to stop promptly when the client goes away.
+func streamEntities[T entity, R any](
+	ctx context.Context,
+	store *Store,
+	kind entityType[T],
+	filterExpr string,
+	chunkSize int,
+	stream *connect.ServerStream[R],
+	newResponse func([]T) *R,
+) error {
+	switch {
+	case chunkSize < 0:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("chunk_size cannot be negative"))
+	case chunkSize == 0:
+		chunkSize = defaultChunkSize
+	case chunkSize > maxChunkSize:
+		chunkSize = maxChunkSize
+	}
+	entities, err := filterEntities(kind.index(store).all, filterExpr)
+	if err != nil {
+		return err
+	}
+	for chunk := range slices.Chunk(entities, chunkSize) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := stream.Send(newResponse(chunk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filterEntities returns the entities that match the given filter
+// expression. It returns an InvalidArgument error if the expression is
+// invalid.
+func filterEntities[T entity](entities []T, expr string) ([]T, error) {
+	var zero T
+	compiled, err := filter.Compile(expr, zero.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid filter: %w", err))
+	}
+	if compiled == nil {
+		return entities, nil
+	}
+	var results []T
+	for _, item := range entities {
+		if compiled.Match(item.ProtoReflect()) {
+			results = append(results, item)
+		}
+	}
+	return results, nil
+}
+
+func paginate[T any](entities []T, pageSize int, token string, codec pageTokenCodec) ([]T, string, error) {
+	start := 0
+	if token != "" {
+		decoded, err := codec.decode(token, time.Now())
+		if err != nil {
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page_token: %w", err))
+		}
+		if decoded.Offset < 0 || decoded.Offset > len(entities) {
+			// This should not be possible for a token that the server
+			// created, but it must never cause a panic.
+			return nil, "", connect.NewError(connect.CodeInvalidArgument, errors.New("invalid page_token: offset out of range"))
+		}
+		start = decoded.Offset
+		if pageSize <= 0 {
+			pageSize = decoded.PageSize
+		}
+	}
+	end := len(entities)
+	if pageSize > 0 && start+pageSize < end {
+		end = start + pageSize
+	}
+	var nextPageToken string
+	if end < len(entities) {
+		nextPageToken = codec.encode(pageToken{Offset: end, PageSize: pageSize}, time.Now())
+	}
+	return entities[start:end], nextPageToken, nil
+}
+
+// pageTokenCodec creates and validates the page tokens for one List request.
+type pageTokenCodec struct {
+	key         []byte
+	fingerprint string
+	version     uint64
+}
+
+func (c pageTokenCodec) encode(token pageToken, now time.Time) string {
+	token.Fingerprint = c.fingerprint
+	token.Version = c.version
+	token.Expiry = now.Add(pageTokenTTL).Unix()
+	data, _ := json.Marshal(token) //nolint:errchkjson // cannot fail
+	return base64.RawURLEncoding.EncodeToString(append(data, tokenMAC(c.key, data)...))
+}
+
+func (c pageTokenCodec) decode(token string, now time.Time) (pageToken, error) {
+	var decoded pageToken
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) < sha256.Size {
+		return decoded, errors.New("malformed token")
+	}
+	data, mac := data[:len(data)-sha256.Size], data[len(data)-sha256.Size:]
+	if !hmac.Equal(mac, tokenMAC(c.key, data)) {
+		return decoded, errors.New("malformed token")
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return decoded, errors.New("malformed token")
+	}
+	switch {
+	case now.Unix() > decoded.Expiry:
+		return decoded, errors.New("token has expired; restart from the first page")
+	case decoded.Fingerprint != c.fingerprint:
+		return decoded, errors.New("token was returned for a request with a different filter or order_by")
+	case decoded.Version != c.version:
+		return decoded, errors.New("the data has changed since the token was returned; restart from the first page")
+	}
+	return decoded, nil
+}
+
+// tokenMAC returns the HMAC of the given token data, which authenticates
+// tokens that are returned to clients.
+func tokenMAC(key, data []byte) []byte {
+	hash := hmac.New(sha256.New, key)
+	_, _ = hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// listFingerprint returns a short hash that identifies the results of a List
+// request, excluding pagination.
+func listFingerprint(kind string, filterExpr string, ordering orderby.Ordering) string {
+	hash := sha256.Sum256([]byte(kind + "\x00" + strings.TrimSpace(filterExpr) + "\x00" + ordering.String()))
+	return base64.RawURLEncoding.EncodeToString(hash[:8])
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	"github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1/filmv1connect"
+	"github.com/peterhellberg/swapi"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1700000000, 0)
+	codec := pageTokenCodec{key: []byte("secret"), fingerprint: "films", version: 3}
+	token := codec.encode(pageToken{Offset: 10, PageSize: 5}, now)
+
+	decoded, err := codec.decode(token, now.Add(pageTokenTTL))
+	if err != nil {
+		t.Fatalf("decode returned error: %v", err)
+	}
+	if decoded.Offset != 10 || decoded.PageSize != 5 {
+		t.Errorf("decode returned offset %d and page size %d, want 10 and 5", decoded.Offset, decoded.PageSize)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := slices.Clone(raw)
+	tampered[5] ^= 1
+	otherKey := codec
+	otherKey.key = []byte("other secret")
+	otherFingerprint := codec
+	otherFingerprint.fingerprint = "people"
+	otherVersion := codec
+	otherVersion.version = 4
+	testCases := []struct {
+		name    string
+		codec   pageTokenCodec
+		token   string
+		now     time.Time
+		wantErr string
+	}{
+		{name: "not base64", codec: codec, token: "!!!", now: now, wantErr: "malformed token"},
+		{name: "too short", codec: codec, token: base64.RawURLEncoding.EncodeToString(raw[:10]), now: now, wantErr: "malformed token"},
+		{name: "tampered", codec: codec, token: base64.RawURLEncoding.EncodeToString(tampered), now: now, wantErr: "malformed token"},
+		{name: "different key", codec: otherKey, token: token, now: now, wantErr: "malformed token"},
+		{name: "expired", codec: codec, token: token, now: now.Add(pageTokenTTL + time.Second), wantErr: "token has expired; restart from the first page"},
+		{name: "different request", codec: otherFingerprint, token: token, now: now, wantErr: "token was returned for a request with a different filter or order_by"},
+		{name: "different version", codec: otherVersion, token: token, now: now, wantErr: "the data has changed since the token was returned; restart from the first page"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := testCase.codec.decode(testCase.token, testCase.now)
+			if err == nil || err.Error() != testCase.wantErr {
+				t.Errorf("decode returned error %v, want %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+	items := []string{"a", "b", "c", "d", "e"}
+	codec := pageTokenCodec{key: []byte("secret"), fingerprint: "letters"}
+
+	var pages [][]string
+	var token string
+	for {
+		page, next, err := paginate(items, 2, token, codec)
+		if err != nil {
+			t.Fatalf("paginate returned error: %v", err)
+		}
+		pages = append(pages, page)
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+	if !slices.EqualFunc(pages, want, slices.Equal) {
+		t.Errorf("pages = %v, want %v", pages, want)
+	}
+
+	// The page size is remembered by the token.
+	first, next, err := paginate(items, 3, "", codec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _, err := paginate(items, 0, next, codec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(first, []string{"a", "b", "c"}) || !slices.Equal(second, []string{"d", "e"}) {
+		t.Errorf("pages = %v and %v, want [a b c] and [d e]", first, second)
+	}
+
+	_, _, err = paginate(items, 2, "bogus", codec)
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("paginate with bad token returned %v, want InvalidArgument", err)
+	}
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Message() != "invalid page_token: malformed token" {
+		t.Errorf("paginate with bad token returned %v", err)
+	}
+}
+
+func TestListFilmsPageTokenInvalidatedByChange(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := handler.ListFilms(ctx, connect.NewRequest(&filmv1.ListFilmsRequest{PageSize: 2}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Msg.GetFilms()) != 2 || first.Msg.GetNextPageToken() == "" {
+		t.Fatalf("first page has %d films and token %q", len(first.Msg.GetFilms()), first.Msg.GetNextPageToken())
+	}
+	_, err = handler.CreateFilm(ctx, connect.NewRequest(&filmv1.CreateFilmRequest{Film: &filmv1.Film{Title: "The Holiday Special"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = handler.ListFilms(ctx, connect.NewRequest(&filmv1.ListFilmsRequest{PageToken: first.Msg.GetNextPageToken()}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ListFilms with stale token returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestStreamFilms(t *testing.T) {
+	t.Parallel()
+	dataset := &Dataset{}
+	for i := 1; i <= maxChunkSize+1; i++ {
+		dataset.Films = append(dataset.Films, &Film{Film: swapi.Film{
+			Title: fmt.Sprintf("Film %d", i),
+			URL:   fmt.Sprintf("https://swapi.dev/api/films/%d/", i),
+		}})
+	}
+	handler, err := NewHandler(WithDataSource(NewMemoryDataSource(dataset)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(filmv1connect.NewFilmServiceHandler(handler))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := filmv1connect.NewFilmServiceClient(server.Client(), server.URL)
+
+	repeat := func(size, count, last int) []int {
+		return append(slices.Repeat([]int{size}, count), last)
+	}
+	testCases := []struct {
+		name      string
+		filter    string
+		chunkSize int32
+		want      []int
+		wantCode  connect.Code
+	}{
+		{name: "default", want: repeat(defaultChunkSize, 10, 1)},
+		{name: "small", chunkSize: 300, want: []int{300, 300, 300, 101}},
+		{name: "too large", chunkSize: 5000, want: []int{maxChunkSize, 1}},
+		// Film 1, films 10 to 19, films 100 to 199, and films 1000 and 1001.
+		{name: "filter", filter: `title = "Film 1*"`, chunkSize: 50, want: []int{50, 50, 13}},
+		{name: "no matches", filter: `title = "Film 0"`},
+		{name: "negative", chunkSize: -1, wantCode: connect.CodeInvalidArgument},
+		{name: "invalid filter", filter: `title =`, wantCode: connect.CodeInvalidArgument},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			stream, err := client.StreamFilms(context.Background(), connect.NewRequest(&filmv1.StreamFilmsRequest{
+				Filter:    testCase.filter,
+				ChunkSize: testCase.chunkSize,
+			}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stream.Close()
+			var sizes []int
+			var ids []int
+			for stream.Receive() {
+				sizes = append(sizes, len(stream.Msg().GetFilms()))
+				for _, film := range stream.Msg().GetFilms() {
+					id, err := strconv.Atoi(film.GetId())
+					if err != nil {
+						t.Fatal(err)
+					}
+					ids = append(ids, id)
+				}
+			}
+			if testCase.wantCode != 0 {
+				if connect.CodeOf(stream.Err()) != testCase.wantCode {
+					t.Errorf("stream returned %v, want %v", stream.Err(), testCase.wantCode)
+				}
+				return
+			}
+			if err := stream.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(sizes, testCase.want) {
+				t.Errorf("chunk sizes = %v, want %v", sizes, testCase.want)
+			}
+			// The films are streamed in order, without gaps or duplicates.
+			if !slices.IsSorted(ids) || len(slices.Compact(slices.Clone(ids))) != len(ids) {
+				t.Errorf("films are not in order: %v", ids)
+			}
+		})
+	}
+}
+
+func TestStreamEntitiesCanceled(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// The context is checked before each chunk, so nothing is sent to the
+	// stream, which would panic if it were used.
+	err = streamEntities(ctx, handler.store.Load(), filmType, "", 1, nil,
+		func(chunk []*filmv1.Film) *filmv1.StreamFilmsResponse {
+			t.Errorf("created a response for a canceled stream")
+			return &filmv1.StreamFilmsResponse{Films: chunk}
+		})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("streamEntities returned %v, want %v", err, context.Canceled)
+	}
+}
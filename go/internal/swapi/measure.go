@@ -0,0 +1,68 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+)
+
+// gravityAnnotation matches the parenthesized notes in swapi.dev gravity
+// values, like "1.5 (surface), 1 standard (Cloud City)".
+var gravityAnnotation = regexp.MustCompile(`\([^)]*\)`) //nolint:gochecknoglobals
+
+// parseGravity parses a gravity value from swapi.dev data, such as
+// "1 standard", "0.9-1.1 standard", or "1.5 (surface), 1 standard", into the
+// range of its values. It returns nil if the gravity is unknown.
+func parseGravity(s string) *planetv1.GravityRange {
+	s = gravityAnnotation.ReplaceAllString(strings.ToLower(s), "")
+	s = strings.ReplaceAll(s, "standard", "")
+	var values []float64
+	for _, value := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '-' }) {
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil
+		}
+		values = append(values, v)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return &planetv1.GravityRange{Min: slices.Min(values), Max: slices.Max(values)}
+}
+
+// parseBirthYear parses a birth year from swapi.dev data, such as "19BBY" or
+// "3ABY". It returns nil if the year is unknown.
+func parseBirthYear(s string) *personv1.BirthYear {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	era, sign := personv1.Era_ERA_BBY, -1.0
+	year, ok := strings.CutSuffix(s, "BBY")
+	if !ok {
+		if year, ok = strings.CutSuffix(s, "ABY"); !ok {
+			return nil
+		}
+		era, sign = personv1.Era_ERA_ABY, 1
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(year), 64)
+	if err != nil || v < 0 {
+		return nil
+	}
+	return &personv1.BirthYear{Era: era, Year: v, AbsoluteYear: sign * v}
+}
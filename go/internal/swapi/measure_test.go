@@ -0,0 +1,74 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"testing"
+
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseGravity(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		gravity string
+		want    *planetv1.GravityRange
+	}{
+		{gravity: "1 standard", want: &planetv1.GravityRange{Min: 1, Max: 1}},
+		{gravity: "0.98", want: &planetv1.GravityRange{Min: 0.98, Max: 0.98}},
+		{gravity: "1.1 standard, 0.9 (surface)", want: &planetv1.GravityRange{Min: 0.9, Max: 1.1}},
+		{gravity: "1.5 (surface), 1 standard (Cloud City)", want: &planetv1.GravityRange{Min: 1, Max: 1.5}},
+		{gravity: "0.5-1", want: &planetv1.GravityRange{Min: 0.5, Max: 1}},
+		{gravity: "0.9-1.1 Standard", want: &planetv1.GravityRange{Min: 0.9, Max: 1.1}},
+		{gravity: "N/A"},
+		{gravity: "unknown"},
+		{gravity: ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.gravity, func(t *testing.T) {
+			t.Parallel()
+			if got := parseGravity(testCase.gravity); !proto.Equal(got, testCase.want) {
+				t.Errorf("parseGravity(%q) = %v, want %v", testCase.gravity, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestParseBirthYear(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		birthYear string
+		want      *personv1.BirthYear
+	}{
+		{birthYear: "19BBY", want: &personv1.BirthYear{Era: personv1.Era_ERA_BBY, Year: 19, AbsoluteYear: -19}},
+		{birthYear: "41.9BBY", want: &personv1.BirthYear{Era: personv1.Era_ERA_BBY, Year: 41.9, AbsoluteYear: -41.9}},
+		{birthYear: "4ABY", want: &personv1.BirthYear{Era: personv1.Era_ERA_ABY, Year: 4, AbsoluteYear: 4}},
+		{birthYear: " 0aby", want: &personv1.BirthYear{Era: personv1.Era_ERA_ABY}},
+		{birthYear: "unknown"},
+		{birthYear: "BBY"},
+		{birthYear: "-5BBY"},
+		{birthYear: "19"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.birthYear, func(t *testing.T) {
+			t.Parallel()
+			if got := parseBirthYear(testCase.birthYear); !proto.Equal(got, testCase.want) {
+				t.Errorf("parseBirthYear(%q) = %v, want %v", testCase.birthYear, got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	manufacturerv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	speciesv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	starshipv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	vehiclev1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// entityType describes one type of entity in a Store.
+type entityType[T entity] struct {
+	// name is the name of the entity type, used in error messages.
+	name string
+	// index returns the index for this type of entity in the given store.
+	index func(*Store) *entityIndex[T]
+	// normalize, if not nil, puts the fields of a created or updated entity
+	// into their canonical form, the same as the transform function that
+	// creates entities from swapi data.
+	normalize func(T)
+}
+
+//nolint:gochecknoglobals
+var (
+	filmType = entityType[*filmv1.Film]{
+		name:  "film",
+		index: func(s *Store) *entityIndex[*filmv1.Film] { return &s.films },
+	}
+	personType = entityType[*personv1.Person]{
+		name:  "person",
+		index: func(s *Store) *entityIndex[*personv1.Person] { return &s.people },
+		normalize: func(person *personv1.Person) {
+			person.MassKg = maybeFloat(person.Mass)
+			person.BirthYearValue = parseBirthYear(person.BirthYear)
+		},
+	}
+	planetType = entityType[*planetv1.Planet]{
+		name:  "planet",
+		index: func(s *Store) *entityIndex[*planetv1.Planet] { return &s.planets },
+		normalize: func(planet *planetv1.Planet) {
+			planet.Climates, planet.ClimateTypes = normalizeClimates(planet.Climates)
+			planet.Terrains = normalizeVocabulary(planet.Terrains)
+			planet.GravityRange = parseGravity(planet.Gravity)
+		},
+	}
+	speciesType = entityType[*speciesv1.Species]{
+		name:  "species",
+		index: func(s *Store) *entityIndex[*speciesv1.Species] { return &s.species },
+	}
+	starshipType = entityType[*starshipv1.Starship]{
+		name:  "starship",
+		index: func(s *Store) *entityIndex[*starshipv1.Starship] { return &s.starships },
+	}
+	vehicleType = entityType[*vehiclev1.Vehicle]{
+		name:  "vehicle",
+		index: func(s *Store) *entityIndex[*vehiclev1.Vehicle] { return &s.vehicles },
+	}
+	// manufacturerType is only used to query manufacturers, since they are
+	// derived from starships and vehicles and cannot be mutated.
+	manufacturerType = entityType[*manufacturerv1.Manufacturer]{
+		name:  "manufacturer",
+		index: func(s *Store) *entityIndex[*manufacturerv1.Manufacturer] { return s.manufacturerIndex() },
+	}
+)
+
+// immutableFields are the fields of an entity that are assigned by the
+// server and cannot be changed by an update.
+//
+//nolint:gochecknoglobals
+var immutableFields = map[protoreflect.Name]struct{}{
+	"id":      {},
+	"created": {},
+	"edited":  {},
+}
+
+func createEntity[T entity](h *Handler, kind entityType[T], item T) (T, error) {
+	if !item.ProtoReflect().IsValid() {
+		return item, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is required", kind.name))
+	}
+	item = proto.Clone(item).(T) //nolint:forcetypeassert,errcheck
+	now := timestamppb.Now()
+	err := h.update(func(store *Store) error {
+		index := kind.index(store)
+		id := item.GetId()
+		if id == "" {
+			id = index.nextID()
+		} else if _, exists := index.byID[id]; exists {
+			return connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s %q already exists", kind.name, id))
+		}
+		setEntityField(item, "id", protoreflect.ValueOfString(id))
+		setEntityField(item, "created", protoreflect.ValueOfMessage(now.ProtoReflect()))
+		setEntityField(item, "edited", protoreflect.ValueOfMessage(now.ProtoReflect()))
+		if kind.normalize != nil {
+			kind.normalize(item)
+		}
+		if err := validateEntity(store, kind, item); err != nil {
+			return err
+		}
+		index.put(item)
+		syncInverses(store, kind.name, nil, item, now)
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return item, nil
+}
+
+func updateEntity[T entity](h *Handler, kind entityType[T], item T, updateMask *fieldmaskpb.FieldMask) (T, error) {
+	var updated T
+	if !item.ProtoReflect().IsValid() {
+		return updated, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s is required", kind.name))
+	}
+	err := h.update(func(store *Store) error {
+		index := kind.index(store)
+		existing, ok := index.byID[item.GetId()]
+		if !ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %q not found", kind.name, item.GetId()))
+		}
+		if err := checkEdited(kind, existing, item.GetEdited()); err != nil {
+			return err
+		}
+		updated = proto.Clone(existing).(T) //nolint:forcetypeassert,errcheck
+		if err := applyUpdateMask(updated.ProtoReflect(), item.ProtoReflect(), updateMask); err != nil {
+			return err
+		}
+		now := timestamppb.Now()
+		setEntityField(updated, "edited", protoreflect.ValueOfMessage(now.ProtoReflect()))
+		if kind.normalize != nil {
+			kind.normalize(updated)
+		}
+		if err := validateEntity(store, kind, updated); err != nil {
+			return err
+		}
+		index.put(updated)
+		syncInverses(store, kind.name, existing, updated, now)
+		return nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return updated, nil
+}
+
+func deleteEntity[T entity](h *Handler, kind entityType[T], id string, edited *timestamppb.Timestamp, cascade bool) error {
+	return h.update(func(store *Store) error {
+		index := kind.index(store)
+		existing, ok := index.byID[id]
+		if !ok {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("%s %q not found", kind.name, id))
+		}
+		if err := checkEdited(kind, existing, edited); err != nil {
+			return err
+		}
+		if err := removeReferences(store, kind.name, id, cascade, timestamppb.Now()); err != nil {
+			return err
+		}
+		index.remove(id)
+		return nil
+	})
+}
+
+// checkEdited verifies that the given edited timestamp, if present, matches
+// the existing entity. This provides optimistic concurrency control: clients
+// send the edited timestamp of the version they last read, and the mutation
+// fails if the entity has been changed since then.
+func checkEdited[T entity](kind entityType[T], existing T, edited *timestamppb.Timestamp) error {
+	if edited == nil || proto.Equal(existing.GetEdited(), edited) {
+		return nil
+	}
+	return connect.NewError(
+		connect.CodeAborted,
+		fmt.Errorf("%s %q was concurrently modified: edited timestamp is %s, not %s",
+			kind.name, existing.GetId(), existing.GetEdited().AsTime(), edited.AsTime()),
+	)
+}
+
+func validateEntity[T entity](store *Store, kind entityType[T], item T) error {
+	if entityName(item) == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s must have a name", kind.name))
+	}
+	return checkReferences(store, kind.name, item)
+}
+
+// entityName returns the name of the given entity. For films, this is the
+// title.
+func entityName(msg proto.Message) string {
+	refl := msg.ProtoReflect()
+	fields := refl.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"name", "title"} {
+		if field := fields.ByName(name); field != nil {
+			return refl.Get(field).String()
+		}
+	}
+	return ""
+}
+
+func setEntityField(msg proto.Message, name protoreflect.Name, value protoreflect.Value) {
+	refl := msg.ProtoReflect()
+	refl.Set(refl.Descriptor().Fields().ByName(name), value)
+}
+
+// applyUpdateMask copies the fields indicated by the given mask from src to
+// dest. If the mask is empty, all fields that are populated in src, other
+// than immutableFields, are copied. So a client that omits the mask does not
+// clear the fields that it did not send.
+func applyUpdateMask(dest, src protoreflect.Message, mask *fieldmaskpb.FieldMask) error {
+	if len(mask.GetPaths()) == 0 {
+		src.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+			if _, immutable := immutableFields[field.Name()]; !immutable {
+				dest.Set(field, value)
+			}
+			return true
+		})
+		return nil
+	}
+	for _, path := range mask.GetPaths() {
+		names := strings.Split(path, ".")
+		if _, immutable := immutableFields[protoreflect.Name(names[0])]; immutable {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("update_mask: field %q cannot be updated", path))
+		}
+		if err := applyPath(dest, src, names, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyPath(dest, src protoreflect.Message, names []string, path string) error {
+	field := dest.Descriptor().Fields().ByName(protoreflect.Name(names[0]))
+	if field == nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("update_mask: unknown field path %q", path))
+	}
+	if len(names) == 1 {
+		copyField(dest, src, field)
+		return nil
+	}
+	if field.Message() == nil || field.IsList() || field.IsMap() {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("update_mask: invalid field path %q: %s is not a message", path, field.Name()))
+	}
+	return applyPath(dest.Mutable(field).Message(), src.Get(field).Message(), names[1:], path)
+}
+
+func copyField(dest, src protoreflect.Message, field protoreflect.FieldDescriptor) {
+	if src.Has(field) {
+		dest.Set(field, src.Get(field))
+	} else {
+		dest.Clear(field)
+	}
+}
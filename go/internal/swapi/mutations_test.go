@@ -0,0 +1,263 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateEntity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now()
+	resp, err := handler.CreateFilm(ctx, connect.NewRequest(&filmv1.CreateFilmRequest{Film: &filmv1.Film{
+		Title:   "The Force Awakens",
+		Created: timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	film := resp.Msg.GetFilm()
+	// The snapshot has films 1 through 6.
+	if film.GetId() != "7" {
+		t.Errorf("id = %q, want 7", film.GetId())
+	}
+	if created := film.GetCreated().AsTime(); created.Before(before) || created.After(time.Now()) {
+		t.Errorf("created = %v, want the time of the request", created)
+	}
+	if !proto.Equal(film.GetCreated(), film.GetEdited()) {
+		t.Errorf("edited = %v, want the same as created %v", film.GetEdited(), film.GetCreated())
+	}
+	got, err := handler.GetFilms(ctx, connect.NewRequest(&filmv1.GetFilmsRequest{Ids: []string{"7"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got.Msg.GetFilms()[0], film) {
+		t.Errorf("GetFilms returned %v, want %v", got.Msg.GetFilms()[0], film)
+	}
+
+	_, err = handler.CreateFilm(ctx, connect.NewRequest(&filmv1.CreateFilmRequest{Film: &filmv1.Film{Id: "1", Title: "A New Hope"}}))
+	if connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("CreateFilm with an existing id returned %v, want AlreadyExists", err)
+	}
+	_, err = handler.CreateFilm(ctx, connect.NewRequest(&filmv1.CreateFilmRequest{Film: &filmv1.Film{OpeningCrawl: "It is a period of civil war."}}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("CreateFilm without a title returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestCreateEntityNormalizes(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := handler.CreatePerson(context.Background(), connect.NewRequest(&personv1.CreatePersonRequest{Person: &personv1.Person{
+		Name:      "Jabba Desilijic Tiure",
+		Mass:      "1,358",
+		BirthYear: "600BBY",
+		// These are computed from mass and birth_year, so they are ignored.
+		MassKg:         proto.Float64(1),
+		BirthYearValue: &personv1.BirthYear{Era: personv1.Era_ERA_ABY, Year: 1, AbsoluteYear: 1},
+	}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	person := resp.Msg.GetPerson()
+	if person.MassKg == nil || person.GetMassKg() != 1358 {
+		t.Errorf("mass_kg = %v, want 1358", person.MassKg)
+	}
+	want := &personv1.BirthYear{Era: personv1.Era_ERA_BBY, Year: 600, AbsoluteYear: -600}
+	if !proto.Equal(person.GetBirthYearValue(), want) {
+		t.Errorf("birth_year_value = %v, want %v", person.GetBirthYearValue(), want)
+	}
+}
+
+func TestUpdateEntity(t *testing.T) {
+	t.Parallel()
+	// Luke Skywalker has id 1, mass "77", and blue eyes.
+	stale := timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	testCases := []struct {
+		name     string
+		person   *personv1.Person
+		paths    []string
+		wantCode connect.Code
+		check    func(t *testing.T, before, after *personv1.Person)
+	}{
+		{
+			name:   "mask",
+			person: &personv1.Person{Id: "1", Name: "Luke", Mass: "80"},
+			paths:  []string{"mass"},
+			check: func(t *testing.T, before, after *personv1.Person) {
+				t.Helper()
+				if after.GetName() != before.GetName() {
+					t.Errorf("name = %q, want it unchanged since it is not in the mask", after.GetName())
+				}
+				if after.GetMass() != "80" || after.GetMassKg() != 80 {
+					t.Errorf("mass = %q and mass_kg = %v, want 80", after.GetMass(), after.GetMassKg())
+				}
+			},
+		},
+		{
+			name:   "mask clears",
+			person: &personv1.Person{Id: "1"},
+			paths:  []string{"eye_color", "mass"},
+			check: func(t *testing.T, _, after *personv1.Person) {
+				t.Helper()
+				if after.GetEyeColor() != "" || after.MassKg != nil {
+					t.Errorf("eye_color = %q and mass_kg = %v, want them cleared", after.GetEyeColor(), after.MassKg)
+				}
+			},
+		},
+		{
+			name: "empty mask",
+			person: &personv1.Person{
+				Id:       "1",
+				EyeColor: "green",
+				Created:  stale,
+			},
+			check: func(t *testing.T, before, after *personv1.Person) {
+				t.Helper()
+				if after.GetEyeColor() != "green" {
+					t.Errorf("eye_color = %q, want green", after.GetEyeColor())
+				}
+				if after.GetName() != before.GetName() || after.GetMass() != before.GetMass() || len(after.GetFilmIds()) != len(before.GetFilmIds()) {
+					t.Errorf("fields that were not sent changed: %v", after)
+				}
+				if !proto.Equal(after.GetCreated(), before.GetCreated()) {
+					t.Errorf("created = %v, want it unchanged", after.GetCreated())
+				}
+				if !after.GetEdited().AsTime().After(before.GetEdited().AsTime()) {
+					t.Errorf("edited = %v, want it to be later than %v", after.GetEdited(), before.GetEdited())
+				}
+			},
+		},
+		{
+			name:     "immutable field",
+			person:   &personv1.Person{Id: "1", Created: stale},
+			paths:    []string{"created"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "unknown field",
+			person:   &personv1.Person{Id: "1"},
+			paths:    []string{"midichlorians"},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:     "stale edited",
+			person:   &personv1.Person{Id: "1", EyeColor: "green", Edited: stale},
+			wantCode: connect.CodeAborted,
+		},
+		{
+			name:     "unknown id",
+			person:   &personv1.Person{Id: "1000", EyeColor: "green"},
+			wantCode: connect.CodeNotFound,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			handler, err := NewHandler()
+			if err != nil {
+				t.Fatal(err)
+			}
+			before := handler.store.Load().people.byID["1"]
+			req := &personv1.UpdatePersonRequest{Person: testCase.person}
+			if testCase.paths != nil {
+				req.UpdateMask = &fieldmaskpb.FieldMask{Paths: testCase.paths}
+			}
+			resp, err := handler.UpdatePerson(ctx, connect.NewRequest(req))
+			if testCase.wantCode != 0 {
+				if connect.CodeOf(err) != testCase.wantCode {
+					t.Fatalf("UpdatePerson returned %v, want code %v", err, testCase.wantCode)
+				}
+				if after := handler.store.Load().people.byID["1"]; after != before {
+					t.Errorf("failed update changed person 1 to %v", after)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			testCase.check(t, before, resp.Msg.GetPerson())
+		})
+	}
+}
+
+func TestUpdateEntityNormalizes(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := handler.UpdatePlanet(context.Background(), connect.NewRequest(&planetv1.UpdatePlanetRequest{
+		Planet:     &planetv1.Planet{Id: "1", Gravity: "0.5-1 standard"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"gravity"}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &planetv1.GravityRange{Min: 0.5, Max: 1}
+	if got := resp.Msg.GetPlanet().GetGravityRange(); !proto.Equal(got, want) {
+		t.Errorf("gravity_range = %v, want %v", got, want)
+	}
+}
+
+func TestDeleteEntity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := handler.CreateFilm(ctx, connect.NewRequest(&filmv1.CreateFilmRequest{Film: &filmv1.Film{Title: "The Holiday Special"}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Msg.GetFilm().GetId()
+	stale := timestamppb.New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	_, err = handler.DeleteFilm(ctx, connect.NewRequest(&filmv1.DeleteFilmRequest{Id: id, Edited: stale}))
+	if connect.CodeOf(err) != connect.CodeAborted {
+		t.Errorf("DeleteFilm with a stale edited timestamp returned %v, want Aborted", err)
+	}
+	_, err = handler.DeleteFilm(ctx, connect.NewRequest(&filmv1.DeleteFilmRequest{Id: id, Edited: created.Msg.GetFilm().GetEdited()}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = handler.DeleteFilm(ctx, connect.NewRequest(&filmv1.DeleteFilmRequest{Id: id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("DeleteFilm of a deleted film returned %v, want NotFound", err)
+	}
+	_, err = handler.DeleteFilm(ctx, connect.NewRequest(&filmv1.DeleteFilmRequest{Id: "1000"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("DeleteFilm of an unknown id returned %v, want NotFound", err)
+	}
+}
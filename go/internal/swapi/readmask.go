@@ -0,0 +1,97 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// maskTree is a parsed read mask. Each field in the tree is retained. A
+// field with no subtree is retained in its entirety.
+type maskTree map[protoreflect.FieldNumber]maskTree
+
+// newMaskTree parses the given mask against the given message descriptor.
+// It returns an InvalidArgument error if any path is invalid, or nil if the
+// mask is empty.
+func newMaskTree(desc protoreflect.MessageDescriptor, mask *fieldmaskpb.FieldMask) (maskTree, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+	tree := maskTree{}
+	for _, path := range mask.GetPaths() {
+		fields, err := resolveFieldPath(desc, path)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid read_mask path %q: %w", path, err))
+		}
+		node := tree
+		for i, field := range fields {
+			sub, ok := node[field.Number()]
+			switch {
+			case ok && sub == nil:
+				// A parent of this path is already retained entirely.
+			case i == len(fields)-1:
+				node[field.Number()] = nil
+			case !ok:
+				sub = maskTree{}
+				node[field.Number()] = sub
+			}
+			if sub == nil {
+				break
+			}
+			node = sub
+		}
+	}
+	return tree, nil
+}
+
+// prune clears all fields of the given message that are not in the tree.
+func (t maskTree) prune(msg protoreflect.Message) {
+	var cleared []protoreflect.FieldDescriptor
+	msg.Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		sub, ok := t[field.Number()]
+		switch {
+		case !ok:
+			cleared = append(cleared, field)
+		case sub != nil:
+			sub.prune(msg.Mutable(field).Message())
+		}
+		return true
+	})
+	for _, field := range cleared {
+		msg.Clear(field)
+	}
+}
+
+// applyReadMask returns copies of the given entities that only contain the
+// fields in the given mask. Since the entities in a Store are shared, they
+// are never modified. If the mask is empty, the entities are returned as is.
+func applyReadMask[T entity](entities []T, mask *fieldmaskpb.FieldMask) ([]T, error) {
+	var zero T
+	tree, err := newMaskTree(zero.ProtoReflect().Descriptor(), mask)
+	if err != nil || tree == nil {
+		return entities, err
+	}
+	results := make([]T, len(entities))
+	for i, item := range entities {
+		results[i] = proto.Clone(item).(T) //nolint:forcetypeassert,errcheck
+		tree.prune(results[i].ProtoReflect())
+	}
+	return results, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"connectrpc.com/connect"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewMaskTree(t *testing.T) {
+	t.Parallel()
+	// The field numbers of Person and BirthYear.
+	const (
+		id, name, created, birthYearValue = 1, 2, 14, 18
+		era, year                         = 1, 2
+		seconds                           = 1
+	)
+	testCases := []struct {
+		name    string
+		paths   []string
+		want    maskTree
+		wantErr bool
+	}{
+		{name: "empty"},
+		{name: "fields", paths: []string{"id", "name"}, want: maskTree{id: nil, name: nil}},
+		{name: "nested", paths: []string{"birth_year_value.year", "created.seconds"}, want: maskTree{
+			birthYearValue: {year: nil},
+			created:        {seconds: nil},
+		}},
+		{name: "json names", paths: []string{"birthYearValue.era", "birth_year_value.year"}, want: maskTree{
+			birthYearValue: {era: nil, year: nil},
+		}},
+		{name: "parent first", paths: []string{"birth_year_value", "birth_year_value.year"}, want: maskTree{birthYearValue: nil}},
+		{name: "parent last", paths: []string{"birth_year_value.year", "birth_year_value"}, want: maskTree{birthYearValue: nil}},
+		{name: "duplicate", paths: []string{"name", "name"}, want: maskTree{name: nil}},
+		{name: "unknown field", paths: []string{"id", "no_such_field"}, wantErr: true},
+		{name: "unknown nested field", paths: []string{"birth_year_value.no_such_field"}, wantErr: true},
+		{name: "not a message", paths: []string{"name.first"}, wantErr: true},
+		{name: "empty path", paths: []string{""}, wantErr: true},
+	}
+	desc := (&personv1.Person{}).ProtoReflect().Descriptor()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := newMaskTree(desc, &fieldmaskpb.FieldMask{Paths: testCase.paths})
+			if testCase.wantErr {
+				if connect.CodeOf(err) != connect.CodeInvalidArgument {
+					t.Errorf("newMaskTree returned %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			// A nil subtree retains the whole field, while an empty one
+			// would retain none of it, so DeepEqual's distinction between
+			// them matters.
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("newMaskTree returned %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestApplyReadMask(t *testing.T) {
+	t.Parallel()
+	person := &personv1.Person{
+		Id:             "1",
+		Name:           "Luke Skywalker",
+		FilmIds:        []string{"1", "2"},
+		Created:        &timestamppb.Timestamp{Seconds: 1418227200, Nanos: 5},
+		HeightCm:       proto.Float64(172),
+		BirthYearValue: &personv1.BirthYear{Era: personv1.Era_ERA_BBY, Year: 19, AbsoluteYear: -19},
+	}
+	original := proto.Clone(person)
+	testCases := []struct {
+		name  string
+		paths []string
+		want  *personv1.Person
+	}{
+		{name: "empty", want: person},
+		{name: "fields", paths: []string{"id", "film_ids", "height_cm"}, want: &personv1.Person{
+			Id: "1", FilmIds: []string{"1", "2"}, HeightCm: proto.Float64(172),
+		}},
+		{name: "nested", paths: []string{"id", "birth_year_value.era", "created.seconds"}, want: &personv1.Person{
+			Id:             "1",
+			Created:        &timestamppb.Timestamp{Seconds: 1418227200},
+			BirthYearValue: &personv1.BirthYear{Era: personv1.Era_ERA_BBY},
+		}},
+		{name: "merged", paths: []string{"birth_year_value.era", "birth_year_value"}, want: &personv1.Person{
+			BirthYearValue: person.BirthYearValue,
+		}},
+		// Paths to absent fields are valid, and leave them absent.
+		{name: "absent", paths: []string{"mass_kg", "homeworld_id"}, want: &personv1.Person{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := applyReadMask([]*personv1.Person{person}, &fieldmaskpb.FieldMask{Paths: testCase.paths})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || !proto.Equal(got[0], testCase.want) {
+				t.Errorf("applyReadMask returned %v, want %v", got, testCase.want)
+			}
+			if len(testCase.paths) == 0 && got[0] != person {
+				t.Errorf("applyReadMask with an empty mask copied the entity")
+			}
+		})
+	}
+	// The parallel subtests run after this function returns, so the entity
+	// is checked for modifications in a cleanup.
+	t.Cleanup(func() {
+		if !proto.Equal(person, original) {
+			t.Errorf("applyReadMask modified the entity: %v", person)
+		}
+	})
+
+	_, err := applyReadMask([]*personv1.Person{person}, &fieldmaskpb.FieldMask{Paths: []string{"no_such_field"}})
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("applyReadMask with an unknown path returned %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetPeopleReadMask(t *testing.T) {
+	t.Parallel()
+	handler, err := NewHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := proto.Clone(handler.store.Load().people.byID["1"])
+	resp, err := handler.GetPeople(context.Background(), connect.NewRequest(&personv1.GetPeopleRequest{
+		Ids:      []string{"1"},
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "birth_year_value.era"}},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &personv1.Person{Name: "Luke Skywalker", BirthYearValue: &personv1.BirthYear{Era: personv1.Era_ERA_BBY}}
+	if people := resp.Msg.GetPeople(); len(people) != 1 || !proto.Equal(people[0], want) {
+		t.Errorf("GetPeople returned %v, want %v", people, want)
+	}
+	if person := handler.store.Load().people.byID["1"]; !proto.Equal(person, stored) {
+		t.Errorf("GetPeople modified the stored person: %v", person)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// searchField is a field of an entity that is included in the search index.
+type searchField struct {
+	name   protoreflect.Name
+	weight float64
+}
+
+// searchFields are the fields that are indexed for each type of entity.
+//
+//nolint:gochecknoglobals
+var searchFields = map[string][]searchField{
+	filmType.name:     {{name: "title", weight: 3}, {name: "director", weight: 1.5}, {name: "opening_crawl", weight: 0.5}},
+	personType.name:   {{name: "name", weight: 3}},
+	planetType.name:   {{name: "name", weight: 3}},
+	speciesType.name:  {{name: "name", weight: 3}, {name: "classification", weight: 1}},
+	starshipType.name: {{name: "name", weight: 3}, {name: "model", weight: 1.5}},
+	vehicleType.name:  {{name: "name", weight: 3}, {name: "model", weight: 1.5}},
+}
+
+const (
+	// prefixFactor is the maximum score factor for a query word that is a
+	// prefix of an indexed word. The factor decreases as the length of the
+	// indexed word increases relative to the query word.
+	prefixFactor = 0.8
+	// typoFactor is the score factor for a query word that is within a
+	// small edit distance of an indexed word.
+	typoFactor = 0.5
+)
+
+// searchIndex is an inverted index of the entities in a Store.
+type searchIndex struct {
+	docs []searchDoc
+	// postings maps each word to the documents and fields it appears in.
+	postings map[string][]posting
+	// words are the keys of postings, sorted, for prefix matching.
+	words []string
+}
+
+type searchDoc struct {
+	kind string
+	msg  proto.Message
+}
+
+type posting struct {
+	doc   int
+	field protoreflect.Name
+	// score is the field's weight, adjusted for the number of times the
+	// word appears in the field.
+	score float64
+}
+
+// searchResult is one entity that matched a search query.
+type searchResult struct {
+	searchDoc
+	score         float64
+	matchedFields []string
+	// order is the index of the document, used to order results that
+	// have the same score.
+	order int
+}
+
+func newSearchIndex(store *Store) *searchIndex {
+	idx := &searchIndex{postings: map[string][]posting{}}
+	for _, kind := range entityTypeNames {
+		for _, msg := range store.indexByName(kind).messages() {
+			doc := len(idx.docs)
+			idx.docs = append(idx.docs, searchDoc{kind: kind, msg: msg})
+			refl := msg.ProtoReflect()
+			for _, field := range searchFields[kind] {
+				counts := map[string]int{}
+				for _, word := range tokenize(refl.Get(refl.Descriptor().Fields().ByName(field.name)).String()) {
+					counts[word]++
+				}
+				for word, count := range counts {
+					idx.postings[word] = append(idx.postings[word], posting{
+						doc:   doc,
+						field: field.name,
+						score: field.weight * (1 + math.Log(float64(count))),
+					})
+				}
+			}
+		}
+	}
+	for word := range idx.postings {
+		idx.words = append(idx.words, word)
+	}
+	slices.Sort(idx.words)
+	return idx
+}
+
+// search returns the entities that match every word of the given query,
+// ordered from most to least relevant. If kinds is not empty, only entities
+// of those types are returned.
+func (idx *searchIndex) search(query string, kinds []string) []searchResult {
+	words := tokenize(query)
+	if len(words) == 0 {
+		return nil
+	}
+	var scores map[int]float64
+	matched := map[int]map[protoreflect.Name]struct{}{}
+	for _, word := range words {
+		wordScores := map[int]float64{}
+		for term, factor := range idx.matchWord(word) {
+			postings := idx.postings[term]
+			idf := math.Log(1 + float64(len(idx.docs))/float64(len(postings)))
+			for _, posting := range postings {
+				doc := idx.docs[posting.doc]
+				if len(kinds) > 0 && !slices.Contains(kinds, doc.kind) {
+					continue
+				}
+				if scores != nil {
+					if _, ok := scores[posting.doc]; !ok {
+						// Did not match a previous word of the query.
+						continue
+					}
+				}
+				wordScores[posting.doc] = max(wordScores[posting.doc], posting.score*factor*idf)
+				if matched[posting.doc] == nil {
+					matched[posting.doc] = map[protoreflect.Name]struct{}{}
+				}
+				matched[posting.doc][posting.field] = struct{}{}
+			}
+		}
+		if scores == nil {
+			scores = wordScores
+			continue
+		}
+		for doc := range scores {
+			if wordScore, ok := wordScores[doc]; ok {
+				scores[doc] += wordScore
+			} else {
+				delete(scores, doc)
+			}
+		}
+	}
+	results := make([]searchResult, 0, len(scores))
+	for doc, score := range scores {
+		var fields []string
+		for _, field := range searchFields[idx.docs[doc].kind] {
+			if _, ok := matched[doc][field.name]; ok {
+				fields = append(fields, string(field.name))
+			}
+		}
+		results = append(results, searchResult{searchDoc: idx.docs[doc], score: score, matchedFields: fields, order: doc})
+	}
+	slices.SortFunc(results, func(a, b searchResult) int {
+		if result := cmp.Compare(b.score, a.score); result != 0 {
+			return result
+		}
+		return cmp.Compare(a.order, b.order)
+	})
+	return results
+}
+
+// matchWord returns the indexed words that match the given query word, along
+// with a factor for how closely each one matches.
+func (idx *searchIndex) matchWord(word string) map[string]float64 {
+	matches := map[string]float64{}
+	start, _ := slices.BinarySearch(idx.words, word)
+	for _, term := range idx.words[start:] {
+		if !strings.HasPrefix(term, word) {
+			break
+		}
+		if term == word {
+			matches[term] = 1
+		} else {
+			matches[term] = prefixFactor * float64(len(word)) / float64(len(term))
+		}
+	}
+	maxDistance := 0
+	switch length := len([]rune(word)); {
+	case length >= 8:
+		maxDistance = 2
+	case length >= 4:
+		maxDistance = 1
+	}
+	if maxDistance == 0 {
+		return matches
+	}
+	for _, term := range idx.words {
+		if _, ok := matches[term]; ok {
+			continue
+		}
+		if editDistance(word, term, maxDistance) <= maxDistance {
+			matches[term] = typoFactor
+		}
+	}
+	return matches
+}
+
+// tokenize splits the given text into lower-case words.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char)
+	})
+}
+
+// editDistance returns the number of single-character insertions,
+// deletions, substitutions, and transpositions of adjacent characters that
+// are needed to change a into b. If the distance is greater than limit, it
+// returns limit+1.
+func editDistance(a, b string, limit int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > limit || -diff > limit {
+		return limit + 1
+	}
+	// prev2, prev, and curr are the rows of the dynamic programming matrix
+	// for the previous two and current characters of a.
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return min(prev[len(rb)], limit+1)
+}
@@ -0,0 +1,254 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	entityv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/entity/v1"
+	searchv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/search/v1"
+	"github.com/peterhellberg/swapi"
+)
+
+//nolint:gochecknoglobals
+var searchDataset = &Dataset{
+	Films: []*Film{
+		{Film: swapi.Film{Title: "A New Hope", URL: "https://swapi.dev/api/films/1/", Director: "George Lucas"}},
+		{Film: swapi.Film{Title: "Revenge of the Sith", URL: "https://swapi.dev/api/films/6/", OpeningCrawl: "Darth Vader is not yet born."}},
+	},
+	People: []*swapi.Person{
+		{Name: "Luke Skywalker", URL: "https://swapi.dev/api/people/1/"},
+		{Name: "Darth Vader", URL: "https://swapi.dev/api/people/4/"},
+		{Name: "Anakin Skywalker", URL: "https://swapi.dev/api/people/11/"},
+	},
+	Planets: []*swapi.Planet{
+		{Name: "Tatooine", URL: "https://swapi.dev/api/planets/1/"},
+	},
+	Starships: []*swapi.Starship{
+		{Name: "Death Star", Model: "DS-1 Orbital Battle Station", URL: "https://swapi.dev/api/starships/9/"},
+	},
+}
+
+func newSearchTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	handler, err := NewHandler(WithDataSource(NewMemoryDataSource(searchDataset)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return handler
+}
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		text string
+		want []string
+	}{
+		{text: "Luke Skywalker", want: []string{"luke", "skywalker"}},
+		{text: "DS-1 Orbital Battle Station", want: []string{"ds", "1", "orbital", "battle", "station"}},
+		{text: "Vader's TIE", want: []string{"vader", "s", "tie"}},
+		{text: "R2-D2", want: []string{"r2", "d2"}},
+		{text: "Padmé", want: []string{"padmé"}},
+		{text: " -- "},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.text, func(t *testing.T) {
+			t.Parallel()
+			if got := tokenize(testCase.text); !slices.Equal(got, testCase.want) {
+				t.Errorf("tokenize(%q) = %q, want %q", testCase.text, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		a, b  string
+		limit int
+		want  int
+	}{
+		{a: "", b: "", limit: 2, want: 0},
+		{a: "vader", b: "vader", limit: 1, want: 0},
+		{a: "vader", b: "vadr", limit: 1, want: 1},
+		{a: "kitten", b: "sitting", limit: 3, want: 3},
+		// A transposition of adjacent characters is one edit.
+		{a: "skywalker", b: "skywalkre", limit: 2, want: 1},
+		{a: "ab", b: "ba", limit: 1, want: 1},
+		// Distances greater than the limit are reported as limit+1, both
+		// when the lengths differ too much and when a row of the matrix
+		// exceeds the limit.
+		{a: "a", b: "abcd", limit: 2, want: 3},
+		{a: "abcdef", b: "uvwxyz", limit: 2, want: 3},
+		{a: "kitten", b: "sitting", limit: 1, want: 2},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.a+"/"+testCase.b, func(t *testing.T) {
+			t.Parallel()
+			if got := editDistance(testCase.a, testCase.b, testCase.limit); got != testCase.want {
+				t.Errorf("editDistance(%q, %q, %d) = %d, want %d", testCase.a, testCase.b, testCase.limit, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMatchWord(t *testing.T) {
+	t.Parallel()
+	idx := newSearchTestHandler(t).store.Load().searchIndex()
+	testCases := []struct {
+		word string
+		want map[string]float64
+	}{
+		{word: "skywalker", want: map[string]float64{"skywalker": 1}},
+		{word: "sky", want: map[string]float64{"skywalker": prefixFactor * float64(len("sky")) / float64(len("skywalker"))}},
+		// Words of at least 8 characters may have two typos, and words of
+		// at least 4 characters may have one.
+		{word: "skywlakr", want: map[string]float64{"skywalker": typoFactor}},
+		{word: "vadr", want: map[string]float64{"vader": typoFactor}},
+		{word: "vdr", want: map[string]float64{}},
+		{word: "stars", want: map[string]float64{"star": typoFactor}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.word, func(t *testing.T) {
+			t.Parallel()
+			if got := idx.matchWord(testCase.word); !maps.Equal(got, testCase.want) {
+				t.Errorf("matchWord(%q) = %v, want %v", testCase.word, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	t.Parallel()
+	idx := newSearchTestHandler(t).store.Load().searchIndex()
+	testCases := []struct {
+		name  string
+		query string
+		kinds []string
+		want  []string
+	}{
+		{
+			// Equal scores are in the order of the index.
+			name:  "tie",
+			query: "skywalker",
+			want:  []string{"person/1", "person/11"},
+		},
+		{
+			name:  "all words",
+			query: "Luke Skywalker",
+			want:  []string{"person/1"},
+		},
+		{
+			name:  "missing word",
+			query: "luke vader",
+		},
+		{
+			// The name of a person has a higher weight than the opening
+			// crawl of a film.
+			name:  "score",
+			query: "darth vader",
+			want:  []string{"person/4", "film/6"},
+		},
+		{
+			name:  "types",
+			query: "vader",
+			kinds: []string{"film"},
+			want:  []string{"film/6"},
+		},
+		{
+			name:  "prefix and typo",
+			query: "skywlakr luk",
+			want:  []string{"person/1"},
+		},
+		{
+			// Each word matches, but not in the same entity.
+			name:  "words in different entities",
+			query: "skywalker tatooine",
+		},
+		{
+			name:  "model",
+			query: "orbital",
+			want:  []string{"starship/9"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			var got []string
+			for _, result := range idx.search(testCase.query, testCase.kinds) {
+				got = append(got, result.kind+"/"+entityID(result.msg))
+			}
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("search(%q) = %v, want %v", testCase.query, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSearchPagination(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	handler := newSearchTestHandler(t)
+	var names []string
+	req := &searchv1.SearchRequest{Query: "skywalker", PageSize: 1}
+	for range 3 {
+		resp, err := handler.Search(ctx, connect.NewRequest(req))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, hit := range resp.Msg.GetHits() {
+			names = append(names, hit.GetEntity().GetPerson().GetName())
+		}
+		if resp.Msg.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.Msg.GetNextPageToken()
+	}
+	if want := []string{"Luke Skywalker", "Anakin Skywalker"}; !slices.Equal(names, want) {
+		t.Errorf("pages returned %v, want %v", names, want)
+	}
+
+	first, err := handler.Search(ctx, connect.NewRequest(&searchv1.SearchRequest{Query: "skywalker", PageSize: 1}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name string
+		req  *searchv1.SearchRequest
+	}{
+		{name: "empty query", req: &searchv1.SearchRequest{Query: "?!"}},
+		{name: "other query", req: &searchv1.SearchRequest{Query: "luke", PageToken: first.Msg.GetNextPageToken()}},
+		{
+			name: "other types",
+			req: &searchv1.SearchRequest{
+				Query: "skywalker", Types: []entityv1.EntityType{entityv1.EntityType_ENTITY_TYPE_PERSON},
+				PageToken: first.Msg.GetNextPageToken(),
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := handler.Search(ctx, connect.NewRequest(testCase.req))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Errorf("Search returned %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	statsv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/stats/v1"
+	"github.com/bufbuild/knit-demo/go/internal/filter"
+	"github.com/bufbuild/knit-demo/go/internal/protopath"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// aggregation is a validated statsv1.Aggregation.
+type aggregation struct {
+	function statsv1.AggregateFunction
+	// path is the path to the aggregated field, or nil to count all
+	// entities.
+	path []protoreflect.FieldDescriptor
+}
+
+func newAggregation(desc protoreflect.MessageDescriptor, agg *statsv1.Aggregation) (aggregation, error) {
+	result := aggregation{function: agg.GetFunction()}
+	switch result.function {
+	case statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT:
+		if agg.GetField() == "" {
+			return result, nil
+		}
+	case statsv1.AggregateFunction_AGGREGATE_FUNCTION_SUM,
+		statsv1.AggregateFunction_AGGREGATE_FUNCTION_MIN,
+		statsv1.AggregateFunction_AGGREGATE_FUNCTION_MAX,
+		statsv1.AggregateFunction_AGGREGATE_FUNCTION_AVG:
+	default:
+		return result, fmt.Errorf("invalid aggregate function: %v", result.function)
+	}
+	path, err := resolveFieldPath(desc, agg.GetField())
+	if err != nil {
+		return result, err
+	}
+	result.path = path
+	if field := path[len(path)-1]; result.function != statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT &&
+		(field.IsList() || field.IsMap() || !isNumeric(field)) {
+		return result, fmt.Errorf("cannot compute %s of field %q because it is not a singular numeric field",
+			strings.TrimPrefix(result.function.String(), "AGGREGATE_FUNCTION_"), agg.GetField())
+	}
+	return result, nil
+}
+
+// resolveFieldPath resolves a dot-separated path of field names, which may
+// be proto names or JSON names, against the given message descriptor. All
+// but the last field must be singular message fields.
+func resolveFieldPath(desc protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	if path == "" {
+		return nil, errors.New("field path is empty")
+	}
+	var fields []protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if desc == nil || (len(fields) > 0 && fields[len(fields)-1].IsList()) {
+			return nil, fmt.Errorf("cannot refer to field %q of %q because it is not a singular message", name, fieldPathName(fields))
+		}
+		field := protopath.FieldByName(desc, name)
+		if field == nil {
+			return nil, fmt.Errorf("unknown field %q", name)
+		}
+		fields = append(fields, field)
+		desc = field.Message()
+	}
+	return fields, nil
+}
+
+func fieldPathName(fields []protoreflect.FieldDescriptor) string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = string(field.Name())
+	}
+	return strings.Join(names, ".")
+}
+
+func isNumeric(field protoreflect.FieldDescriptor) bool {
+	switch field.Kind() { //nolint:exhaustive
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return true
+	default:
+		return false
+	}
+}
+
+// newGroupBy resolves the path of the field to group by, which is nil if
+// the given path is empty.
+func newGroupBy(desc protoreflect.MessageDescriptor, groupBy string) ([]protoreflect.FieldDescriptor, error) {
+	if groupBy == "" {
+		return nil, nil
+	}
+	path, err := resolveFieldPath(desc, groupBy)
+	if err != nil {
+		return nil, err
+	}
+	field := path[len(path)-1]
+	if field.IsMap() || field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind ||
+		field.Kind() == protoreflect.BytesKind {
+		return nil, fmt.Errorf("cannot group by field %q of type %s", groupBy, field.Kind())
+	}
+	return path, nil
+}
+
+// fieldParent returns the message that contains the last field of the given
+// path, or false if any of the intermediate messages is absent.
+func fieldParent(msg protoreflect.Message, path []protoreflect.FieldDescriptor) (protoreflect.Message, bool) {
+	for _, field := range path[:len(path)-1] {
+		if !msg.Has(field) {
+			return nil, false
+		}
+		msg = msg.Get(field).Message()
+	}
+	return msg, true
+}
+
+// fieldPresent reports whether the field at the given path has a value. As
+// in numericValue, singular fields that do not track presence always have a
+// value, even if it is zero. Repeated and map fields have a value if they are
+// not empty.
+func fieldPresent(msg protoreflect.Message, path []protoreflect.FieldDescriptor) bool {
+	parent, ok := fieldParent(msg, path)
+	if !ok {
+		return false
+	}
+	field := path[len(path)-1]
+	if field.IsList() || field.IsMap() || field.HasPresence() {
+		return parent.Has(field)
+	}
+	return true
+}
+
+// groupKeys returns the keys of the groups that the given entity belongs to,
+// or false if it belongs to the group without a key because the field is
+// absent or empty.
+func groupKeys(msg protoreflect.Message, path []protoreflect.FieldDescriptor) ([]string, bool) {
+	if path == nil {
+		return nil, false
+	}
+	parent, ok := fieldParent(msg, path)
+	field := path[len(path)-1]
+	if !ok || (field.HasPresence() && !parent.Has(field)) {
+		return nil, false
+	}
+	value := parent.Get(field)
+	if !field.IsList() {
+		return []string{formatKey(field, value)}, true
+	}
+	list := value.List()
+	if list.Len() == 0 {
+		return nil, false
+	}
+	keys := make([]string, list.Len())
+	for i := range list.Len() {
+		keys[i] = formatKey(field, list.Get(i))
+	}
+	return dedupe(keys), true
+}
+
+func formatKey(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() { //nolint:exhaustive
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return strconv.Itoa(int(value.Enum()))
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64)
+	default:
+		// Strings, bools, and integers are formatted by the value itself.
+		return value.String()
+	}
+}
+
+// numericValue returns the value of the field at the given path as a
+// float64, or false if it is absent.
+func numericValue(msg protoreflect.Message, path []protoreflect.FieldDescriptor) (float64, bool) {
+	parent, ok := fieldParent(msg, path)
+	field := path[len(path)-1]
+	if !ok || (field.HasPresence() && !parent.Has(field)) {
+		return 0, false
+	}
+	value := parent.Get(field)
+	switch field.Kind() { //nolint:exhaustive
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(value.Uint()), true
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float(), true
+	default:
+		return float64(value.Int()), true
+	}
+}
+
+// accumulator accumulates the values of one aggregation in one group.
+type accumulator struct {
+	count         int64
+	sum, min, max float64
+}
+
+func (a *accumulator) add(value float64) {
+	if a.count == 0 {
+		a.min, a.max = value, value
+	}
+	a.count++
+	a.sum += value
+	a.min = math.Min(a.min, value)
+	a.max = math.Max(a.max, value)
+}
+
+func (a *accumulator) result(function statsv1.AggregateFunction) *statsv1.AggregateResult {
+	var value float64
+	switch function { //nolint:exhaustive
+	case statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT:
+		value = float64(a.count)
+	default:
+		if a.count == 0 {
+			return &statsv1.AggregateResult{}
+		}
+		switch function { //nolint:exhaustive
+		case statsv1.AggregateFunction_AGGREGATE_FUNCTION_SUM:
+			value = a.sum
+		case statsv1.AggregateFunction_AGGREGATE_FUNCTION_MIN:
+			value = a.min
+		case statsv1.AggregateFunction_AGGREGATE_FUNCTION_MAX:
+			value = a.max
+		default:
+			value = a.sum / float64(a.count)
+		}
+	}
+	return &statsv1.AggregateResult{Value: &value}
+}
+
+// aggregateGroup accumulates the aggregates of one group of entities.
+type aggregateGroup struct {
+	// key is nil for the group without a key.
+	key          *string
+	count        int64
+	accumulators []accumulator
+}
+
+func (g *aggregateGroup) result(aggs []aggregation) *statsv1.Group {
+	result := &statsv1.Group{Key: g.key, Count: g.count}
+	for i, agg := range aggs {
+		result.Results = append(result.Results, g.accumulators[i].result(agg.function))
+	}
+	return result
+}
+
+// aggregate computes the given aggregations of the entities that match the
+// given filter, grouped by the field at the given path.
+func aggregate(entities []proto.Message, matcher *filter.Filter, groupBy []protoreflect.FieldDescriptor, aggs []aggregation) []*statsv1.Group {
+	// The group without a key is kept apart from the others, so that it is
+	// distinct from the group whose key is the empty string.
+	var noKey *aggregateGroup
+	groups := map[string]*aggregateGroup{}
+	for _, item := range entities {
+		msg := item.ProtoReflect()
+		if !matcher.Match(msg) {
+			continue
+		}
+		var memberOf []*aggregateGroup
+		keys, ok := groupKeys(msg, groupBy)
+		if !ok {
+			if noKey == nil {
+				noKey = &aggregateGroup{accumulators: make([]accumulator, len(aggs))}
+			}
+			memberOf = append(memberOf, noKey)
+		}
+		for _, key := range keys {
+			grp, ok := groups[key]
+			if !ok {
+				grp = &aggregateGroup{key: proto.String(key), accumulators: make([]accumulator, len(aggs))}
+				groups[key] = grp
+			}
+			memberOf = append(memberOf, grp)
+		}
+		for _, grp := range memberOf {
+			grp.count++
+			for i, agg := range aggs {
+				switch {
+				case agg.path == nil:
+					grp.accumulators[i].add(1)
+				case agg.function == statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT:
+					if fieldPresent(msg, agg.path) {
+						grp.accumulators[i].add(1)
+					}
+				default:
+					if value, ok := numericValue(msg, agg.path); ok {
+						grp.accumulators[i].add(value)
+					}
+				}
+			}
+		}
+	}
+	results := make([]*statsv1.Group, 0, len(groups)+1)
+	for _, grp := range groups {
+		results = append(results, grp.result(aggs))
+	}
+	slices.SortFunc(results, func(a, b *statsv1.Group) int {
+		return compareKeys(a.GetKey(), b.GetKey())
+	})
+	if noKey != nil {
+		results = slices.Insert(results, 0, noKey.result(aggs))
+	}
+	return results
+}
+
+// compareKeys orders group keys, with the empty key first, then numeric
+// keys in numeric order, then all other keys.
+func compareKeys(a, b string) int {
+	if a == "" || b == "" {
+		return cmp.Compare(a, b)
+	}
+	aNum, aErr := strconv.ParseFloat(a, 64)
+	bNum, bErr := strconv.ParseFloat(b, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(aNum, bNum)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
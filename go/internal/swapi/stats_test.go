@@ -0,0 +1,132 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"testing"
+
+	starshipv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	statsv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/stats/v1"
+	"github.com/bufbuild/knit-demo/go/internal/filter"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAggregate(t *testing.T) {
+	t.Parallel()
+	starships := []proto.Message{
+		&starshipv1.Starship{Id: "1", Class: "cruiser", Length: proto.Float64(100), Manufacturers: []string{"Kuat", "Sienar"}},
+		&starshipv1.Starship{Id: "2", Class: "cruiser", Length: proto.Float64(300), Manufacturers: []string{""}},
+		&starshipv1.Starship{Id: "3", Class: "", Manufacturers: []string{"Kuat"}},
+		&starshipv1.Starship{Id: "4", Class: "10"},
+	}
+	count := &statsv1.Aggregation{Function: statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT}
+	sum := &statsv1.Aggregation{Function: statsv1.AggregateFunction_AGGREGATE_FUNCTION_SUM, Field: "length"}
+	avg := &statsv1.Aggregation{Function: statsv1.AggregateFunction_AGGREGATE_FUNCTION_AVG, Field: "length"}
+	value := func(value float64) *statsv1.AggregateResult {
+		return &statsv1.AggregateResult{Value: &value}
+	}
+	testCases := []struct {
+		name         string
+		filter       string
+		groupBy      string
+		aggregations []*statsv1.Aggregation
+		want         []*statsv1.Group
+	}{
+		{
+			name:         "single group",
+			aggregations: []*statsv1.Aggregation{count, sum, avg},
+			want: []*statsv1.Group{
+				{Count: 4, Results: []*statsv1.AggregateResult{value(4), value(400), value(200)}},
+			},
+		},
+		{
+			// The empty class is a key, and numeric keys sort before others.
+			name:         "empty key",
+			groupBy:      "class",
+			aggregations: []*statsv1.Aggregation{sum},
+			want: []*statsv1.Group{
+				{Key: proto.String(""), Count: 1, Results: []*statsv1.AggregateResult{{}}},
+				{Key: proto.String("10"), Count: 1, Results: []*statsv1.AggregateResult{{}}},
+				{Key: proto.String("cruiser"), Count: 2, Results: []*statsv1.AggregateResult{value(400)}},
+			},
+		},
+		{
+			// Starship 4 has no manufacturers, so it is in the group without
+			// a key, which is distinct from the group of the empty string.
+			name:         "no key",
+			groupBy:      "manufacturers",
+			aggregations: []*statsv1.Aggregation{count},
+			want: []*statsv1.Group{
+				{Count: 1, Results: []*statsv1.AggregateResult{value(1)}},
+				{Key: proto.String(""), Count: 1, Results: []*statsv1.AggregateResult{value(1)}},
+				{Key: proto.String("Kuat"), Count: 2, Results: []*statsv1.AggregateResult{value(2)}},
+				{Key: proto.String("Sienar"), Count: 1, Results: []*statsv1.AggregateResult{value(1)}},
+			},
+		},
+		{
+			// Like the other functions, COUNT includes zero values of fields
+			// that do not track presence, such as the empty class, but not
+			// absent optional fields or empty repeated fields.
+			name:   "count of fields",
+			filter: `id != "2"`,
+			aggregations: []*statsv1.Aggregation{
+				{Function: statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT, Field: "length"},
+				{Function: statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT, Field: "class"},
+				{Function: statsv1.AggregateFunction_AGGREGATE_FUNCTION_COUNT, Field: "manufacturers"},
+			},
+			want: []*statsv1.Group{
+				{Count: 3, Results: []*statsv1.AggregateResult{value(1), value(3), value(2)}},
+			},
+		},
+		{
+			name:         "no values",
+			filter:       `class = "10"`,
+			aggregations: []*statsv1.Aggregation{count, sum, avg},
+			want: []*statsv1.Group{
+				{Count: 1, Results: []*statsv1.AggregateResult{value(1), {}, {}}},
+			},
+		},
+		{
+			name:         "no entities",
+			filter:       `class = "frigate"`,
+			aggregations: []*statsv1.Aggregation{count},
+		},
+	}
+	desc := (&starshipv1.Starship{}).ProtoReflect().Descriptor()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+			matcher, err := filter.Compile(testCase.filter, desc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			groupBy, err := newGroupBy(desc, testCase.groupBy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			aggs := make([]aggregation, len(testCase.aggregations))
+			for i, agg := range testCase.aggregations {
+				if aggs[i], err = newAggregation(desc, agg); err != nil {
+					t.Fatal(err)
+				}
+			}
+			got := &statsv1.AggregateResponse{Groups: aggregate(starships, matcher, groupBy, aggs)}
+			want := &statsv1.AggregateResponse{Groups: testCase.want}
+			if !proto.Equal(got, want) {
+				t.Errorf("aggregate returned %v, want %v", got, want)
+			}
+		})
+	}
+}
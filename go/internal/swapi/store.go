@@ -0,0 +1,219 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+
+	filmv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/film/v1"
+	manufacturerv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/manufacturer/v1"
+	personv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/person/v1"
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+	speciesv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/species/v1"
+	starshipv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/starship/v1"
+	vehiclev1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/vehicle/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Store is an indexed, in-memory snapshot of the Star Wars API data.
+//
+// All entities are transformed into their proto representations once, when
+// the store is built, and are indexed by ID. The messages in a store are
+// shared by all responses that include them, so they must never be mutated.
+type Store struct {
+	films     entityIndex[*filmv1.Film]
+	people    entityIndex[*personv1.Person]
+	planets   entityIndex[*planetv1.Planet]
+	species   entityIndex[*speciesv1.Species]
+	starships entityIndex[*starshipv1.Starship]
+	vehicles  entityIndex[*vehiclev1.Vehicle]
+	// version is incremented every time the data served by a Handler
+	// changes, whether by a reload or a mutation.
+	version uint64
+
+	searchOnce sync.Once
+	search     *searchIndex
+
+	manufacturersOnce sync.Once
+	manufacturers     entityIndex[*manufacturerv1.Manufacturer]
+
+	graphOnce sync.Once
+	graph     *entityGraph
+}
+
+func newStore(dataset *Dataset) *Store {
+	return &Store{
+		films:     newEntityIndex(transform(dataset.Films, transformFilm)),
+		people:    newEntityIndex(transform(dataset.People, transformPerson)),
+		planets:   newEntityIndex(transform(dataset.Planets, transformPlanet)),
+		species:   newEntityIndex(transform(dataset.Species, transformSpecies)),
+		starships: newEntityIndex(transform(dataset.Starships, transformStarship)),
+		vehicles:  newEntityIndex(transform(dataset.Vehicles, transformVehicle)),
+	}
+}
+
+func (s *Store) clone() *Store {
+	return &Store{
+		films:     s.films.clone(),
+		people:    s.people.clone(),
+		planets:   s.planets.clone(),
+		species:   s.species.clone(),
+		starships: s.starships.clone(),
+		vehicles:  s.vehicles.clone(),
+		version:   s.version,
+	}
+}
+
+// searchIndex returns the full-text search index of the store's entities.
+// It is built the first time it is needed.
+func (s *Store) searchIndex() *searchIndex {
+	s.searchOnce.Do(func() {
+		s.search = newSearchIndex(s)
+	})
+	return s.search
+}
+
+// entityGraph returns the graph of the store's entities and their
+// relations. It is built the first time it is needed.
+func (s *Store) entityGraph() *entityGraph {
+	s.graphOnce.Do(func() {
+		s.graph = newEntityGraph(s)
+	})
+	return s.graph
+}
+
+// manufacturerIndex returns the manufacturers, which are derived from the
+// store's starships and vehicles. They are derived the first time they are
+// needed.
+func (s *Store) manufacturerIndex() *entityIndex[*manufacturerv1.Manufacturer] {
+	s.manufacturersOnce.Do(func() {
+		s.manufacturers = newManufacturerIndex(s)
+	})
+	return &s.manufacturers
+}
+
+// indexByName returns the index for the entity type with the given name,
+// such as "film" or "person".
+func (s *Store) indexByName(name string) messageIndex {
+	switch name {
+	case filmType.name:
+		return &s.films
+	case personType.name:
+		return &s.people
+	case planetType.name:
+		return &s.planets
+	case speciesType.name:
+		return &s.species
+	case starshipType.name:
+		return &s.starships
+	case vehicleType.name:
+		return &s.vehicles
+	default:
+		panic(fmt.Sprintf("unknown entity type %q", name)) //nolint:forbidigo // indicates a bug
+	}
+}
+
+// entity is the constraint satisfied by all proto entity messages.
+type entity interface {
+	proto.Message
+	GetId() string
+	GetEdited() *timestamppb.Timestamp
+}
+
+// entityIndex holds all entities of one type, in their original order,
+// along with an index by ID.
+type entityIndex[T entity] struct {
+	all  []T
+	byID map[string]T
+}
+
+func newEntityIndex[T entity](entities []T) entityIndex[T] {
+	byID := make(map[string]T, len(entities))
+	for _, item := range entities {
+		byID[item.GetId()] = item
+	}
+	return entityIndex[T]{all: entities, byID: byID}
+}
+
+// messageIndex provides access to an entityIndex without static knowledge
+// of its type of entity.
+type messageIndex interface {
+	getMessage(id string) (proto.Message, bool)
+	putMessage(msg proto.Message)
+	messages() []proto.Message
+}
+
+func (e *entityIndex[T]) getMessage(id string) (proto.Message, bool) {
+	item, ok := e.byID[id]
+	if !ok {
+		return nil, false
+	}
+	return item, true
+}
+
+func (e *entityIndex[T]) putMessage(msg proto.Message) {
+	e.put(msg.(T)) //nolint:forcetypeassert,errcheck
+}
+
+func (e *entityIndex[T]) messages() []proto.Message {
+	results := make([]proto.Message, len(e.all))
+	for i, item := range e.all {
+		results[i] = item
+	}
+	return results
+}
+
+func (e *entityIndex[T]) clone() entityIndex[T] {
+	return entityIndex[T]{all: slices.Clone(e.all), byID: maps.Clone(e.byID)}
+}
+
+// put adds the given entity to the index, replacing any existing entity with
+// the same ID. Since the index's contents may be shared with other stores,
+// this must only be called on a clone.
+func (e *entityIndex[T]) put(item T) {
+	id := item.GetId()
+	if _, exists := e.byID[id]; exists {
+		index := slices.IndexFunc(e.all, func(existing T) bool { return existing.GetId() == id })
+		e.all[index] = item
+	} else {
+		e.all = append(e.all, item)
+	}
+	e.byID[id] = item
+}
+
+// remove removes the entity with the given ID from the index. Since the
+// index's contents may be shared with other stores, this must only be called
+// on a clone.
+func (e *entityIndex[T]) remove(id string) {
+	delete(e.byID, id)
+	e.all = slices.DeleteFunc(e.all, func(existing T) bool { return existing.GetId() == id })
+}
+
+// nextID returns an ID for a new entity. It is one greater than the
+// largest numeric ID in the index.
+func (e *entityIndex[T]) nextID() string {
+	var maxID int64
+	for id := range e.byID {
+		if val, err := strconv.ParseInt(id, 10, 64); err == nil && val > maxID {
+			maxID = val
+		}
+	}
+	return strconv.FormatInt(maxID+1, 10)
+}
@@ -18,96 +18,114 @@ package swapi
 import "github.com/peterhellberg/swapi"
 
 var (
-	allFilms = []*swapi.Film{
-		{
-			Title:         "A New Hope",
-			EpisodeID:     4,
-			OpeningCrawl:  "It is a period of civil war.\r\nRebel spaceships, striking\r\nfrom a hidden base, have won\r\ntheir first victory against\r\nthe evil Galactic Empire.\r\n\r\nDuring the battle, Rebel\r\nspies managed to steal secret\r\nplans to the Empire's\r\nultimate weapon, the DEATH\r\nSTAR, an armored space\r\nstation with enough power\r\nto destroy an entire planet.\r\n\r\nPursued by the Empire's\r\nsinister agents, Princess\r\nLeia races home aboard her\r\nstarship, custodian of the\r\nstolen plans that can save her\r\npeople and restore\r\nfreedom to the galaxy....",
-			Director:      "George Lucas",
-			Producer:      "Gary Kurtz, Rick McCallum",
-			CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/6/", "https://swapi.dev/api/people/7/", "https://swapi.dev/api/people/8/", "https://swapi.dev/api/people/9/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/12/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/", "https://swapi.dev/api/people/15/", "https://swapi.dev/api/people/16/", "https://swapi.dev/api/people/18/", "https://swapi.dev/api/people/19/", "https://swapi.dev/api/people/81/"},
-			PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/2/", "https://swapi.dev/api/planets/3/"},
-			StarshipURLs:  []string{"https://swapi.dev/api/starships/2/", "https://swapi.dev/api/starships/3/", "https://swapi.dev/api/starships/5/", "https://swapi.dev/api/starships/9/", "https://swapi.dev/api/starships/10/", "https://swapi.dev/api/starships/11/", "https://swapi.dev/api/starships/12/", "https://swapi.dev/api/starships/13/"},
-			VehicleURLs:   []string{"https://swapi.dev/api/vehicles/4/", "https://swapi.dev/api/vehicles/6/", "https://swapi.dev/api/vehicles/7/", "https://swapi.dev/api/vehicles/8/"},
-			SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/4/", "https://swapi.dev/api/species/5/"},
-			Created:       "2014-12-10T14:23:31.880000Z",
-			Edited:        "2014-12-20T19:49:45.256000Z",
-			URL:           "https://swapi.dev/api/films/1/",
-		},
-		{
-			Title:         "The Empire Strikes Back",
-			EpisodeID:     5,
-			OpeningCrawl:  "It is a dark time for the\r\nRebellion. Although the Death\r\nStar has been destroyed,\r\nImperial troops have driven the\r\nRebel forces from their hidden\r\nbase and pursued them across\r\nthe galaxy.\r\n\r\nEvading the dreaded Imperial\r\nStarfleet, a group of freedom\r\nfighters led by Luke Skywalker\r\nhas established a new secret\r\nbase on the remote ice world\r\nof Hoth.\r\n\r\nThe evil lord Darth Vader,\r\nobsessed with finding young\r\nSkywalker, has dispatched\r\nthousands of remote probes into\r\nthe far reaches of space....",
-			Director:      "Irvin Kershner",
-			Producer:      "Gary Kurtz, Rick McCallum",
-			CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/", "https://swapi.dev/api/people/18/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/22/", "https://swapi.dev/api/people/23/", "https://swapi.dev/api/people/24/", "https://swapi.dev/api/people/25/", "https://swapi.dev/api/people/26/"},
-			PlanetURLs:    []string{"https://swapi.dev/api/planets/4/", "https://swapi.dev/api/planets/5/", "https://swapi.dev/api/planets/6/", "https://swapi.dev/api/planets/27/"},
-			StarshipURLs:  []string{"https://swapi.dev/api/starships/3/", "https://swapi.dev/api/starships/10/", "https://swapi.dev/api/starships/11/", "https://swapi.dev/api/starships/12/", "https://swapi.dev/api/starships/15/", "https://swapi.dev/api/starships/17/", "https://swapi.dev/api/starships/21/", "https://swapi.dev/api/starships/22/", "https://swapi.dev/api/starships/23/"},
-			VehicleURLs:   []string{"https://swapi.dev/api/vehicles/8/", "https://swapi.dev/api/vehicles/14/", "https://swapi.dev/api/vehicles/16/", "https://swapi.dev/api/vehicles/18/", "https://swapi.dev/api/vehicles/19/", "https://swapi.dev/api/vehicles/20/"},
-			SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/7/"},
-			Created:       "2014-12-12T11:26:24.656000Z",
-			Edited:        "2014-12-15T13:07:53.386000Z",
-			URL:           "https://swapi.dev/api/films/2/",
-		},
-		{
-			Title:         "Return of the Jedi",
-			EpisodeID:     6,
-			OpeningCrawl:  "Luke Skywalker has returned to\r\nhis home planet of Tatooine in\r\nan attempt to rescue his\r\nfriend Han Solo from the\r\nclutches of the vile gangster\r\nJabba the Hutt.\r\n\r\nLittle does Luke know that the\r\nGALACTIC EMPIRE has secretly\r\nbegun construction on a new\r\narmored space station even\r\nmore powerful than the first\r\ndreaded Death Star.\r\n\r\nWhen completed, this ultimate\r\nweapon will spell certain doom\r\nfor the small band of rebels\r\nstruggling to restore freedom\r\nto the galaxy...",
-			Director:      "Richard Marquand",
-			Producer:      "Howard G. Kazanjian, George Lucas, Rick McCallum",
-			CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/", "https://swapi.dev/api/people/16/", "https://swapi.dev/api/people/18/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/22/", "https://swapi.dev/api/people/25/", "https://swapi.dev/api/people/27/", "https://swapi.dev/api/people/28/", "https://swapi.dev/api/people/29/", "https://swapi.dev/api/people/30/", "https://swapi.dev/api/people/31/", "https://swapi.dev/api/people/45/"},
-			PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/5/", "https://swapi.dev/api/planets/7/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/"},
-			StarshipURLs:  []string{"https://swapi.dev/api/starships/2/", "https://swapi.dev/api/starships/3/", "https://swapi.dev/api/starships/10/", "https://swapi.dev/api/starships/11/", "https://swapi.dev/api/starships/12/", "https://swapi.dev/api/starships/15/", "https://swapi.dev/api/starships/17/", "https://swapi.dev/api/starships/22/", "https://swapi.dev/api/starships/23/", "https://swapi.dev/api/starships/27/", "https://swapi.dev/api/starships/28/", "https://swapi.dev/api/starships/29/"},
-			VehicleURLs:   []string{"https://swapi.dev/api/vehicles/8/", "https://swapi.dev/api/vehicles/16/", "https://swapi.dev/api/vehicles/18/", "https://swapi.dev/api/vehicles/19/", "https://swapi.dev/api/vehicles/24/", "https://swapi.dev/api/vehicles/25/", "https://swapi.dev/api/vehicles/26/", "https://swapi.dev/api/vehicles/30/"},
-			SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/5/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/8/", "https://swapi.dev/api/species/9/", "https://swapi.dev/api/species/10/", "https://swapi.dev/api/species/15/"},
-			Created:       "2014-12-18T10:39:33.255000Z",
-			Edited:        "2014-12-20T09:48:37.462000Z",
-			URL:           "https://swapi.dev/api/films/3/",
-		},
-		{
-			Title:         "The Phantom Menace",
-			EpisodeID:     1,
-			OpeningCrawl:  "Turmoil has engulfed the\r\nGalactic Republic. The taxation\r\nof trade routes to outlying star\r\nsystems is in dispute.\r\n\r\nHoping to resolve the matter\r\nwith a blockade of deadly\r\nbattleships, the greedy Trade\r\nFederation has stopped all\r\nshipping to the small planet\r\nof Naboo.\r\n\r\nWhile the Congress of the\r\nRepublic endlessly debates\r\nthis alarming chain of events,\r\nthe Supreme Chancellor has\r\nsecretly dispatched two Jedi\r\nKnights, the guardians of\r\npeace and justice in the\r\ngalaxy, to settle the conflict....",
-			Director:      "George Lucas",
-			Producer:      "Rick McCallum",
-			CharacterURLs: []string{"https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/11/", "https://swapi.dev/api/people/16/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/32/", "https://swapi.dev/api/people/33/", "https://swapi.dev/api/people/34/", "https://swapi.dev/api/people/35/", "https://swapi.dev/api/people/36/", "https://swapi.dev/api/people/37/", "https://swapi.dev/api/people/38/", "https://swapi.dev/api/people/39/", "https://swapi.dev/api/people/40/", "https://swapi.dev/api/people/41/", "https://swapi.dev/api/people/42/", "https://swapi.dev/api/people/43/", "https://swapi.dev/api/people/44/", "https://swapi.dev/api/people/46/", "https://swapi.dev/api/people/47/", "https://swapi.dev/api/people/48/", "https://swapi.dev/api/people/49/", "https://swapi.dev/api/people/50/", "https://swapi.dev/api/people/51/", "https://swapi.dev/api/people/52/", "https://swapi.dev/api/people/53/", "https://swapi.dev/api/people/54/", "https://swapi.dev/api/people/55/", "https://swapi.dev/api/people/56/", "https://swapi.dev/api/people/57/", "https://swapi.dev/api/people/58/", "https://swapi.dev/api/people/59/"},
-			PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/"},
-			StarshipURLs:  []string{"https://swapi.dev/api/starships/31/", "https://swapi.dev/api/starships/32/", "https://swapi.dev/api/starships/39/", "https://swapi.dev/api/starships/40/", "https://swapi.dev/api/starships/41/"},
-			VehicleURLs:   []string{"https://swapi.dev/api/vehicles/33/", "https://swapi.dev/api/vehicles/34/", "https://swapi.dev/api/vehicles/35/", "https://swapi.dev/api/vehicles/36/", "https://swapi.dev/api/vehicles/37/", "https://swapi.dev/api/vehicles/38/", "https://swapi.dev/api/vehicles/42/"},
-			SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/11/", "https://swapi.dev/api/species/12/", "https://swapi.dev/api/species/13/", "https://swapi.dev/api/species/14/", "https://swapi.dev/api/species/15/", "https://swapi.dev/api/species/16/", "https://swapi.dev/api/species/17/", "https://swapi.dev/api/species/18/", "https://swapi.dev/api/species/19/", "https://swapi.dev/api/species/20/", "https://swapi.dev/api/species/21/", "https://swapi.dev/api/species/22/", "https://swapi.dev/api/species/23/", "https://swapi.dev/api/species/24/", "https://swapi.dev/api/species/25/", "https://swapi.dev/api/species/26/", "https://swapi.dev/api/species/27/"},
-			Created:       "2014-12-19T16:52:55.740000Z",
-			Edited:        "2014-12-20T10:54:07.216000Z",
-			URL:           "https://swapi.dev/api/films/4/",
-		},
-		{
-			Title:         "Attack of the Clones",
-			EpisodeID:     2,
-			OpeningCrawl:  "There is unrest in the Galactic\r\nSenate. Several thousand solar\r\nsystems have declared their\r\nintentions to leave the Republic.\r\n\r\nThis separatist movement,\r\nunder the leadership of the\r\nmysterious Count Dooku, has\r\nmade it difficult for the limited\r\nnumber of Jedi Knights to maintain \r\npeace and order in the galaxy.\r\n\r\nSenator Amidala, the former\r\nQueen of Naboo, is returning\r\nto the Galactic Senate to vote\r\non the critical issue of creating\r\nan ARMY OF THE REPUBLIC\r\nto assist the overwhelmed\r\nJedi....",
-			Director:      "George Lucas",
-			Producer:      "Rick McCallum",
-			CharacterURLs: []string{"https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/6/", "https://swapi.dev/api/people/7/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/11/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/22/", "https://swapi.dev/api/people/33/", "https://swapi.dev/api/people/35/", "https://swapi.dev/api/people/36/", "https://swapi.dev/api/people/40/", "https://swapi.dev/api/people/43/", "https://swapi.dev/api/people/46/", "https://swapi.dev/api/people/51/", "https://swapi.dev/api/people/52/", "https://swapi.dev/api/people/53/", "https://swapi.dev/api/people/58/", "https://swapi.dev/api/people/59/", "https://swapi.dev/api/people/60/", "https://swapi.dev/api/people/61/", "https://swapi.dev/api/people/62/", "https://swapi.dev/api/people/63/", "https://swapi.dev/api/people/64/", "https://swapi.dev/api/people/65/", "https://swapi.dev/api/people/66/", "https://swapi.dev/api/people/67/", "https://swapi.dev/api/people/68/", "https://swapi.dev/api/people/69/", "https://swapi.dev/api/people/70/", "https://swapi.dev/api/people/71/", "https://swapi.dev/api/people/72/", "https://swapi.dev/api/people/73/", "https://swapi.dev/api/people/74/", "https://swapi.dev/api/people/75/", "https://swapi.dev/api/people/76/", "https://swapi.dev/api/people/77/", "https://swapi.dev/api/people/78/", "https://swapi.dev/api/people/82/"},
-			PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/", "https://swapi.dev/api/planets/10/", "https://swapi.dev/api/planets/11/"},
-			StarshipURLs:  []string{"https://swapi.dev/api/starships/21/", "https://swapi.dev/api/starships/32/", "https://swapi.dev/api/starships/39/", "https://swapi.dev/api/starships/43/", "https://swapi.dev/api/starships/47/", "https://swapi.dev/api/starships/48/", "https://swapi.dev/api/starships/49/", "https://swapi.dev/api/starships/52/", "https://swapi.dev/api/starships/58/"},
-			VehicleURLs:   []string{"https://swapi.dev/api/vehicles/4/", "https://swapi.dev/api/vehicles/44/", "https://swapi.dev/api/vehicles/45/", "https://swapi.dev/api/vehicles/46/", "https://swapi.dev/api/vehicles/50/", "https://swapi.dev/api/vehicles/51/", "https://swapi.dev/api/vehicles/53/", "https://swapi.dev/api/vehicles/54/", "https://swapi.dev/api/vehicles/55/", "https://swapi.dev/api/vehicles/56/", "https://swapi.dev/api/vehicles/57/"},
-			SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/12/", "https://swapi.dev/api/species/13/", "https://swapi.dev/api/species/15/", "https://swapi.dev/api/species/28/", "https://swapi.dev/api/species/29/", "https://swapi.dev/api/species/30/", "https://swapi.dev/api/species/31/", "https://swapi.dev/api/species/32/", "https://swapi.dev/api/species/33/", "https://swapi.dev/api/species/34/", "https://swapi.dev/api/species/35/"},
-			Created:       "2014-12-20T10:57:57.886000Z",
-			Edited:        "2014-12-20T20:18:48.516000Z",
-			URL:           "https://swapi.dev/api/films/5/",
-		},
-		{
-			Title:         "Revenge of the Sith",
-			EpisodeID:     3,
-			OpeningCrawl:  "War! The Republic is crumbling\r\nunder attacks by the ruthless\r\nSith Lord, Count Dooku.\r\nThere are heroes on both sides.\r\nEvil is everywhere.\r\n\r\nIn a stunning move, the\r\nfiendish droid leader, General\r\nGrievous, has swept into the\r\nRepublic capital and kidnapped\r\nChancellor Palpatine, leader of\r\nthe Galactic Senate.\r\n\r\nAs the Separatist Droid Army\r\nattempts to flee the besieged\r\ncapital with their valuable\r\nhostage, two Jedi Knights lead a\r\ndesperate mission to rescue the\r\ncaptive Chancellor....",
-			Director:      "George Lucas",
-			Producer:      "Rick McCallum",
-			CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/6/", "https://swapi.dev/api/people/7/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/11/", "https://swapi.dev/api/people/12/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/33/", "https://swapi.dev/api/people/35/", "https://swapi.dev/api/people/46/", "https://swapi.dev/api/people/51/", "https://swapi.dev/api/people/52/", "https://swapi.dev/api/people/53/", "https://swapi.dev/api/people/54/", "https://swapi.dev/api/people/55/", "https://swapi.dev/api/people/56/", "https://swapi.dev/api/people/58/", "https://swapi.dev/api/people/63/", "https://swapi.dev/api/people/64/", "https://swapi.dev/api/people/67/", "https://swapi.dev/api/people/68/", "https://swapi.dev/api/people/75/", "https://swapi.dev/api/people/78/", "https://swapi.dev/api/people/79/", "https://swapi.dev/api/people/80/", "https://swapi.dev/api/people/81/", "https://swapi.dev/api/people/82/", "https://swapi.dev/api/people/83/"},
-			PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/2/", "https://swapi.dev/api/planets/5/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/", "https://swapi.dev/api/planets/12/", "https://swapi.dev/api/planets/13/", "https://swapi.dev/api/planets/14/", "https://swapi.dev/api/planets/15/", "https://swapi.dev/api/planets/16/", "https://swapi.dev/api/planets/17/", "https://swapi.dev/api/planets/18/", "https://swapi.dev/api/planets/19/"},
-			StarshipURLs:  []string{"https://swapi.dev/api/starships/2/", "https://swapi.dev/api/starships/32/", "https://swapi.dev/api/starships/48/", "https://swapi.dev/api/starships/59/", "https://swapi.dev/api/starships/61/", "https://swapi.dev/api/starships/63/", "https://swapi.dev/api/starships/64/", "https://swapi.dev/api/starships/65/", "https://swapi.dev/api/starships/66/", "https://swapi.dev/api/starships/68/", "https://swapi.dev/api/starships/74/", "https://swapi.dev/api/starships/75/"},
-			VehicleURLs:   []string{"https://swapi.dev/api/vehicles/33/", "https://swapi.dev/api/vehicles/50/", "https://swapi.dev/api/vehicles/53/", "https://swapi.dev/api/vehicles/56/", "https://swapi.dev/api/vehicles/60/", "https://swapi.dev/api/vehicles/62/", "https://swapi.dev/api/vehicles/67/", "https://swapi.dev/api/vehicles/69/", "https://swapi.dev/api/vehicles/70/", "https://swapi.dev/api/vehicles/71/", "https://swapi.dev/api/vehicles/72/", "https://swapi.dev/api/vehicles/73/", "https://swapi.dev/api/vehicles/76/"},
-			SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/15/", "https://swapi.dev/api/species/19/", "https://swapi.dev/api/species/20/", "https://swapi.dev/api/species/23/", "https://swapi.dev/api/species/24/", "https://swapi.dev/api/species/25/", "https://swapi.dev/api/species/26/", "https://swapi.dev/api/species/27/", "https://swapi.dev/api/species/28/", "https://swapi.dev/api/species/29/", "https://swapi.dev/api/species/30/", "https://swapi.dev/api/species/33/", "https://swapi.dev/api/species/34/", "https://swapi.dev/api/species/35/", "https://swapi.dev/api/species/36/", "https://swapi.dev/api/species/37/"},
-			Created:       "2014-12-20T18:49:38.403000Z",
-			Edited:        "2014-12-20T20:47:52.073000Z",
-			URL:           "https://swapi.dev/api/films/6/",
+	allFilms = []*Film{
+		{
+			Film: swapi.Film{
+				Title:         "A New Hope",
+				EpisodeID:     4,
+				OpeningCrawl:  "It is a period of civil war.\r\nRebel spaceships, striking\r\nfrom a hidden base, have won\r\ntheir first victory against\r\nthe evil Galactic Empire.\r\n\r\nDuring the battle, Rebel\r\nspies managed to steal secret\r\nplans to the Empire's\r\nultimate weapon, the DEATH\r\nSTAR, an armored space\r\nstation with enough power\r\nto destroy an entire planet.\r\n\r\nPursued by the Empire's\r\nsinister agents, Princess\r\nLeia races home aboard her\r\nstarship, custodian of the\r\nstolen plans that can save her\r\npeople and restore\r\nfreedom to the galaxy....",
+				Director:      "George Lucas",
+				Producer:      "Gary Kurtz, Rick McCallum",
+				CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/6/", "https://swapi.dev/api/people/7/", "https://swapi.dev/api/people/8/", "https://swapi.dev/api/people/9/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/12/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/", "https://swapi.dev/api/people/15/", "https://swapi.dev/api/people/16/", "https://swapi.dev/api/people/18/", "https://swapi.dev/api/people/19/", "https://swapi.dev/api/people/81/"},
+				PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/2/", "https://swapi.dev/api/planets/3/"},
+				StarshipURLs:  []string{"https://swapi.dev/api/starships/2/", "https://swapi.dev/api/starships/3/", "https://swapi.dev/api/starships/5/", "https://swapi.dev/api/starships/9/", "https://swapi.dev/api/starships/10/", "https://swapi.dev/api/starships/11/", "https://swapi.dev/api/starships/12/", "https://swapi.dev/api/starships/13/"},
+				VehicleURLs:   []string{"https://swapi.dev/api/vehicles/4/", "https://swapi.dev/api/vehicles/6/", "https://swapi.dev/api/vehicles/7/", "https://swapi.dev/api/vehicles/8/"},
+				SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/4/", "https://swapi.dev/api/species/5/"},
+				Created:       "2014-12-10T14:23:31.880000Z",
+				Edited:        "2014-12-20T19:49:45.256000Z",
+				URL:           "https://swapi.dev/api/films/1/",
+			},
+			ReleaseDate: "1977-05-25",
+		},
+		{
+			Film: swapi.Film{
+				Title:         "The Empire Strikes Back",
+				EpisodeID:     5,
+				OpeningCrawl:  "It is a dark time for the\r\nRebellion. Although the Death\r\nStar has been destroyed,\r\nImperial troops have driven the\r\nRebel forces from their hidden\r\nbase and pursued them across\r\nthe galaxy.\r\n\r\nEvading the dreaded Imperial\r\nStarfleet, a group of freedom\r\nfighters led by Luke Skywalker\r\nhas established a new secret\r\nbase on the remote ice world\r\nof Hoth.\r\n\r\nThe evil lord Darth Vader,\r\nobsessed with finding young\r\nSkywalker, has dispatched\r\nthousands of remote probes into\r\nthe far reaches of space....",
+				Director:      "Irvin Kershner",
+				Producer:      "Gary Kurtz, Rick McCallum",
+				CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/", "https://swapi.dev/api/people/18/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/22/", "https://swapi.dev/api/people/23/", "https://swapi.dev/api/people/24/", "https://swapi.dev/api/people/25/", "https://swapi.dev/api/people/26/"},
+				PlanetURLs:    []string{"https://swapi.dev/api/planets/4/", "https://swapi.dev/api/planets/5/", "https://swapi.dev/api/planets/6/", "https://swapi.dev/api/planets/27/"},
+				StarshipURLs:  []string{"https://swapi.dev/api/starships/3/", "https://swapi.dev/api/starships/10/", "https://swapi.dev/api/starships/11/", "https://swapi.dev/api/starships/12/", "https://swapi.dev/api/starships/15/", "https://swapi.dev/api/starships/17/", "https://swapi.dev/api/starships/21/", "https://swapi.dev/api/starships/22/", "https://swapi.dev/api/starships/23/"},
+				VehicleURLs:   []string{"https://swapi.dev/api/vehicles/8/", "https://swapi.dev/api/vehicles/14/", "https://swapi.dev/api/vehicles/16/", "https://swapi.dev/api/vehicles/18/", "https://swapi.dev/api/vehicles/19/", "https://swapi.dev/api/vehicles/20/"},
+				SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/7/"},
+				Created:       "2014-12-12T11:26:24.656000Z",
+				Edited:        "2014-12-15T13:07:53.386000Z",
+				URL:           "https://swapi.dev/api/films/2/",
+			},
+			ReleaseDate: "1980-05-17",
+		},
+		{
+			Film: swapi.Film{
+				Title:         "Return of the Jedi",
+				EpisodeID:     6,
+				OpeningCrawl:  "Luke Skywalker has returned to\r\nhis home planet of Tatooine in\r\nan attempt to rescue his\r\nfriend Han Solo from the\r\nclutches of the vile gangster\r\nJabba the Hutt.\r\n\r\nLittle does Luke know that the\r\nGALACTIC EMPIRE has secretly\r\nbegun construction on a new\r\narmored space station even\r\nmore powerful than the first\r\ndreaded Death Star.\r\n\r\nWhen completed, this ultimate\r\nweapon will spell certain doom\r\nfor the small band of rebels\r\nstruggling to restore freedom\r\nto the galaxy...",
+				Director:      "Richard Marquand",
+				Producer:      "Howard G. Kazanjian, George Lucas, Rick McCallum",
+				CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/", "https://swapi.dev/api/people/16/", "https://swapi.dev/api/people/18/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/22/", "https://swapi.dev/api/people/25/", "https://swapi.dev/api/people/27/", "https://swapi.dev/api/people/28/", "https://swapi.dev/api/people/29/", "https://swapi.dev/api/people/30/", "https://swapi.dev/api/people/31/", "https://swapi.dev/api/people/45/"},
+				PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/5/", "https://swapi.dev/api/planets/7/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/"},
+				StarshipURLs:  []string{"https://swapi.dev/api/starships/2/", "https://swapi.dev/api/starships/3/", "https://swapi.dev/api/starships/10/", "https://swapi.dev/api/starships/11/", "https://swapi.dev/api/starships/12/", "https://swapi.dev/api/starships/15/", "https://swapi.dev/api/starships/17/", "https://swapi.dev/api/starships/22/", "https://swapi.dev/api/starships/23/", "https://swapi.dev/api/starships/27/", "https://swapi.dev/api/starships/28/", "https://swapi.dev/api/starships/29/"},
+				VehicleURLs:   []string{"https://swapi.dev/api/vehicles/8/", "https://swapi.dev/api/vehicles/16/", "https://swapi.dev/api/vehicles/18/", "https://swapi.dev/api/vehicles/19/", "https://swapi.dev/api/vehicles/24/", "https://swapi.dev/api/vehicles/25/", "https://swapi.dev/api/vehicles/26/", "https://swapi.dev/api/vehicles/30/"},
+				SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/5/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/8/", "https://swapi.dev/api/species/9/", "https://swapi.dev/api/species/10/", "https://swapi.dev/api/species/15/"},
+				Created:       "2014-12-18T10:39:33.255000Z",
+				Edited:        "2014-12-20T09:48:37.462000Z",
+				URL:           "https://swapi.dev/api/films/3/",
+			},
+			ReleaseDate: "1983-05-25",
+		},
+		{
+			Film: swapi.Film{
+				Title:         "The Phantom Menace",
+				EpisodeID:     1,
+				OpeningCrawl:  "Turmoil has engulfed the\r\nGalactic Republic. The taxation\r\nof trade routes to outlying star\r\nsystems is in dispute.\r\n\r\nHoping to resolve the matter\r\nwith a blockade of deadly\r\nbattleships, the greedy Trade\r\nFederation has stopped all\r\nshipping to the small planet\r\nof Naboo.\r\n\r\nWhile the Congress of the\r\nRepublic endlessly debates\r\nthis alarming chain of events,\r\nthe Supreme Chancellor has\r\nsecretly dispatched two Jedi\r\nKnights, the guardians of\r\npeace and justice in the\r\ngalaxy, to settle the conflict....",
+				Director:      "George Lucas",
+				Producer:      "Rick McCallum",
+				CharacterURLs: []string{"https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/11/", "https://swapi.dev/api/people/16/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/32/", "https://swapi.dev/api/people/33/", "https://swapi.dev/api/people/34/", "https://swapi.dev/api/people/35/", "https://swapi.dev/api/people/36/", "https://swapi.dev/api/people/37/", "https://swapi.dev/api/people/38/", "https://swapi.dev/api/people/39/", "https://swapi.dev/api/people/40/", "https://swapi.dev/api/people/41/", "https://swapi.dev/api/people/42/", "https://swapi.dev/api/people/43/", "https://swapi.dev/api/people/44/", "https://swapi.dev/api/people/46/", "https://swapi.dev/api/people/47/", "https://swapi.dev/api/people/48/", "https://swapi.dev/api/people/49/", "https://swapi.dev/api/people/50/", "https://swapi.dev/api/people/51/", "https://swapi.dev/api/people/52/", "https://swapi.dev/api/people/53/", "https://swapi.dev/api/people/54/", "https://swapi.dev/api/people/55/", "https://swapi.dev/api/people/56/", "https://swapi.dev/api/people/57/", "https://swapi.dev/api/people/58/", "https://swapi.dev/api/people/59/"},
+				PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/"},
+				StarshipURLs:  []string{"https://swapi.dev/api/starships/31/", "https://swapi.dev/api/starships/32/", "https://swapi.dev/api/starships/39/", "https://swapi.dev/api/starships/40/", "https://swapi.dev/api/starships/41/"},
+				VehicleURLs:   []string{"https://swapi.dev/api/vehicles/33/", "https://swapi.dev/api/vehicles/34/", "https://swapi.dev/api/vehicles/35/", "https://swapi.dev/api/vehicles/36/", "https://swapi.dev/api/vehicles/37/", "https://swapi.dev/api/vehicles/38/", "https://swapi.dev/api/vehicles/42/"},
+				SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/11/", "https://swapi.dev/api/species/12/", "https://swapi.dev/api/species/13/", "https://swapi.dev/api/species/14/", "https://swapi.dev/api/species/15/", "https://swapi.dev/api/species/16/", "https://swapi.dev/api/species/17/", "https://swapi.dev/api/species/18/", "https://swapi.dev/api/species/19/", "https://swapi.dev/api/species/20/", "https://swapi.dev/api/species/21/", "https://swapi.dev/api/species/22/", "https://swapi.dev/api/species/23/", "https://swapi.dev/api/species/24/", "https://swapi.dev/api/species/25/", "https://swapi.dev/api/species/26/", "https://swapi.dev/api/species/27/"},
+				Created:       "2014-12-19T16:52:55.740000Z",
+				Edited:        "2014-12-20T10:54:07.216000Z",
+				URL:           "https://swapi.dev/api/films/4/",
+			},
+			ReleaseDate: "1999-05-19",
+		},
+		{
+			Film: swapi.Film{
+				Title:         "Attack of the Clones",
+				EpisodeID:     2,
+				OpeningCrawl:  "There is unrest in the Galactic\r\nSenate. Several thousand solar\r\nsystems have declared their\r\nintentions to leave the Republic.\r\n\r\nThis separatist movement,\r\nunder the leadership of the\r\nmysterious Count Dooku, has\r\nmade it difficult for the limited\r\nnumber of Jedi Knights to maintain \r\npeace and order in the galaxy.\r\n\r\nSenator Amidala, the former\r\nQueen of Naboo, is returning\r\nto the Galactic Senate to vote\r\non the critical issue of creating\r\nan ARMY OF THE REPUBLIC\r\nto assist the overwhelmed\r\nJedi....",
+				Director:      "George Lucas",
+				Producer:      "Rick McCallum",
+				CharacterURLs: []string{"https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/6/", "https://swapi.dev/api/people/7/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/11/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/22/", "https://swapi.dev/api/people/33/", "https://swapi.dev/api/people/35/", "https://swapi.dev/api/people/36/", "https://swapi.dev/api/people/40/", "https://swapi.dev/api/people/43/", "https://swapi.dev/api/people/46/", "https://swapi.dev/api/people/51/", "https://swapi.dev/api/people/52/", "https://swapi.dev/api/people/53/", "https://swapi.dev/api/people/58/", "https://swapi.dev/api/people/59/", "https://swapi.dev/api/people/60/", "https://swapi.dev/api/people/61/", "https://swapi.dev/api/people/62/", "https://swapi.dev/api/people/63/", "https://swapi.dev/api/people/64/", "https://swapi.dev/api/people/65/", "https://swapi.dev/api/people/66/", "https://swapi.dev/api/people/67/", "https://swapi.dev/api/people/68/", "https://swapi.dev/api/people/69/", "https://swapi.dev/api/people/70/", "https://swapi.dev/api/people/71/", "https://swapi.dev/api/people/72/", "https://swapi.dev/api/people/73/", "https://swapi.dev/api/people/74/", "https://swapi.dev/api/people/75/", "https://swapi.dev/api/people/76/", "https://swapi.dev/api/people/77/", "https://swapi.dev/api/people/78/", "https://swapi.dev/api/people/82/"},
+				PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/", "https://swapi.dev/api/planets/10/", "https://swapi.dev/api/planets/11/"},
+				StarshipURLs:  []string{"https://swapi.dev/api/starships/21/", "https://swapi.dev/api/starships/32/", "https://swapi.dev/api/starships/39/", "https://swapi.dev/api/starships/43/", "https://swapi.dev/api/starships/47/", "https://swapi.dev/api/starships/48/", "https://swapi.dev/api/starships/49/", "https://swapi.dev/api/starships/52/", "https://swapi.dev/api/starships/58/"},
+				VehicleURLs:   []string{"https://swapi.dev/api/vehicles/4/", "https://swapi.dev/api/vehicles/44/", "https://swapi.dev/api/vehicles/45/", "https://swapi.dev/api/vehicles/46/", "https://swapi.dev/api/vehicles/50/", "https://swapi.dev/api/vehicles/51/", "https://swapi.dev/api/vehicles/53/", "https://swapi.dev/api/vehicles/54/", "https://swapi.dev/api/vehicles/55/", "https://swapi.dev/api/vehicles/56/", "https://swapi.dev/api/vehicles/57/"},
+				SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/12/", "https://swapi.dev/api/species/13/", "https://swapi.dev/api/species/15/", "https://swapi.dev/api/species/28/", "https://swapi.dev/api/species/29/", "https://swapi.dev/api/species/30/", "https://swapi.dev/api/species/31/", "https://swapi.dev/api/species/32/", "https://swapi.dev/api/species/33/", "https://swapi.dev/api/species/34/", "https://swapi.dev/api/species/35/"},
+				Created:       "2014-12-20T10:57:57.886000Z",
+				Edited:        "2014-12-20T20:18:48.516000Z",
+				URL:           "https://swapi.dev/api/films/5/",
+			},
+			ReleaseDate: "2002-05-16",
+		},
+		{
+			Film: swapi.Film{
+				Title:         "Revenge of the Sith",
+				EpisodeID:     3,
+				OpeningCrawl:  "War! The Republic is crumbling\r\nunder attacks by the ruthless\r\nSith Lord, Count Dooku.\r\nThere are heroes on both sides.\r\nEvil is everywhere.\r\n\r\nIn a stunning move, the\r\nfiendish droid leader, General\r\nGrievous, has swept into the\r\nRepublic capital and kidnapped\r\nChancellor Palpatine, leader of\r\nthe Galactic Senate.\r\n\r\nAs the Separatist Droid Army\r\nattempts to flee the besieged\r\ncapital with their valuable\r\nhostage, two Jedi Knights lead a\r\ndesperate mission to rescue the\r\ncaptive Chancellor....",
+				Director:      "George Lucas",
+				Producer:      "Rick McCallum",
+				CharacterURLs: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/", "https://swapi.dev/api/people/3/", "https://swapi.dev/api/people/4/", "https://swapi.dev/api/people/5/", "https://swapi.dev/api/people/6/", "https://swapi.dev/api/people/7/", "https://swapi.dev/api/people/10/", "https://swapi.dev/api/people/11/", "https://swapi.dev/api/people/12/", "https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/20/", "https://swapi.dev/api/people/21/", "https://swapi.dev/api/people/33/", "https://swapi.dev/api/people/35/", "https://swapi.dev/api/people/46/", "https://swapi.dev/api/people/51/", "https://swapi.dev/api/people/52/", "https://swapi.dev/api/people/53/", "https://swapi.dev/api/people/54/", "https://swapi.dev/api/people/55/", "https://swapi.dev/api/people/56/", "https://swapi.dev/api/people/58/", "https://swapi.dev/api/people/63/", "https://swapi.dev/api/people/64/", "https://swapi.dev/api/people/67/", "https://swapi.dev/api/people/68/", "https://swapi.dev/api/people/75/", "https://swapi.dev/api/people/78/", "https://swapi.dev/api/people/79/", "https://swapi.dev/api/people/80/", "https://swapi.dev/api/people/81/", "https://swapi.dev/api/people/82/", "https://swapi.dev/api/people/83/"},
+				PlanetURLs:    []string{"https://swapi.dev/api/planets/1/", "https://swapi.dev/api/planets/2/", "https://swapi.dev/api/planets/5/", "https://swapi.dev/api/planets/8/", "https://swapi.dev/api/planets/9/", "https://swapi.dev/api/planets/12/", "https://swapi.dev/api/planets/13/", "https://swapi.dev/api/planets/14/", "https://swapi.dev/api/planets/15/", "https://swapi.dev/api/planets/16/", "https://swapi.dev/api/planets/17/", "https://swapi.dev/api/planets/18/", "https://swapi.dev/api/planets/19/"},
+				StarshipURLs:  []string{"https://swapi.dev/api/starships/2/", "https://swapi.dev/api/starships/32/", "https://swapi.dev/api/starships/48/", "https://swapi.dev/api/starships/59/", "https://swapi.dev/api/starships/61/", "https://swapi.dev/api/starships/63/", "https://swapi.dev/api/starships/64/", "https://swapi.dev/api/starships/65/", "https://swapi.dev/api/starships/66/", "https://swapi.dev/api/starships/68/", "https://swapi.dev/api/starships/74/", "https://swapi.dev/api/starships/75/"},
+				VehicleURLs:   []string{"https://swapi.dev/api/vehicles/33/", "https://swapi.dev/api/vehicles/50/", "https://swapi.dev/api/vehicles/53/", "https://swapi.dev/api/vehicles/56/", "https://swapi.dev/api/vehicles/60/", "https://swapi.dev/api/vehicles/62/", "https://swapi.dev/api/vehicles/67/", "https://swapi.dev/api/vehicles/69/", "https://swapi.dev/api/vehicles/70/", "https://swapi.dev/api/vehicles/71/", "https://swapi.dev/api/vehicles/72/", "https://swapi.dev/api/vehicles/73/", "https://swapi.dev/api/vehicles/76/"},
+				SpeciesURLs:   []string{"https://swapi.dev/api/species/1/", "https://swapi.dev/api/species/2/", "https://swapi.dev/api/species/3/", "https://swapi.dev/api/species/6/", "https://swapi.dev/api/species/15/", "https://swapi.dev/api/species/19/", "https://swapi.dev/api/species/20/", "https://swapi.dev/api/species/23/", "https://swapi.dev/api/species/24/", "https://swapi.dev/api/species/25/", "https://swapi.dev/api/species/26/", "https://swapi.dev/api/species/27/", "https://swapi.dev/api/species/28/", "https://swapi.dev/api/species/29/", "https://swapi.dev/api/species/30/", "https://swapi.dev/api/species/33/", "https://swapi.dev/api/species/34/", "https://swapi.dev/api/species/35/", "https://swapi.dev/api/species/36/", "https://swapi.dev/api/species/37/"},
+				Created:       "2014-12-20T18:49:38.403000Z",
+				Edited:        "2014-12-20T20:47:52.073000Z",
+				URL:           "https://swapi.dev/api/films/6/",
+			},
+			ReleaseDate: "2005-05-19",
 		},
 	}
 	allPeople = []*swapi.Person{
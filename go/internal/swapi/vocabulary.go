@@ -0,0 +1,137 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"slices"
+	"strings"
+
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+)
+
+// climateAliases maps the names of climates that appear in swapi.dev data,
+// other than the canonical names of the Climate enum, to their canonical
+// values.
+//
+//nolint:gochecknoglobals
+var climateAliases = map[string]planetv1.Climate{
+	"artic":    planetv1.Climate_CLIMATE_ARCTIC,
+	"subartic": planetv1.Climate_CLIMATE_SUBARCTIC,
+}
+
+// climatesByName maps the canonical name of every climate, as well as its
+// aliases, to its Climate value. The canonical name of a climate is its enum
+// value name, without the prefix, in lower case, with spaces instead of
+// underscores. For example, "artificial temperate".
+//
+//nolint:gochecknoglobals
+var climatesByName = func() map[string]planetv1.Climate {
+	results := make(map[string]planetv1.Climate, len(planetv1.Climate_value)+len(climateAliases))
+	for _, value := range planetv1.Climate_value {
+		if climate := planetv1.Climate(value); climate != planetv1.Climate_CLIMATE_UNSPECIFIED {
+			results[climateName(climate)] = climate
+		}
+	}
+	for alias, value := range climateAliases {
+		results[alias] = value
+	}
+	return results
+}()
+
+func climateName(climate planetv1.Climate) string {
+	name := strings.TrimPrefix(climate.String(), "CLIMATE_")
+	return strings.ReplaceAll(strings.ToLower(name), "_", " ")
+}
+
+// normalizeClimates returns the given climates in lower case, with known
+// misspellings corrected, along with the Climate value of each one that is
+// in the canonical vocabulary.
+func normalizeClimates(climates []string) ([]string, []planetv1.Climate) {
+	climates = normalizeVocabulary(climates)
+	var types []planetv1.Climate
+	for i, climate := range climates {
+		if value, ok := climatesByName[climate]; ok {
+			climates[i] = climateName(value)
+			types = append(types, value)
+		}
+	}
+	return dedupe(climates), dedupe(types)
+}
+
+// normalizeVocabulary returns the given values trimmed, in lower case, and
+// without duplicates. The value "unknown" is removed, since an empty list
+// indicates that the values are unknown.
+func normalizeVocabulary(values []string) []string {
+	results := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" && value != "unknown" {
+			results = append(results, value)
+		}
+	}
+	return dedupe(results)
+}
+
+// splitList splits a comma-separated list of values, as used in swapi.dev
+// data, into its trimmed, non-empty, distinct values.
+func splitList(s string) []string {
+	values := strings.Split(s, ",")
+	results := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			results = append(results, value)
+		}
+	}
+	return dedupe(results)
+}
+
+// corporateSuffixes are the values in comma-separated lists of
+// manufacturers that are not manufacturers themselves, but the end of the
+// previous manufacturer's name, as in "Gallofree Yards, Inc.".
+//
+//nolint:gochecknoglobals
+var corporateSuffixes = map[string]struct{}{
+	"inc":          {},
+	"inc.":         {},
+	"incorporated": {},
+}
+
+// splitManufacturers splits a comma-separated list of manufacturers, as
+// used in swapi.dev data, like splitList, except that corporate suffixes
+// remain part of the preceding name.
+func splitManufacturers(s string) []string {
+	var results []string
+	for _, value := range strings.Split(s, ",") {
+		value = strings.TrimSpace(value)
+		if _, ok := corporateSuffixes[strings.ToLower(value)]; ok && len(results) > 0 {
+			results[len(results)-1] += ", " + value
+		} else if value != "" {
+			results = append(results, value)
+		}
+	}
+	return dedupe(results)
+}
+
+// dedupe removes duplicate values, keeping the first occurrence of each.
+func dedupe[T comparable](values []T) []T {
+	seen := make(map[T]struct{}, len(values))
+	return slices.DeleteFunc(values, func(value T) bool {
+		if _, ok := seen[value]; ok {
+			return true
+		}
+		seen[value] = struct{}{}
+		return false
+	})
+}
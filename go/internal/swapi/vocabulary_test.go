@@ -0,0 +1,151 @@
+// Copyright 2023 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package swapi
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	planetv1 "github.com/bufbuild/knit-demo/go/gen/buf/knit/demo/swapi/planet/v1"
+)
+
+func TestNormalizeClimates(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		climates  []string
+		want      []string
+		wantTypes []planetv1.Climate
+	}{
+		{
+			climates:  []string{"arid"},
+			want:      []string{"arid"},
+			wantTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_ARID},
+		},
+		{
+			climates:  []string{"artic", " Subartic"},
+			want:      []string{"arctic", "subarctic"},
+			wantTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_ARCTIC, planetv1.Climate_CLIMATE_SUBARCTIC},
+		},
+		{
+			climates:  []string{"Artificial Temperate"},
+			want:      []string{"artificial temperate"},
+			wantTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_ARTIFICIAL_TEMPERATE},
+		},
+		{
+			// A misspelling and the correct spelling are the same climate.
+			climates:  []string{"arctic", "artic", "ARCTIC"},
+			want:      []string{"arctic"},
+			wantTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_ARCTIC},
+		},
+		{
+			// Climates that are not in the vocabulary are kept, but have no
+			// Climate value.
+			climates:  []string{"temperate", "mild"},
+			want:      []string{"temperate", "mild"},
+			wantTypes: []planetv1.Climate{planetv1.Climate_CLIMATE_TEMPERATE},
+		},
+		{
+			climates: []string{"unknown"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(strings.Join(testCase.climates, ","), func(t *testing.T) {
+			t.Parallel()
+			got, gotTypes := normalizeClimates(slices.Clone(testCase.climates))
+			if !slices.Equal(got, testCase.want) || !slices.Equal(gotTypes, testCase.wantTypes) {
+				t.Errorf("normalizeClimates(%q) = %q, %v, want %q, %v", testCase.climates, got, gotTypes, testCase.want, testCase.wantTypes)
+			}
+		})
+	}
+}
+
+func TestNormalizeVocabulary(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		values []string
+		want   []string
+	}{
+		{values: []string{"Grasslands", " mountains "}, want: []string{"grasslands", "mountains"}},
+		{values: []string{"desert", "Desert", "unknown", ""}, want: []string{"desert"}},
+		{values: []string{"UNKNOWN"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(strings.Join(testCase.values, ","), func(t *testing.T) {
+			t.Parallel()
+			if got := normalizeVocabulary(testCase.values); !slices.Equal(got, testCase.want) {
+				t.Errorf("normalizeVocabulary(%q) = %q, want %q", testCase.values, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		list string
+		want []string
+	}{
+		{list: "temperate, tropical", want: []string{"temperate", "tropical"}},
+		{list: "desert,, desert ,", want: []string{"desert"}},
+		{list: ""},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.list, func(t *testing.T) {
+			t.Parallel()
+			if got := splitList(testCase.list); !slices.Equal(got, testCase.want) {
+				t.Errorf("splitList(%q) = %q, want %q", testCase.list, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSplitManufacturers(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		list string
+		want []string
+	}{
+		{
+			list: "Corellian Engineering Corporation",
+			want: []string{"Corellian Engineering Corporation"},
+		},
+		{
+			list: "Kuat Drive Yards, Fondor Shipyards",
+			want: []string{"Kuat Drive Yards", "Fondor Shipyards"},
+		},
+		{
+			list: "Gallofree Yards, Inc.",
+			want: []string{"Gallofree Yards, Inc."},
+		},
+		{
+			list: "Incom Corporation, Subpro Corporation, Incorporated, Sienar Fleet Systems",
+			want: []string{"Incom Corporation", "Subpro Corporation, Incorporated", "Sienar Fleet Systems"},
+		},
+		{
+			// A suffix cannot be the first manufacturer.
+			list: "Inc., Sienar Fleet Systems, Sienar Fleet Systems",
+			want: []string{"Inc.", "Sienar Fleet Systems"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.list, func(t *testing.T) {
+			t.Parallel()
+			if got := splitManufacturers(testCase.list); !slices.Equal(got, testCase.want) {
+				t.Errorf("splitManufacturers(%q) = %q, want %q", testCase.list, got, testCase.want)
+			}
+		})
+	}
+}